@@ -7,6 +7,7 @@ import (
 	"github.com/alserov/rently/api/internal/log"
 	"github.com/alserov/rently/api/internal/routes"
 	"github.com/alserov/rently/api/internal/server"
+	"github.com/alserov/rently/proto/jwks"
 	"github.com/gofiber/fiber/v2"
 	"log/slog"
 	"os"
@@ -40,6 +41,7 @@ func MustStart(cfg *config.Config) {
 		ReadTimeout:  cfg.ReadTimeout,
 		WriteTimeout: cfg.WriteTimeout,
 		Clients:      cls,
+		Verifier:     jwks.NewVerifier(jwks.Params{Source: jwks.UserSource(cls.UserClient)}),
 	})
 
 	router := fiber.New()
@@ -11,6 +11,8 @@ import (
 
 const (
 	AUTH_TOKEN = "auth_token"
+	// REFRESH_TOKEN is the cookie of the refresh token, it is not readable by the scripts
+	REFRESH_TOKEN = "refresh_token"
 
 	ERR_NOT_AUTHORIZED = "not authorized"
 	ERR_NOT_ALLOWED    = "not allowed"
@@ -1,7 +1,5 @@
 package models
 
-import "time"
-
 type Error struct {
 	Err string `json:"error"`
 }
@@ -15,8 +13,14 @@ type CreateRentReq struct {
 	PhoneNumber    string `json:"phoneNumber"`
 	PassportNumber string `json:"passportNumber"`
 	PaymentSource  string `json:"paymentSource" validate:"required"`
+	Email          string `json:"email" validate:"omitempty,email"`
+	PromoCode      string `json:"promoCode" validate:"omitempty,max=32"`
 	RentStart      int64  `json:"rentStart"`
 	RentEnd        int64  `json:"rentEnd"`
+	// PickupStation and DropOffStation are the home station of the car by default,
+	// the different drop-off station makes the rent one-way
+	PickupStation  string `json:"pickupStation"`
+	DropOffStation string `json:"dropOffStation"`
 }
 
 type CreateCarReq struct {
@@ -28,6 +32,7 @@ type CreateCarReq struct {
 	PricePerDay float32  `json:"pricePerDay" validate:"required,gt=0"`
 	Images      [][]byte `json:"images" validate:"required,min=1,max=7"`
 	MainImage   []byte   `json:"mainImage" type:"main"  validate:"required"`
+	StationUUID string   `json:"stationUUID"`
 }
 
 type UpdateCarPriceReq struct {
@@ -35,16 +40,119 @@ type UpdateCarPriceReq struct {
 	PricePerDay float32 `json:"pricePerDay" validate:"required,gt=0"`
 }
 
+// UpdateCarReq only the set fields are updated, Price is set in minor units of the Currency
+type UpdateCarReq struct {
+	Brand    *string `json:"brand" validate:"omitempty,min=1"`
+	Type     *string `json:"type" validate:"omitempty,min=1"`
+	MaxSpeed *int32  `json:"maxSpeed" validate:"omitempty,gt=0,lt=700"`
+	Seats    *int32  `json:"seats" validate:"omitempty,gt=0,lt=12"`
+	Category *string `json:"category" validate:"omitempty,min=1"`
+	Price    *int64  `json:"price" validate:"omitempty,gt=0"`
+	Currency string  `json:"currency" validate:"omitempty,len=3"`
+	// StationUUID moves the car to the other home station
+	StationUUID *string `json:"stationUUID" validate:"omitempty,min=1"`
+}
+
+// ScheduleMaintenanceReq period is set in unix seconds, the unplanned maintenance starts now, if start is not set,
+// and lasts till it is closed, if end is not set
+type ScheduleMaintenanceReq struct {
+	Kind       string `json:"kind" validate:"required,oneof=planned unplanned"`
+	Reason     string `json:"reason" validate:"required,max=255"`
+	Start      int64  `json:"start" validate:"required_if=Kind planned"`
+	End        int64  `json:"end" validate:"required_if=Kind planned"`
+	IntervalKm int64  `json:"intervalKm" validate:"gte=0"`
+}
+
+// CloseMaintenanceReq Mileage is the odometer reading of the car after the maintenance
+type CloseMaintenanceReq struct {
+	Mileage int64 `json:"mileage" validate:"gte=0"`
+}
+
+// ReorderCarImagesReq IDs are all the images of the car in the new order
+type ReorderCarImagesReq struct {
+	IDs []string `json:"ids" validate:"required,min=1,dive,required"`
+}
+
+type CreatePromoCodeReq struct {
+	Code    string `json:"code" validate:"required,min=3,max=32"`
+	Percent int32  `json:"percent" validate:"omitempty,gt=0,lt=100"`
+	// Amount is the fixed discount in minor units of the currency
+	Amount         int64    `json:"amount" validate:"omitempty,gt=0"`
+	Currency       string   `json:"currency" validate:"omitempty,len=3"`
+	MaxUses        int32    `json:"maxUses" validate:"gte=0"`
+	MaxUsesPerUser int32    `json:"maxUsesPerUser" validate:"gte=0"`
+	Categories     []string `json:"categories"`
+	ValidFrom      int64    `json:"validFrom"`
+	ValidUntil     int64    `json:"validUntil"`
+}
+
+type QuotePriceReq struct {
+	CarUUID        string `json:"carUUID" validate:"required"`
+	RentStart      int64  `json:"rentStart" validate:"required"`
+	RentEnd        int64  `json:"rentEnd" validate:"required,gtfield=RentStart"`
+	PickupStation  string `json:"pickupStation"`
+	DropOffStation string `json:"dropOffStation"`
+}
+
+// GetAvailableCarsReq period is set in unix seconds
 type GetAvailableCarsReq struct {
-	Start *time.Time `json:"start" validate:"required"`
-	End   *time.Time `json:"end" validate:"required"`
+	Start int64 `json:"start" validate:"required"`
+	End   int64 `json:"end" validate:"required,gtfield=Start"`
+	// StationUUID filters the cars of the station
+	StationUUID string `json:"stationUUID"`
+	Area
+	CarFilter
+	Page
 }
 
 type GetCarsByParamsReq struct {
-	Brand       string  `json:"brand" validate:"required"`
-	Type        string  `json:"type" validate:"required"`
-	MaxSpeed    int32   `json:"maxSpeed" validate:"required,gt=0,lt=900"`
-	Seats       int32   `json:"seats" validate:"required,gt=0,lt=12"`
-	Category    string  `json:"category" validate:"required"`
-	PricePerDay float32 `json:"pricePerDay" validate:"required,gt=0"`
+	CarFilter
+	Page
+}
+
+// SearchCarsReq cars are sorted by relevance, if sortBy is not set
+type SearchCarsReq struct {
+	Query    string `json:"q" validate:"required,max=100"`
+	Brand    string `json:"brand"`
+	Category string `json:"category"`
+	Seats    int32  `json:"seats" validate:"omitempty,gt=0,lt=12"`
+	Page
+}
+
+type CarFilter struct {
+	Brand    string `json:"brand"`
+	Type     string `json:"type"`
+	MaxSpeed int32  `json:"maxSpeed" validate:"omitempty,gt=0,lt=900"`
+	Seats    int32  `json:"seats" validate:"omitempty,gt=0,lt=12"`
+	Category string `json:"category"`
+	// MinPrice and MaxPrice are inclusive and set in minor units of the currency
+	MinPrice int64  `json:"minPrice" validate:"omitempty,gt=0"`
+	MaxPrice int64  `json:"maxPrice" validate:"omitempty,gt=0"`
+	Currency string `json:"currency" validate:"omitempty,len=3"`
+}
+
+// Area filters the stations within RadiusKm from the point, zero radius means no filter
+type Area struct {
+	Latitude  float64 `json:"lat" validate:"gte=-90,lte=90"`
+	Longitude float64 `json:"lon" validate:"gte=-180,lte=180"`
+	RadiusKm  float64 `json:"radiusKm" validate:"omitempty,gt=0,lte=500"`
+}
+
+// CreateStationReq OpensAt and ClosesAt are HH:MM in the time zone of the station, empty time zone means UTC
+type CreateStationReq struct {
+	Name      string  `json:"name" validate:"required,max=100"`
+	Address   string  `json:"address" validate:"required,max=255"`
+	Latitude  float64 `json:"latitude" validate:"gte=-90,lte=90"`
+	Longitude float64 `json:"longitude" validate:"gte=-180,lte=180"`
+	OpensAt   string  `json:"opensAt" validate:"required,len=5"`
+	ClosesAt  string  `json:"closesAt" validate:"required,len=5"`
+	TimeZone  string  `json:"timeZone"`
+	Capacity  int32   `json:"capacity" validate:"required,gt=0"`
+}
+
+type Page struct {
+	PageSize   int32  `json:"pageSize" validate:"omitempty,gt=0,lte=100"`
+	PageToken  string `json:"pageToken"`
+	SortBy     string `json:"sortBy" validate:"omitempty,oneof=price maxSpeed seats brand relevance"`
+	Descending bool   `json:"desc"`
 }
@@ -7,6 +7,13 @@ type RegisterReq struct {
 	PassportNumber string `json:"passportNumber" validate:"required,min=9" errormgs:"invalid passport number: can not be less than 9 characters"`
 	PaymentSource  string `json:"paymentSource" validate:"required,min=12" errormgs:"invalid card number: can not be less than 12 characters"`
 	PhoneNumber    string `json:"phoneNumber" validate:"required,min=7" errormgs:"invalid phone number: can not be less than 7 characters"`
+	Client         Client `json:"-"`
+}
+
+// Client is the device, the session is started from, it is taken from the request
+type Client struct {
+	UserAgent string
+	IP        string
 }
 
 type RegisterRes struct {
@@ -16,6 +23,26 @@ type RegisterRes struct {
 type LoginReq struct {
 	Password string `json:"password" validate:"required,max=40,min=7" errormgs:"invalid password: can not be less than 7 or greater than 40 characters"`
 	Email    string `json:"email" validate:"required,min=5" errormgs:"invalid email: can not be less than 5 characters"`
+	Client   Client `json:"-"`
+}
+
+type RequestPasswordResetReq struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+// ConfirmPasswordResetReq the code is taken from the emailed link
+type ConfirmPasswordResetReq struct {
+	Code        string `json:"code" validate:"required"`
+	NewPassword string `json:"newPassword" validate:"required,min=7,max=40"`
+}
+
+// VerifyEmailReq the code is taken from the emailed link
+type VerifyEmailReq struct {
+	Code string `json:"code" validate:"required"`
+}
+
+type ResendEmailVerificationReq struct {
+	Email string `json:"email" validate:"required,email"`
 }
 
 type ResetPasswordReq struct {
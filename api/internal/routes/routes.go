@@ -12,22 +12,52 @@ const (
 	AUTH  = "/auth"
 	ADMIN = "/admin"
 	USER  = "/user"
+
+	JWKS = "/.well-known/jwks.json"
 )
 
 func Setup(c *fiber.App, s *server.Server) {
 	admin := c.Group(ADMIN)
 	admin.Post("carsharing/", middleware.CheckIfAuthorized, s.Carsharing.CreateCar)
 	admin.Delete("carsharing/:car_uuid", middleware.CheckIfAuthorized, s.Carsharing.DeleteCar)
+	admin.Get("carsharing/:car_uuid/images", middleware.CheckIfAuthorized, s.Carsharing.GetCarImages)
+	admin.Post("carsharing/:car_uuid/image", middleware.CheckIfAuthorized, s.Carsharing.UploadCarImage)
+	admin.Delete("carsharing/:car_uuid/image/:id", middleware.CheckIfAuthorized, s.Carsharing.DeleteCarImage)
+	admin.Put("carsharing/:car_uuid/images/order", middleware.CheckIfAuthorized, s.Carsharing.ReorderCarImages)
+	admin.Put("carsharing/:car_uuid/image/:id/main", middleware.CheckIfAuthorized, s.Carsharing.SetMainCarImage)
 	admin.Patch("carsharing/", middleware.CheckIfAuthorized, s.Carsharing.UpdateCarPrice)
+	admin.Patch("carsharing/:car_uuid", middleware.CheckIfAuthorized, s.Carsharing.UpdateCar)
+	admin.Get("carsharing/:car_uuid/changes", middleware.CheckIfAuthorized, s.Carsharing.GetCarChanges)
+	admin.Post("carsharing/:car_uuid/maintenance", middleware.CheckIfAuthorized, s.Carsharing.ScheduleMaintenance)
+	admin.Get("carsharing/:car_uuid/maintenances", middleware.CheckIfAuthorized, s.Carsharing.GetMaintenances)
+	admin.Patch("carsharing/maintenance/:uuid/close", middleware.CheckIfAuthorized, s.Carsharing.CloseMaintenance)
+	admin.Patch("carsharing/rent/start/:uuid", middleware.CheckIfAuthorized, s.Carsharing.StartRent)
+	admin.Patch("carsharing/rent/complete/:uuid", middleware.CheckIfAuthorized, s.Carsharing.CompleteRent)
+	admin.Patch("carsharing/rent/no-show/:uuid", middleware.CheckIfAuthorized, s.Carsharing.MarkNoShow)
+	admin.Post("carsharing/promo", middleware.CheckIfAuthorized, s.Carsharing.CreatePromoCode)
+	admin.Delete("carsharing/promo/:code", middleware.CheckIfAuthorized, s.Carsharing.ExpirePromoCode)
+	admin.Post("carsharing/station", middleware.CheckIfAuthorized, s.Carsharing.CreateStation)
 
 	info := c.Group(INFO)
 	info.Get("carsharing/car/image/:bucket/:id", s.Carsharing.GetImage)
 	info.Get("carsharing/car/:car_uuid", s.Carsharing.GetCarByUUID)
 	info.Get("carsharing/filter", s.Carsharing.GetCarsByParams)
+	info.Get("carsharing/available", s.Carsharing.GetAvailableCars)
+	info.Get("carsharing/search", s.Carsharing.SearchCars)
+	info.Get("carsharing/quote", s.Carsharing.QuotePrice)
+	info.Get("carsharing/stations", s.Carsharing.GetStations)
+
+	c.Get(JWKS, s.User.GetJWKS)
 
 	auth := c.Group(AUTH)
 	auth.Post("register/", s.User.Register)
 	auth.Get("login/", s.User.Login)
+	auth.Post("refresh/", s.User.RefreshToken)
+	auth.Post("logout/", middleware.CheckIfAuthorized, s.User.Logout)
+	auth.Post("password/forgot", s.User.RequestPasswordReset)
+	auth.Post("password/reset", s.User.ConfirmPasswordReset)
+	auth.Post("email/verify", s.User.VerifyEmail)
+	auth.Post("email/resend", s.User.ResendEmailVerification)
 
 	rent := c.Group(RENT)
 	rent.Post("/carsharing/new", s.Carsharing.CreateRent)
@@ -36,4 +66,6 @@ func Setup(c *fiber.App, s *server.Server) {
 
 	user := c.Group(USER)
 	user.Patch("/reset-password", s.User.ResetPassword)
+	user.Get("/sessions", middleware.CheckIfAuthorized, s.User.ListSessions)
+	user.Delete("/sessions/:id", middleware.CheckIfAuthorized, s.User.RevokeSession)
 }
@@ -1,8 +1,8 @@
 package domains
 
 import (
-	"bytes"
 	"context"
+	"fmt"
 	grpcbreaker "github.com/alserov/circuit_breaker/grpc"
 	"github.com/alserov/rently/api/internal/log"
 	"github.com/alserov/rently/api/internal/middleware"
@@ -10,11 +10,11 @@ import (
 	"github.com/alserov/rently/api/internal/utils/converter"
 	carsh "github.com/alserov/rently/proto/gen/carsharing"
 	usr "github.com/alserov/rently/proto/gen/user"
+	"github.com/alserov/rently/proto/jwks"
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
-	"image"
-	"image/jpeg"
-	"log/slog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"net/http"
 	"time"
 )
@@ -29,6 +29,7 @@ func NewCarsharing(p Params[CarsharingClients]) Carsharing {
 		log:              log.GetLogger(),
 		carsharingClient: p.Client.Carsharing,
 		userClient:       p.Client.User,
+		verifier:         p.Verifier,
 		readTimeout:      p.ReadTimeout,
 		writeTimeout:     p.WriteTimeout,
 		valid:            validator.New(),
@@ -44,17 +45,36 @@ type CarsharingClients struct {
 
 type Carsharing interface {
 	CreateCar(c *fiber.Ctx) error
+	UploadCarImage(c *fiber.Ctx) error
+	GetCarImages(c *fiber.Ctx) error
+	DeleteCarImage(c *fiber.Ctx) error
+	ReorderCarImages(c *fiber.Ctx) error
+	SetMainCarImage(c *fiber.Ctx) error
 	DeleteCar(c *fiber.Ctx) error
+	UpdateCar(c *fiber.Ctx) error
 	UpdateCarPrice(c *fiber.Ctx) error
+	GetCarChanges(c *fiber.Ctx) error
+	ScheduleMaintenance(c *fiber.Ctx) error
+	CloseMaintenance(c *fiber.Ctx) error
+	GetMaintenances(c *fiber.Ctx) error
+	CreatePromoCode(c *fiber.Ctx) error
+	ExpirePromoCode(c *fiber.Ctx) error
+	CreateStation(c *fiber.Ctx) error
 
 	CreateRent(c *fiber.Ctx) error
 	CancelRent(c *fiber.Ctx) error
 	CheckRent(c *fiber.Ctx) error
+	StartRent(c *fiber.Ctx) error
+	CompleteRent(c *fiber.Ctx) error
+	MarkNoShow(c *fiber.Ctx) error
 
 	GetAvailableCars(c *fiber.Ctx) error
 	GetCarsByParams(c *fiber.Ctx) error
+	SearchCars(c *fiber.Ctx) error
 	GetCarByUUID(c *fiber.Ctx) error
 	GetImage(c *fiber.Ctx) error
+	QuotePrice(c *fiber.Ctx) error
+	GetStations(c *fiber.Ctx) error
 }
 
 type carsharing struct {
@@ -70,6 +90,8 @@ type carsharing struct {
 	carsharingClient carsh.CarsClient
 	userClient       usr.UserClient
 
+	verifier jwks.Verifier
+
 	breaker *grpcbreaker.Breaker
 }
 
@@ -95,7 +117,62 @@ func (csh *carsharing) CancelRent(c *fiber.Ctx) error {
 	ctx, cancel := context.WithTimeout(c.Context(), time.Duration(csh.writeTimeout.Seconds()*0.80*float64(time.Second)))
 	defer cancel()
 
-	_, err := grpcbreaker.Execute(ctx, csh.carsharingClient.CancelRent, csh.convert.CancelRentToPb(rentUUID), csh.breaker)
+	ctx, err := withIdempotencyKey(ctx, c)
+	if err != nil {
+		c.Status(http.StatusBadRequest)
+		handleResponseError(c.Send(marshal(models.Error{Err: err.Error()})))
+		return nil
+	}
+
+	_, err = grpcbreaker.Execute(ctx, csh.carsharingClient.CancelRent, csh.convert.CancelRentToPb(rentUUID), csh.breaker)
+	if err != nil {
+		handleServiceError(c.Response(), err)
+		return nil
+	}
+
+	c.Status(http.StatusOK)
+	return nil
+}
+
+func (csh *carsharing) StartRent(c *fiber.Ctx) error {
+	rentUUID := c.Params("uuid")
+
+	ctx, cancel := context.WithTimeout(c.Context(), time.Duration(csh.writeTimeout.Seconds()*0.80*float64(time.Second)))
+	defer cancel()
+
+	_, err := grpcbreaker.Execute(ctx, csh.carsharingClient.StartRent, csh.convert.StartRentToPb(rentUUID), csh.breaker)
+	if err != nil {
+		handleServiceError(c.Response(), err)
+		return nil
+	}
+
+	c.Status(http.StatusOK)
+	return nil
+}
+
+func (csh *carsharing) CompleteRent(c *fiber.Ctx) error {
+	rentUUID := c.Params("uuid")
+
+	ctx, cancel := context.WithTimeout(c.Context(), time.Duration(csh.writeTimeout.Seconds()*0.80*float64(time.Second)))
+	defer cancel()
+
+	_, err := grpcbreaker.Execute(ctx, csh.carsharingClient.CompleteRent, csh.convert.CompleteRentToPb(rentUUID), csh.breaker)
+	if err != nil {
+		handleServiceError(c.Response(), err)
+		return nil
+	}
+
+	c.Status(http.StatusOK)
+	return nil
+}
+
+func (csh *carsharing) MarkNoShow(c *fiber.Ctx) error {
+	rentUUID := c.Params("uuid")
+
+	ctx, cancel := context.WithTimeout(c.Context(), time.Duration(csh.writeTimeout.Seconds()*0.80*float64(time.Second)))
+	defer cancel()
+
+	_, err := grpcbreaker.Execute(ctx, csh.carsharingClient.MarkNoShow, csh.convert.MarkNoShowToPb(rentUUID), csh.breaker)
 	if err != nil {
 		handleServiceError(c.Response(), err)
 		return nil
@@ -116,6 +193,13 @@ func (csh *carsharing) CreateRent(c *fiber.Ctx) error {
 	ctx, cancel := context.WithTimeout(c.Context(), time.Duration(csh.writeTimeout.Seconds()*0.80*float64(time.Second)))
 	defer cancel()
 
+	ctx, err := withIdempotencyKey(ctx, c)
+	if err != nil {
+		c.Status(http.StatusBadRequest)
+		handleResponseError(c.Send(marshal(models.Error{Err: err.Error()})))
+		return nil
+	}
+
 	token := c.Cookies(middleware.AUTH_TOKEN)
 	res, err := grpcbreaker.Execute(ctx, csh.carsharingClient.CreateRent, csh.convert.CreateRentReqToPb(req, token), csh.breaker)
 	if err != nil {
@@ -130,32 +214,99 @@ func (csh *carsharing) CreateRent(c *fiber.Ctx) error {
 func (csh *carsharing) GetImage(c *fiber.Ctx) error {
 	bucket := c.Params("bucket")
 	id := c.Params("id")
+	variant := c.Query("variant")
 
-	ctx, cancel := context.WithTimeout(c.Context(), time.Duration(csh.writeTimeout.Seconds()*0.80*float64(time.Second)))
-	defer cancel()
+	// the image is never changed after the upload, so its id is enough for the etag
+	etag := imageETag(id, variant)
+	if c.Get(fiber.HeaderIfNoneMatch) == etag {
+		c.Status(http.StatusNotModified)
+		return nil
+	}
+
+	offset, length := parseRange(c.Get(fiber.HeaderRange))
 
-	res, err := grpcbreaker.Execute(ctx, csh.carsharingClient.GetImage, csh.convert.GetImage(bucket, id), csh.breaker)
+	// the stream is read after the handler returns, so the context is canceled by the body reader
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(csh.writeTimeout.Seconds()*0.80*float64(time.Second)))
+
+	stream, err := grpcbreaker.Execute(ctx, csh.carsharingClient.GetImageStream, csh.convert.GetImageStream(bucket, id, variant, offset, length), csh.breaker)
 	if err != nil {
+		cancel()
 		handleServiceError(c.Response(), err)
 		return nil
 	}
 
-	img, _, err := image.Decode(bytes.NewReader((*res).File))
+	// the errors of the stream are returned with the first message
+	first, err := stream.Recv()
 	if err != nil {
-		csh.log.Error("failed to decode bytes to img", slog.String("error", err.Error()))
-		return err
+		cancel()
+		if status.Code(err) == codes.OutOfRange {
+			if size := stream.Trailer().Get(imageSizeMD); len(size) > 0 {
+				c.Set(fiber.HeaderContentRange, "bytes */"+size[0])
+			}
+		}
+		handleServiceError(c.Response(), err)
+		return nil
 	}
 
-	buffer := new(bytes.Buffer)
-	err = jpeg.Encode(buffer, img, nil)
-	if err != nil {
-		csh.log.Error("failed to encode img to bytes", slog.String("error", err.Error()))
-		return err
+	contentLength := first.Size - first.Offset
+	if length > 0 && length < contentLength {
+		contentLength = length
 	}
 
+	c.Set(fiber.HeaderContentType, first.ContentType)
+	c.Set(fiber.HeaderETag, etag)
+	c.Set(fiber.HeaderAcceptRanges, "bytes")
+	c.Set(fiber.HeaderCacheControl, "public, max-age=86400, immutable")
+
 	c.Status(http.StatusOK)
-	c.Response().Header.Set("Content-Type", "image/jpeg")
-	handleResponseError(c.Send(buffer.Bytes()))
+	if offset > 0 || length > 0 {
+		c.Status(http.StatusPartialContent)
+		c.Set(fiber.HeaderContentRange, fmt.Sprintf("bytes %d-%d/%d", first.Offset, first.Offset+contentLength-1, first.Size))
+	}
+
+	c.Context().SetBodyStream(&imageStreamReader{
+		stream: stream,
+		chunk:  first.Data,
+		cancel: cancel,
+	}, int(contentLength))
+	return nil
+}
+
+func (csh *carsharing) UploadCarImage(c *fiber.Ctx) error {
+	carUUID := c.Params("car_uuid")
+
+	file, err := c.FormFile("image")
+	if err != nil {
+		c.Status(http.StatusBadRequest)
+		handleResponseError(c.Send(marshal(models.Error{Err: fmt.Sprintf("image file is required: %v", err)})))
+		return nil
+	}
+
+	f, err := file.Open()
+	if err != nil {
+		c.Status(http.StatusBadRequest)
+		handleResponseError(c.Send(marshal(models.Error{Err: fmt.Sprintf("failed to open file: %v", err)})))
+		return nil
+	}
+	defer f.Close()
+
+	ctx, cancel := context.WithTimeout(c.Context(), time.Duration(csh.writeTimeout.Seconds()*0.80*float64(time.Second)))
+	defer cancel()
+
+	token := c.Context().Value(middleware.AUTH_TOKEN).(string)
+	if ctx, err = csh.checkIfAuthorized(ctx, token); err != nil {
+		c.Status(http.StatusMethodNotAllowed)
+		return nil
+	}
+
+	id, err := uploadImage(ctx, csh.carsharingClient, csh.convert.UploadCarImageInfoToPb(carUUID, c.QueryBool("main")), f)
+	if err != nil {
+		handleServiceError(c.Response(), err)
+		return nil
+	}
+
+	c.Status(http.StatusCreated)
+	handleResponseError(c.Send(marshal(map[string]string{"id": id})))
 	return nil
 }
 
@@ -167,15 +318,23 @@ func (csh *carsharing) GetAvailableCars(c *fiber.Ctx) error {
 		return nil
 	}
 
+	if err := csh.valid.Struct(req); err != nil {
+		c.Status(http.StatusBadRequest)
+		handleResponseError(c.Send(marshal(models.Error{Err: err.Error()})))
+		return nil
+	}
+
 	ctx, cancel := context.WithTimeout(c.Context(), time.Duration(csh.writeTimeout.Seconds()*0.80*float64(time.Second)))
 	defer cancel()
 
-	cars, err := grpcbreaker.Execute(ctx, csh.carsharingClient.GetAvailableCars, nil, csh.breaker)
+	cars, err := grpcbreaker.Execute(ctx, csh.carsharingClient.GetAvailableCars, csh.convert.GetAvailableCarsReqToPb(req), csh.breaker)
 	if err != nil {
 		handleServiceError(c.Response(), err)
 		return nil
 	}
 
+	(*cars).Cars = csh.transformImageLinks((*cars).Cars)
+
 	c.Status(http.StatusOK)
 	handleResponseError(c.Send(marshal(cars)))
 	return nil
@@ -189,6 +348,12 @@ func (csh *carsharing) GetCarsByParams(c *fiber.Ctx) error {
 		return nil
 	}
 
+	if err := csh.valid.Struct(req); err != nil {
+		c.Status(http.StatusBadRequest)
+		handleResponseError(c.Send(marshal(models.Error{Err: err.Error()})))
+		return nil
+	}
+
 	ctx, cancel := context.WithTimeout(c.Context(), time.Duration(csh.writeTimeout.Seconds()*0.80*float64(time.Second)))
 	defer cancel()
 
@@ -198,13 +363,71 @@ func (csh *carsharing) GetCarsByParams(c *fiber.Ctx) error {
 		return nil
 	}
 
-	res := csh.transformImageLinks((*cars).Cars)
+	(*cars).Cars = csh.transformImageLinks((*cars).Cars)
+
+	c.Status(http.StatusOK)
+	handleResponseError(c.Send(marshal(cars)))
+	return nil
+}
+
+func (csh *carsharing) SearchCars(c *fiber.Ctx) error {
+	var req models.SearchCarsReq
+	if err := parseQueryParams(c, &req); err != nil {
+		c.Status(http.StatusBadRequest)
+		handleResponseError(c.Send(marshal(models.Error{Err: err.Error()})))
+		return nil
+	}
+
+	if err := csh.valid.Struct(req); err != nil {
+		c.Status(http.StatusBadRequest)
+		handleResponseError(c.Send(marshal(models.Error{Err: err.Error()})))
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), time.Duration(csh.writeTimeout.Seconds()*0.80*float64(time.Second)))
+	defer cancel()
+
+	res, err := grpcbreaker.Execute(ctx, csh.carsharingClient.SearchCars, csh.convert.SearchCarsReqToPb(req), csh.breaker)
+	if err != nil {
+		handleServiceError(c.Response(), err)
+		return nil
+	}
+
+	(*res).Cars = csh.transformImageLinks((*res).Cars)
 
 	c.Status(http.StatusOK)
 	handleResponseError(c.Send(marshal(res)))
 	return nil
 }
 
+func (csh *carsharing) QuotePrice(c *fiber.Ctx) error {
+	var req models.QuotePriceReq
+	if err := parseQueryParams(c, &req); err != nil {
+		c.Status(http.StatusBadRequest)
+		handleResponseError(c.Send(marshal(models.Error{Err: err.Error()})))
+		return nil
+	}
+
+	if err := csh.valid.Struct(req); err != nil {
+		c.Status(http.StatusBadRequest)
+		handleResponseError(c.Send(marshal(models.Error{Err: err.Error()})))
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), time.Duration(csh.writeTimeout.Seconds()*0.80*float64(time.Second)))
+	defer cancel()
+
+	quote, err := grpcbreaker.Execute(ctx, csh.carsharingClient.QuotePrice, csh.convert.QuotePriceReqToPb(req), csh.breaker)
+	if err != nil {
+		handleServiceError(c.Response(), err)
+		return nil
+	}
+
+	c.Status(http.StatusOK)
+	handleResponseError(c.Send(marshal(quote)))
+	return nil
+}
+
 func (csh *carsharing) GetCarByUUID(c *fiber.Ctx) error {
 	carUUID := c.Params("car_uuid")
 	if len(carUUID) < 10 {
@@ -238,7 +461,14 @@ func (csh *carsharing) DeleteCar(c *fiber.Ctx) error {
 	ctx, cancel := context.WithTimeout(c.Context(), time.Duration(csh.writeTimeout.Seconds()*0.80*float64(time.Second)))
 	defer cancel()
 
-	_, err := grpcbreaker.Execute(ctx, csh.carsharingClient.DeleteCar, csh.convert.DeleteCarReqToPb(carUUID), csh.breaker)
+	token := c.Context().Value(middleware.AUTH_TOKEN).(string)
+	ctx, err := csh.checkIfAuthorized(ctx, token)
+	if err != nil {
+		c.Status(http.StatusMethodNotAllowed)
+		return nil
+	}
+
+	_, err = grpcbreaker.Execute(ctx, csh.carsharingClient.DeleteCar, csh.convert.DeleteCarReqToPb(carUUID), csh.breaker)
 	if err != nil {
 		handleServiceError(c.Response(), err)
 		return nil
@@ -259,10 +489,312 @@ func (csh *carsharing) UpdateCarPrice(c *fiber.Ctx) error {
 	ctx, cancel := context.WithTimeout(c.Context(), time.Duration(csh.writeTimeout.Seconds()*0.80*float64(time.Second)))
 	defer cancel()
 
-	_, err := grpcbreaker.Execute(ctx, csh.carsharingClient.UpdateCarPrice, csh.convert.UpdateCarPriceToPb(req), csh.breaker)
+	token := c.Context().Value(middleware.AUTH_TOKEN).(string)
+	ctx, err := csh.checkIfAuthorized(ctx, token)
+	if err != nil {
+		c.Status(http.StatusMethodNotAllowed)
+		return nil
+	}
+
+	_, err = grpcbreaker.Execute(ctx, csh.carsharingClient.UpdateCarPrice, csh.convert.UpdateCarPriceToPb(req), csh.breaker)
+	if err != nil {
+		handleServiceError(c.Response(), err)
+
+		return nil
+	}
+
+	c.Status(http.StatusOK)
+	return nil
+}
+
+func (csh *carsharing) UpdateCar(c *fiber.Ctx) error {
+	var req models.UpdateCarReq
+	if err := decode(c.Request().Body(), &req, csh.valid); err != nil {
+		c.Status(http.StatusBadRequest)
+		handleResponseError(c.Send(marshal(models.Error{Err: err.Error()})))
+		return nil
+	}
+
+	pbReq := csh.convert.UpdateCarReqToPb(c.Params("car_uuid"), req)
+	if len(pbReq.UpdateMask.Paths) == 0 {
+		c.Status(http.StatusBadRequest)
+		handleResponseError(c.Send(marshal(models.Error{Err: "at least one field has to be updated"})))
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), time.Duration(csh.writeTimeout.Seconds()*0.80*float64(time.Second)))
+	defer cancel()
+
+	token := c.Context().Value(middleware.AUTH_TOKEN).(string)
+	ctx, err := csh.checkIfAuthorized(ctx, token)
+	if err != nil {
+		c.Status(http.StatusMethodNotAllowed)
+		return nil
+	}
+
+	_, err = grpcbreaker.Execute(ctx, csh.carsharingClient.UpdateCar, pbReq, csh.breaker)
+	if err != nil {
+		handleServiceError(c.Response(), err)
+		return nil
+	}
+
+	c.Status(http.StatusOK)
+	return nil
+}
+
+func (csh *carsharing) GetCarChanges(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.Context(), time.Duration(csh.readTimeout.Seconds()*0.80*float64(time.Second)))
+	defer cancel()
+
+	token := c.Context().Value(middleware.AUTH_TOKEN).(string)
+	ctx, err := csh.checkIfAuthorized(ctx, token)
+	if err != nil {
+		c.Status(http.StatusMethodNotAllowed)
+		return nil
+	}
+
+	res, err := grpcbreaker.Execute(ctx, csh.carsharingClient.GetCarChanges, csh.convert.GetCarChangesReqToPb(c.Params("car_uuid")), csh.breaker)
+	if err != nil {
+		handleServiceError(c.Response(), err)
+		return nil
+	}
+
+	c.Status(http.StatusOK)
+	handleResponseError(c.Send(marshal(res)))
+	return nil
+}
+
+// ScheduleMaintenance responds with the rents, which collide with the maintenance, they are not canceled automatically
+func (csh *carsharing) ScheduleMaintenance(c *fiber.Ctx) error {
+	var req models.ScheduleMaintenanceReq
+	if err := decode(c.Request().Body(), &req, csh.valid); err != nil {
+		c.Status(http.StatusBadRequest)
+		handleResponseError(c.Send(marshal(models.Error{Err: err.Error()})))
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), time.Duration(csh.writeTimeout.Seconds()*0.80*float64(time.Second)))
+	defer cancel()
+
+	token := c.Context().Value(middleware.AUTH_TOKEN).(string)
+	ctx, err := csh.checkIfAuthorized(ctx, token)
+	if err != nil {
+		c.Status(http.StatusMethodNotAllowed)
+		return nil
+	}
+
+	res, err := grpcbreaker.Execute(ctx, csh.carsharingClient.ScheduleMaintenance, csh.convert.ScheduleMaintenanceReqToPb(c.Params("car_uuid"), req), csh.breaker)
+	if err != nil {
+		handleServiceError(c.Response(), err)
+		return nil
+	}
+
+	c.Status(http.StatusCreated)
+	handleResponseError(c.Send(marshal(res)))
+	return nil
+}
+
+func (csh *carsharing) CloseMaintenance(c *fiber.Ctx) error {
+	var req models.CloseMaintenanceReq
+	if err := decode(c.Request().Body(), &req, csh.valid); err != nil {
+		c.Status(http.StatusBadRequest)
+		handleResponseError(c.Send(marshal(models.Error{Err: err.Error()})))
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), time.Duration(csh.writeTimeout.Seconds()*0.80*float64(time.Second)))
+	defer cancel()
+
+	token := c.Context().Value(middleware.AUTH_TOKEN).(string)
+	ctx, err := csh.checkIfAuthorized(ctx, token)
+	if err != nil {
+		c.Status(http.StatusMethodNotAllowed)
+		return nil
+	}
+
+	_, err = grpcbreaker.Execute(ctx, csh.carsharingClient.CloseMaintenance, csh.convert.CloseMaintenanceReqToPb(c.Params("uuid"), req), csh.breaker)
+	if err != nil {
+		handleServiceError(c.Response(), err)
+		return nil
+	}
+
+	c.Status(http.StatusOK)
+	return nil
+}
+
+func (csh *carsharing) GetMaintenances(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.Context(), time.Duration(csh.readTimeout.Seconds()*0.80*float64(time.Second)))
+	defer cancel()
+
+	token := c.Context().Value(middleware.AUTH_TOKEN).(string)
+	ctx, err := csh.checkIfAuthorized(ctx, token)
+	if err != nil {
+		c.Status(http.StatusMethodNotAllowed)
+		return nil
+	}
+
+	res, err := grpcbreaker.Execute(ctx, csh.carsharingClient.GetMaintenances, csh.convert.GetMaintenancesReqToPb(c.Params("car_uuid")), csh.breaker)
+	if err != nil {
+		handleServiceError(c.Response(), err)
+		return nil
+	}
+
+	c.Status(http.StatusOK)
+	handleResponseError(c.Send(marshal(res)))
+	return nil
+}
+
+func (csh *carsharing) CreatePromoCode(c *fiber.Ctx) error {
+	var req models.CreatePromoCodeReq
+	if err := decode(c.Request().Body(), &req, csh.valid); err != nil {
+		c.Status(http.StatusBadRequest)
+		handleResponseError(c.Send(marshal(models.Error{Err: err.Error()})))
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), time.Duration(csh.writeTimeout.Seconds()*0.80*float64(time.Second)))
+	defer cancel()
+
+	_, err := grpcbreaker.Execute(ctx, csh.carsharingClient.CreatePromoCode, csh.convert.CreatePromoCodeReqToPb(req), csh.breaker)
+	if err != nil {
+		handleServiceError(c.Response(), err)
+		return nil
+	}
+
+	c.Status(http.StatusCreated)
+	return nil
+}
+
+func (csh *carsharing) CreateStation(c *fiber.Ctx) error {
+	var req models.CreateStationReq
+	if err := decode(c.Request().Body(), &req, csh.valid); err != nil {
+		c.Status(http.StatusBadRequest)
+		handleResponseError(c.Send(marshal(models.Error{Err: err.Error()})))
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), time.Duration(csh.writeTimeout.Seconds()*0.80*float64(time.Second)))
+	defer cancel()
+
+	token := c.Context().Value(middleware.AUTH_TOKEN).(string)
+	ctx, err := csh.checkIfAuthorized(ctx, token)
+	if err != nil {
+		c.Status(http.StatusMethodNotAllowed)
+		return nil
+	}
+
+	res, err := grpcbreaker.Execute(ctx, csh.carsharingClient.CreateStation, csh.convert.CreateStationReqToPb(req), csh.breaker)
 	if err != nil {
 		handleServiceError(c.Response(), err)
+		return nil
+	}
 
+	c.Status(http.StatusCreated)
+	handleResponseError(c.Send(marshal(res)))
+	return nil
+}
+
+func (csh *carsharing) GetStations(c *fiber.Ctx) error {
+	var area models.Area
+	if err := parseQueryParams(c, &area); err != nil {
+		c.Status(http.StatusBadRequest)
+		handleResponseError(c.Send(marshal(models.Error{Err: err.Error()})))
+		return nil
+	}
+
+	if err := csh.valid.Struct(area); err != nil {
+		c.Status(http.StatusBadRequest)
+		handleResponseError(c.Send(marshal(models.Error{Err: err.Error()})))
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), time.Duration(csh.readTimeout.Seconds()*0.80*float64(time.Second)))
+	defer cancel()
+
+	res, err := grpcbreaker.Execute(ctx, csh.carsharingClient.GetStations, csh.convert.GetStationsReqToPb(area), csh.breaker)
+	if err != nil {
+		handleServiceError(c.Response(), err)
+		return nil
+	}
+
+	c.Status(http.StatusOK)
+	handleResponseError(c.Send(marshal(res)))
+	return nil
+}
+
+func (csh *carsharing) ExpirePromoCode(c *fiber.Ctx) error {
+	code := c.Params("code")
+
+	ctx, cancel := context.WithTimeout(c.Context(), time.Duration(csh.writeTimeout.Seconds()*0.80*float64(time.Second)))
+	defer cancel()
+
+	_, err := grpcbreaker.Execute(ctx, csh.carsharingClient.ExpirePromoCode, csh.convert.ExpirePromoCodeReqToPb(code), csh.breaker)
+	if err != nil {
+		handleServiceError(c.Response(), err)
+		return nil
+	}
+
+	c.Status(http.StatusOK)
+	return nil
+}
+
+func (csh *carsharing) GetCarImages(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.Context(), time.Duration(csh.writeTimeout.Seconds()*0.80*float64(time.Second)))
+	defer cancel()
+
+	res, err := grpcbreaker.Execute(ctx, csh.carsharingClient.GetCarImages, csh.convert.GetCarImagesReqToPb(c.Params("car_uuid")), csh.breaker)
+	if err != nil {
+		handleServiceError(c.Response(), err)
+		return nil
+	}
+
+	c.Status(http.StatusOK)
+	handleResponseError(c.Send(marshal(res)))
+	return nil
+}
+
+func (csh *carsharing) DeleteCarImage(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.Context(), time.Duration(csh.writeTimeout.Seconds()*0.80*float64(time.Second)))
+	defer cancel()
+
+	_, err := grpcbreaker.Execute(ctx, csh.carsharingClient.DeleteCarImage, csh.convert.DeleteCarImageReqToPb(c.Params("car_uuid"), c.Params("id")), csh.breaker)
+	if err != nil {
+		handleServiceError(c.Response(), err)
+		return nil
+	}
+
+	c.Status(http.StatusOK)
+	return nil
+}
+
+func (csh *carsharing) ReorderCarImages(c *fiber.Ctx) error {
+	var req models.ReorderCarImagesReq
+	if err := decode(c.Request().Body(), &req, csh.valid); err != nil {
+		c.Status(http.StatusBadRequest)
+		handleResponseError(c.Send(marshal(models.Error{Err: err.Error()})))
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), time.Duration(csh.writeTimeout.Seconds()*0.80*float64(time.Second)))
+	defer cancel()
+
+	_, err := grpcbreaker.Execute(ctx, csh.carsharingClient.ReorderCarImages, csh.convert.ReorderCarImagesReqToPb(c.Params("car_uuid"), req), csh.breaker)
+	if err != nil {
+		handleServiceError(c.Response(), err)
+		return nil
+	}
+
+	c.Status(http.StatusOK)
+	return nil
+}
+
+func (csh *carsharing) SetMainCarImage(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.Context(), time.Duration(csh.writeTimeout.Seconds()*0.80*float64(time.Second)))
+	defer cancel()
+
+	_, err := grpcbreaker.Execute(ctx, csh.carsharingClient.SetMainCarImage, csh.convert.SetMainCarImageReqToPb(c.Params("car_uuid"), c.Params("id")), csh.breaker)
+	if err != nil {
+		handleServiceError(c.Response(), err)
 		return nil
 	}
 
@@ -282,12 +814,13 @@ func (csh *carsharing) CreateCar(c *fiber.Ctx) error {
 	defer cancel()
 
 	token := c.Context().Value(middleware.AUTH_TOKEN).(string)
-	if err := csh.checkIfAuthorized(ctx, token); err != nil {
+	ctx, err := csh.checkIfAuthorized(ctx, token)
+	if err != nil {
 		c.Status(http.StatusMethodNotAllowed)
 		return nil
 	}
 
-	_, err := grpcbreaker.Execute(ctx, csh.carsharingClient.CreateCar, csh.convert.CreateCarReqToPb(req), csh.breaker)
+	_, err = grpcbreaker.Execute(ctx, csh.carsharingClient.CreateCar, csh.convert.CreateCarReqToPb(req), csh.breaker)
 	if err != nil {
 		handleServiceError(c.Response(), err)
 		return nil
@@ -297,34 +830,36 @@ func (csh *carsharing) CreateCar(c *fiber.Ctx) error {
 	return nil
 }
 
-const path = "http://localhost:3001/info/carsharing/car/image"
+const (
+	path = "http://localhost:3001/info/carsharing/car/image"
+	// the catalogue shows the small previews, the full size image is loaded with the car
+	listImageVariant = "thumbnail"
+)
 
 func (csh *carsharing) transformImageLinks(cars []*carsh.CarMainInfo) []*carsh.CarMainInfo {
 	c := make([]*carsh.CarMainInfo, 0, len(cars))
 	for _, car := range cars {
-		car.Image = transformImageInfoToLink(car.UUID, car.Image)
+		car.Image = transformImageInfoToLink(car.UUID, car.Image) + "?variant=" + listImageVariant
 		c = append(c, car)
 	}
 	return c
 }
 
-func (csh *carsharing) checkIfAuthorized(ctx context.Context, token string) error {
-	res, err := grpcbreaker.Execute(ctx, csh.userClient.CheckIfAuthorized, csh.convert.CheckIfAuthorizedReqToPb(token), csh.breaker)
+// checkIfAuthorized returns the context, which passes the uuid of the admin to the service as the actor of the changes,
+// the token and its role are verified offline with the cached keys, see jwks.Verifier for the revocation trade-off
+func (csh *carsharing) checkIfAuthorized(ctx context.Context, token string) (context.Context, error) {
+	claims, err := csh.verifier.Verify(ctx, token)
 	if err != nil {
-		return err
-	}
-
-	if !(*res).IsAuthorized {
-		return &models.Error{
+		return nil, &models.Error{
 			Err: middleware.ERR_NOT_AUTHORIZED,
 		}
 	}
 
-	if (*res).Role != "admin" {
-		return &models.Error{
+	if claims.Role != "admin" {
+		return nil, &models.Error{
 			Err: middleware.ERR_NOT_ALLOWED,
 		}
 	}
 
-	return nil
+	return withActor(ctx, claims.UUID), nil
 }
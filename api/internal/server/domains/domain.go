@@ -1,9 +1,14 @@
 package domains
 
-import "time"
+import (
+	"github.com/alserov/rently/proto/jwks"
+	"time"
+)
 
 type Params[T any] struct {
 	Client       T
 	ReadTimeout  time.Duration
 	WriteTimeout time.Duration
+	// Verifier checks the tokens without the user service
+	Verifier jwks.Verifier
 }
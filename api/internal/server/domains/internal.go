@@ -2,14 +2,18 @@ package domains
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/alserov/rently/api/internal/log"
 	"github.com/alserov/rently/api/internal/models"
+	carsh "github.com/alserov/rently/proto/gen/carsharing"
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
 	"github.com/valyala/fasthttp"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"io"
 	"log/slog"
@@ -25,6 +29,38 @@ func handleResponseError(err error) {
 	}
 }
 
+const (
+	IDEMPOTENCY_KEY_HEADER = "Idempotency-Key"
+	// idempotencyKeyMD is a metadata key, carsharing service reads idempotency key from
+	idempotencyKeyMD = "idempotency-key"
+
+	maxIdempotencyKeyLen = 255
+)
+
+// imageSizeMD is a trailer key, carsharing service sends the size of the image with, when the range is not satisfiable
+const imageSizeMD = "image-size"
+
+// actorMD is a metadata key, carsharing service records the uuid of the admin, who changes the cars, from
+const actorMD = "actor"
+
+func withActor(ctx context.Context, uuid string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, actorMD, uuid)
+}
+
+// withIdempotencyKey passes Idempotency-Key header, if provided, to the service via grpc metadata
+func withIdempotencyKey(ctx context.Context, c *fiber.Ctx) (context.Context, error) {
+	key := c.Get(IDEMPOTENCY_KEY_HEADER)
+	if key == "" {
+		return ctx, nil
+	}
+
+	if len(key) > maxIdempotencyKeyLen {
+		return nil, fmt.Errorf("idempotency key can not be longer than %d symbols", maxIdempotencyKeyLen)
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, idempotencyKeyMD, key), nil
+}
+
 func marshal(in interface{}) []byte {
 	b, err := json.Marshal(in)
 	if err != nil {
@@ -138,13 +174,21 @@ func parseQueryParams(c *fiber.Ctx, target any) error {
 		panic("unexpected type: " + val.Kind().String())
 	}
 
-	params := c.Queries()
-	if len(params) == 0 {
-		return fmt.Errorf("no parameters provided")
-	}
+	return setQueryParams(val, c.Queries())
+}
 
+// setQueryParams sets struct fields by their json tags, fields of embedded structs are set as well
+func setQueryParams(val reflect.Value, params map[string]string) error {
 	for i := 0; i < val.NumField(); i++ {
 		f := val.Field(i)
+
+		if val.Type().Field(i).Anonymous && f.Kind() == reflect.Struct {
+			if err := setQueryParams(f, params); err != nil {
+				return err
+			}
+			continue
+		}
+
 		tag := strings.Split(val.Type().Field(i).Tag.Get("json"), ",")[0]
 
 		if _, ok := params[tag]; ok {
@@ -155,6 +199,24 @@ func parseQueryParams(c *fiber.Ctx, target any) error {
 					return fmt.Errorf("invalid parameter type: %s", tag)
 				}
 				f.SetInt(int64(value))
+			case int32:
+				value, err := strconv.ParseInt(params[tag], 10, 32)
+				if err != nil {
+					return fmt.Errorf("invalid parameter type: %s", tag)
+				}
+				f.SetInt(value)
+			case int64:
+				value, err := strconv.ParseInt(params[tag], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid parameter type: %s", tag)
+				}
+				f.SetInt(value)
+			case bool:
+				value, err := strconv.ParseBool(params[tag])
+				if err != nil {
+					return fmt.Errorf("invalid parameter type: %s", tag)
+				}
+				f.SetBool(value)
 			case string:
 				f.SetString(params[tag])
 			case float32:
@@ -163,6 +225,12 @@ func parseQueryParams(c *fiber.Ctx, target any) error {
 					return fmt.Errorf("invalid parameter type: %s", tag)
 				}
 				f.SetFloat(value)
+			case float64:
+				value, err := strconv.ParseFloat(params[tag], 64)
+				if err != nil {
+					return fmt.Errorf("invalid parameter type: %s", tag)
+				}
+				f.SetFloat(value)
 			}
 		}
 	}
@@ -170,6 +238,104 @@ func parseQueryParams(c *fiber.Ctx, target any) error {
 	return nil
 }
 
+// IMAGE_CHUNK_SIZE is the size of the chunks of the uploaded images
+const IMAGE_CHUNK_SIZE = 64 << 10
+
+// uploadImage sends the info and then the file by chunks, so the file size is not limited by the gRPC message size
+func uploadImage(ctx context.Context, client carsh.CarsClient, info *carsh.UploadCarImageInfo, f io.Reader) (string, error) {
+	stream, err := client.UploadCarImage(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if err = stream.Send(&carsh.UploadCarImageReq{Data: &carsh.UploadCarImageReq_Info{Info: info}}); err != nil && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+
+	buf := make([]byte, IMAGE_CHUNK_SIZE)
+	for err == nil {
+		var n int
+		n, err = f.Read(buf)
+		if n > 0 {
+			// io.EOF means that the service has stopped the stream, the reason is returned by CloseAndRecv
+			if sendErr := stream.Send(&carsh.UploadCarImageReq{Data: &carsh.UploadCarImageReq_Chunk{Chunk: buf[:n]}}); sendErr != nil {
+				err = sendErr
+			}
+		}
+	}
+	if !errors.Is(err, io.EOF) {
+		return "", status.Error(codes.InvalidArgument, fmt.Sprintf("failed to read file: %v", err))
+	}
+
+	res, err := stream.CloseAndRecv()
+	if err != nil {
+		return "", err
+	}
+
+	return res.Id, nil
+}
+
+// imageStreamReader reads the body of the response from the image stream, it is closed by fasthttp
+type imageStreamReader struct {
+	stream carsh.Cars_GetImageStreamClient
+	chunk  []byte
+	cancel context.CancelFunc
+}
+
+func (r *imageStreamReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		res, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.chunk = res.Data
+	}
+
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	return n, nil
+}
+
+func (r *imageStreamReader) Close() error {
+	r.cancel()
+	return nil
+}
+
+func imageETag(id string, variant string) string {
+	if variant == "" {
+		variant = "original"
+	}
+	return fmt.Sprintf(`"%s-%s"`, id, variant)
+}
+
+// parseRange parses the single range of the Range header, the ranges, which are not supported,
+// e.g. multiple or suffix ones, and the invalid ones are ignored and the whole image is sent,
+// the size of the image is unknown here, so only the service responds with 416
+func parseRange(header string) (offset int64, length int64) {
+	spec, found := strings.CutPrefix(header, "bytes=")
+	if header == "" || !found || strings.Contains(spec, ",") || strings.HasPrefix(spec, "-") {
+		return 0, 0
+	}
+
+	first, last, _ := strings.Cut(spec, "-")
+
+	offset, err := strconv.ParseInt(first, 10, 64)
+	if err != nil || offset < 0 {
+		return 0, 0
+	}
+
+	if last == "" {
+		return offset, 0
+	}
+
+	end, err := strconv.ParseInt(last, 10, 64)
+	if err != nil || end < offset {
+		return 0, 0
+	}
+
+	return offset, end - offset + 1
+}
+
 func transformImageInfoToLink(bucket string, id string) string {
 	return fmt.Sprintf("%s/%s/%s", path, bucket, id)
 }
@@ -188,6 +354,28 @@ func handleServiceError(w *fasthttp.Response, err error) {
 			w.SetBody(marshal(models.Error{
 				Err: st.Message(),
 			}))
+		case codes.Unauthenticated:
+			w.SetStatusCode(http.StatusUnauthorized)
+			w.SetBody(marshal(models.Error{
+				Err: st.Message(),
+			}))
+		case codes.PermissionDenied:
+			w.SetStatusCode(http.StatusForbidden)
+			w.SetBody(marshal(models.Error{
+				Err: st.Message(),
+			}))
+		case codes.FailedPrecondition:
+			w.SetStatusCode(http.StatusConflict)
+			w.SetBody(marshal(models.Error{
+				Err: st.Message(),
+			}))
+		case codes.ResourceExhausted:
+			w.SetStatusCode(http.StatusRequestEntityTooLarge)
+			w.SetBody(marshal(models.Error{
+				Err: st.Message(),
+			}))
+		case codes.OutOfRange:
+			w.SetStatusCode(http.StatusRequestedRangeNotSatisfiable)
 		default:
 			log.GetLogger().Error("unknown service error", slog.String("error", err.Error()))
 			w.SetStatusCode(http.StatusInternalServerError)
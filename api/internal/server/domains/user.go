@@ -6,16 +6,29 @@ import (
 	"github.com/alserov/rently/api/internal/models"
 	"github.com/alserov/rently/api/internal/utils/converter"
 	usr "github.com/alserov/rently/proto/gen/user"
+	"github.com/alserov/rently/proto/jwks"
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"net/http"
 	"time"
 )
 
+const JWKS_CACHE_CONTROL = "public, max-age=300"
+
 type User interface {
 	Register(c *fiber.Ctx) error
 	Login(c *fiber.Ctx) error
 	ResetPassword(c *fiber.Ctx) error
+	RequestPasswordReset(c *fiber.Ctx) error
+	ConfirmPasswordReset(c *fiber.Ctx) error
+	VerifyEmail(c *fiber.Ctx) error
+	ResendEmailVerification(c *fiber.Ctx) error
+	RefreshToken(c *fiber.Ctx) error
+	Logout(c *fiber.Ctx) error
+	ListSessions(c *fiber.Ctx) error
+	RevokeSession(c *fiber.Ctx) error
+	GetJWKS(c *fiber.Ctx) error
 }
 
 func NewUser(p Params[usr.UserClient]) User {
@@ -62,6 +75,91 @@ func (u *user) ResetPassword(c *fiber.Ctx) error {
 	return nil
 }
 
+// RequestPasswordReset responds the same for the unknown emails, so they can not be checked with it
+func (u *user) RequestPasswordReset(c *fiber.Ctx) error {
+	var req models.RequestPasswordResetReq
+	if err := decode(c.Request().Body(), &req, u.valid); err != nil {
+		handleResponseError(c.Status(http.StatusBadRequest).Send(marshal(models.Error{Err: err.Error()})))
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), time.Duration(u.writeTimeout.Seconds()*0.80*float64(time.Second)))
+	defer cancel()
+
+	_, err := u.userClient.RequestPasswordReset(ctx, u.convert.RequestPasswordResetReqToPb(req))
+	if err != nil {
+		handleServiceError(c.Response(), err)
+		return nil
+	}
+
+	c.Status(http.StatusAccepted)
+	return nil
+}
+
+// ConfirmPasswordReset all the sessions are revoked by the reset, so the cookies of this client are cleared too
+func (u *user) ConfirmPasswordReset(c *fiber.Ctx) error {
+	var req models.ConfirmPasswordResetReq
+	if err := decode(c.Request().Body(), &req, u.valid); err != nil {
+		handleResponseError(c.Status(http.StatusBadRequest).Send(marshal(models.Error{Err: err.Error()})))
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), time.Duration(u.writeTimeout.Seconds()*0.80*float64(time.Second)))
+	defer cancel()
+
+	_, err := u.userClient.ConfirmPasswordReset(ctx, u.convert.ConfirmPasswordResetReqToPb(req))
+	if err != nil {
+		handleServiceError(c.Response(), err)
+		return nil
+	}
+
+	c.ClearCookie(middleware.AUTH_TOKEN, middleware.REFRESH_TOKEN)
+
+	c.Status(http.StatusOK)
+	return nil
+}
+
+func (u *user) VerifyEmail(c *fiber.Ctx) error {
+	var req models.VerifyEmailReq
+	if err := decode(c.Request().Body(), &req, u.valid); err != nil {
+		handleResponseError(c.Status(http.StatusBadRequest).Send(marshal(models.Error{Err: err.Error()})))
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), time.Duration(u.writeTimeout.Seconds()*0.80*float64(time.Second)))
+	defer cancel()
+
+	_, err := u.userClient.VerifyEmail(ctx, u.convert.VerifyEmailReqToPb(req))
+	if err != nil {
+		handleServiceError(c.Response(), err)
+		return nil
+	}
+
+	c.Status(http.StatusOK)
+	return nil
+}
+
+// ResendEmailVerification responds the same for the unknown and verified emails, so they can not be checked with it
+func (u *user) ResendEmailVerification(c *fiber.Ctx) error {
+	var req models.ResendEmailVerificationReq
+	if err := decode(c.Request().Body(), &req, u.valid); err != nil {
+		handleResponseError(c.Status(http.StatusBadRequest).Send(marshal(models.Error{Err: err.Error()})))
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), time.Duration(u.writeTimeout.Seconds()*0.80*float64(time.Second)))
+	defer cancel()
+
+	_, err := u.userClient.ResendEmailVerification(ctx, u.convert.ResendEmailVerificationReqToPb(req))
+	if err != nil {
+		handleServiceError(c.Response(), err)
+		return nil
+	}
+
+	c.Status(http.StatusAccepted)
+	return nil
+}
+
 func (u *user) Register(c *fiber.Ctx) error {
 	var req models.RegisterReq
 	if err := decode(c.Request().Body(), &req, u.valid); err != nil {
@@ -69,6 +167,8 @@ func (u *user) Register(c *fiber.Ctx) error {
 		return nil
 	}
 
+	req.Client = clientFromRequest(c)
+
 	ctx, cancel := context.WithTimeout(c.Context(), time.Duration(u.writeTimeout.Seconds()*0.80*float64(time.Second)))
 	defer cancel()
 
@@ -78,10 +178,7 @@ func (u *user) Register(c *fiber.Ctx) error {
 		return nil
 	}
 
-	c.Cookie(&fiber.Cookie{
-		Name:  middleware.AUTH_TOKEN,
-		Value: res.Token,
-	})
+	setAuthCookies(c, res.Token, res.RefreshToken)
 
 	handleResponseError(c.Send(marshal(models.RegisterRes{UUID: res.UUID})))
 	return nil
@@ -94,6 +191,8 @@ func (u *user) Login(c *fiber.Ctx) error {
 		return nil
 	}
 
+	req.Client = clientFromRequest(c)
+
 	ctx, cancel := context.WithTimeout(c.Context(), time.Duration(u.readTimeout.Seconds()*0.80*float64(time.Second)))
 	defer cancel()
 
@@ -103,11 +202,113 @@ func (u *user) Login(c *fiber.Ctx) error {
 		return nil
 	}
 
+	setAuthCookies(c, res.Token, res.RefreshToken)
+
+	c.Status(http.StatusOK)
+	return nil
+}
+
+// RefreshToken the refresh token is rotated, so both cookies are replaced
+func (u *user) RefreshToken(c *fiber.Ctx) error {
+	refreshToken := c.Cookies(middleware.REFRESH_TOKEN)
+	if refreshToken == "" {
+		handleResponseError(c.Status(http.StatusUnauthorized).Send(marshal(models.Error{Err: middleware.ERR_NOT_AUTHORIZED})))
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), time.Duration(u.writeTimeout.Seconds()*0.80*float64(time.Second)))
+	defer cancel()
+
+	res, err := u.userClient.RefreshToken(ctx, u.convert.RefreshTokenReqToPb(refreshToken))
+	if err != nil {
+		handleServiceError(c.Response(), err)
+		return nil
+	}
+
+	setAuthCookies(c, res.Token, res.RefreshToken)
+
+	c.Status(http.StatusOK)
+	return nil
+}
+
+func (u *user) Logout(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.Context(), time.Duration(u.writeTimeout.Seconds()*0.80*float64(time.Second)))
+	defer cancel()
+
+	_, err := u.userClient.Logout(ctx, u.convert.LogoutReqToPb(c.Cookies(middleware.AUTH_TOKEN)))
+	if err != nil {
+		handleServiceError(c.Response(), err)
+		return nil
+	}
+
+	c.ClearCookie(middleware.AUTH_TOKEN, middleware.REFRESH_TOKEN)
+
+	c.Status(http.StatusOK)
+	return nil
+}
+
+func (u *user) ListSessions(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.Context(), time.Duration(u.readTimeout.Seconds()*0.80*float64(time.Second)))
+	defer cancel()
+
+	res, err := u.userClient.ListSessions(ctx, u.convert.ListSessionsReqToPb(c.Cookies(middleware.AUTH_TOKEN)))
+	if err != nil {
+		handleServiceError(c.Response(), err)
+		return nil
+	}
+
+	c.Status(http.StatusOK)
+	handleResponseError(c.Send(marshal(res)))
+	return nil
+}
+
+func (u *user) RevokeSession(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.Context(), time.Duration(u.writeTimeout.Seconds()*0.80*float64(time.Second)))
+	defer cancel()
+
+	_, err := u.userClient.RevokeSession(ctx, u.convert.RevokeSessionReqToPb(c.Cookies(middleware.AUTH_TOKEN), c.Params("id")))
+	if err != nil {
+		handleServiceError(c.Response(), err)
+		return nil
+	}
+
+	c.Status(http.StatusOK)
+	return nil
+}
+
+// GetJWKS the keys are cached by the clients for a while, the rotated keys are published before they sign the tokens
+func (u *user) GetJWKS(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.Context(), time.Duration(u.readTimeout.Seconds()*0.80*float64(time.Second)))
+	defer cancel()
+
+	res, err := u.userClient.GetJWKS(ctx, &emptypb.Empty{})
+	if err != nil {
+		handleServiceError(c.Response(), err)
+		return nil
+	}
+
+	c.Set(fiber.HeaderCacheControl, JWKS_CACHE_CONTROL)
+	c.Status(http.StatusOK)
+	handleResponseError(c.Send(marshal(jwks.FromPb(res))))
+	return nil
+}
+
+func setAuthCookies(c *fiber.Ctx, token string, refreshToken string) {
 	c.Cookie(&fiber.Cookie{
 		Name:  middleware.AUTH_TOKEN,
-		Value: res.Token,
+		Value: token,
+	})
+	c.Cookie(&fiber.Cookie{
+		Name:     middleware.REFRESH_TOKEN,
+		Value:    refreshToken,
+		HTTPOnly: true,
+		SameSite: fiber.CookieSameSiteStrictMode,
 	})
+}
 
-	c.Status(http.StatusOK)
-	return nil
+func clientFromRequest(c *fiber.Ctx) models.Client {
+	return models.Client{
+		UserAgent: c.Get(fiber.HeaderUserAgent),
+		IP:        c.IP(),
+	}
 }
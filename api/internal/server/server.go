@@ -4,6 +4,7 @@ import (
 	"github.com/alserov/rently/api/internal/clients"
 	"github.com/alserov/rently/api/internal/server/domains"
 	"github.com/alserov/rently/proto/gen/user"
+	"github.com/alserov/rently/proto/jwks"
 	"time"
 )
 
@@ -18,6 +19,8 @@ type Params struct {
 	ReadTimeout time.Duration
 
 	WriteTimeout time.Duration
+
+	Verifier jwks.Verifier
 }
 
 func NewServer(p Params) *Server {
@@ -29,6 +32,7 @@ func NewServer(p Params) *Server {
 			},
 			ReadTimeout:  p.ReadTimeout,
 			WriteTimeout: p.WriteTimeout,
+			Verifier:     p.Verifier,
 		}),
 		User: domains.NewUser(domains.Params[user.UserClient]{
 			Client:       p.Clients.UserClient,
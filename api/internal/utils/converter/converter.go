@@ -4,6 +4,7 @@ import (
 	"github.com/alserov/rently/api/internal/models"
 	"github.com/alserov/rently/proto/gen/carsharing"
 	"github.com/alserov/rently/proto/gen/user"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"time"
 )
@@ -12,16 +13,44 @@ type Converter interface {
 	CreateCarReqToPb(req models.CreateCarReq) *carsharing.CreateCarReq
 	DeleteCarReqToPb(uuid string) *carsharing.DeleteCarReq
 	UpdateCarPriceToPb(req models.UpdateCarPriceReq) *carsharing.UpdateCarPriceReq
+	UpdateCarReqToPb(carUUID string, req models.UpdateCarReq) *carsharing.UpdateCarReq
+	GetCarChangesReqToPb(carUUID string) *carsharing.GetCarChangesReq
+	ScheduleMaintenanceReqToPb(carUUID string, req models.ScheduleMaintenanceReq) *carsharing.ScheduleMaintenanceReq
+	CloseMaintenanceReqToPb(uuid string, req models.CloseMaintenanceReq) *carsharing.CloseMaintenanceReq
+	GetMaintenancesReqToPb(carUUID string) *carsharing.GetMaintenancesReq
 	GetCarByUUIDReqToPb(uuid string) *carsharing.GetCarByUUIDReq
 	GetCarsParamsReqToPb(req models.GetCarsByParamsReq) *carsharing.GetCarsByParamsReq
-	GetImage(bucket string, id string) *carsharing.GetImageReq
+	GetAvailableCarsReqToPb(req models.GetAvailableCarsReq) *carsharing.GetAvailableCarsReq
+	SearchCarsReqToPb(req models.SearchCarsReq) *carsharing.SearchCarsReq
+	GetImageStream(bucket string, id string, variant string, offset, length int64) *carsharing.GetImageStreamReq
+	UploadCarImageInfoToPb(carUUID string, main bool) *carsharing.UploadCarImageInfo
+	GetCarImagesReqToPb(carUUID string) *carsharing.GetCarImagesReq
+	DeleteCarImageReqToPb(carUUID string, id string) *carsharing.DeleteCarImageReq
+	ReorderCarImagesReqToPb(carUUID string, req models.ReorderCarImagesReq) *carsharing.ReorderCarImagesReq
+	SetMainCarImageReqToPb(carUUID string, id string) *carsharing.SetMainCarImageReq
 	CheckIfAuthorizedReqToPb(token string) *user.CheckIfAuthorizedReq
 	RegisterReqToPb(req models.RegisterReq) *user.RegisterReq
 	LoginReqToPb(req models.LoginReq) *user.LoginReq
+	RefreshTokenReqToPb(refreshToken string) *user.RefreshTokenReq
+	LogoutReqToPb(token string) *user.LogoutReq
+	ListSessionsReqToPb(token string) *user.ListSessionsReq
+	RevokeSessionReqToPb(token string, sessionID string) *user.RevokeSessionReq
 	CreateRentReqToPb(req models.CreateRentReq, token string) *carsharing.CreateRentReq
+	QuotePriceReqToPb(req models.QuotePriceReq) *carsharing.QuotePriceReq
+	CreateStationReqToPb(req models.CreateStationReq) *carsharing.CreateStationReq
+	GetStationsReqToPb(area models.Area) *carsharing.GetStationsReq
+	CreatePromoCodeReqToPb(req models.CreatePromoCodeReq) *carsharing.CreatePromoCodeReq
+	ExpirePromoCodeReqToPb(code string) *carsharing.ExpirePromoCodeReq
 	ResetPasswordReqToPb(req models.ResetPasswordReq) *user.ResetPasswordReq
+	RequestPasswordResetReqToPb(req models.RequestPasswordResetReq) *user.RequestPasswordResetReq
+	ConfirmPasswordResetReqToPb(req models.ConfirmPasswordResetReq) *user.ConfirmPasswordResetReq
+	VerifyEmailReqToPb(req models.VerifyEmailReq) *user.VerifyEmailReq
+	ResendEmailVerificationReqToPb(req models.ResendEmailVerificationReq) *user.ResendEmailVerificationReq
 	CancelRentToPb(rentUUID string) *carsharing.CancelRentReq
 	CheckRentToPb(rentUUID string) *carsharing.CheckRentReq
+	StartRentToPb(rentUUID string) *carsharing.StartRentReq
+	CompleteRentToPb(rentUUID string) *carsharing.CompleteRentReq
+	MarkNoShowToPb(rentUUID string) *carsharing.MarkNoShowReq
 }
 
 func NewConverter() Converter {
@@ -37,6 +66,24 @@ func (s *converter) CheckRentToPb(rentUUID string) *carsharing.CheckRentReq {
 	}
 }
 
+func (s *converter) StartRentToPb(rentUUID string) *carsharing.StartRentReq {
+	return &carsharing.StartRentReq{
+		RentUUID: rentUUID,
+	}
+}
+
+func (s *converter) CompleteRentToPb(rentUUID string) *carsharing.CompleteRentReq {
+	return &carsharing.CompleteRentReq{
+		RentUUID: rentUUID,
+	}
+}
+
+func (s *converter) MarkNoShowToPb(rentUUID string) *carsharing.MarkNoShowReq {
+	return &carsharing.MarkNoShowReq{
+		RentUUID: rentUUID,
+	}
+}
+
 func (s *converter) CancelRentToPb(rentUUID string) *carsharing.CancelRentReq {
 	return &carsharing.CancelRentReq{
 		RentUUID: rentUUID,
@@ -51,13 +98,42 @@ func (s *converter) ResetPasswordReqToPb(req models.ResetPasswordReq) *user.Rese
 	}
 }
 
+func (s *converter) RequestPasswordResetReqToPb(req models.RequestPasswordResetReq) *user.RequestPasswordResetReq {
+	return &user.RequestPasswordResetReq{
+		Email: req.Email,
+	}
+}
+
+func (s *converter) ConfirmPasswordResetReqToPb(req models.ConfirmPasswordResetReq) *user.ConfirmPasswordResetReq {
+	return &user.ConfirmPasswordResetReq{
+		Code:        req.Code,
+		NewPassword: req.NewPassword,
+	}
+}
+
+func (s *converter) VerifyEmailReqToPb(req models.VerifyEmailReq) *user.VerifyEmailReq {
+	return &user.VerifyEmailReq{
+		Code: req.Code,
+	}
+}
+
+func (s *converter) ResendEmailVerificationReqToPb(req models.ResendEmailVerificationReq) *user.ResendEmailVerificationReq {
+	return &user.ResendEmailVerificationReq{
+		Email: req.Email,
+	}
+}
+
 func (s *converter) CreateRentReqToPb(req models.CreateRentReq, token string) *carsharing.CreateRentReq {
 	return &carsharing.CreateRentReq{
 		CarUUID:        req.CarUUID,
 		PhoneNumber:    req.PhoneNumber,
 		PassportNumber: req.PassportNumber,
 		PaymentSource:  req.PaymentSource,
+		Email:          req.Email,
+		PromoCode:      req.PromoCode,
 		Token:          token,
+		PickupStation:  req.PickupStation,
+		DropOffStation: req.DropOffStation,
 		RentStart: &timestamppb.Timestamp{
 			Seconds: time.Unix(req.RentStart, 0).Unix(),
 			Nanos:   int32(time.Unix(req.RentStart, 0).Nanosecond()),
@@ -69,6 +145,87 @@ func (s *converter) CreateRentReqToPb(req models.CreateRentReq, token string) *c
 	}
 }
 
+func (s *converter) QuotePriceReqToPb(req models.QuotePriceReq) *carsharing.QuotePriceReq {
+	return &carsharing.QuotePriceReq{
+		CarUUID:        req.CarUUID,
+		RentStart:      timestamppb.New(time.Unix(req.RentStart, 0)),
+		RentEnd:        timestamppb.New(time.Unix(req.RentEnd, 0)),
+		PickupStation:  req.PickupStation,
+		DropOffStation: req.DropOffStation,
+	}
+}
+
+func (s *converter) CreateStationReqToPb(req models.CreateStationReq) *carsharing.CreateStationReq {
+	return &carsharing.CreateStationReq{
+		Station: &carsharing.Station{
+			Name:      req.Name,
+			Address:   req.Address,
+			Latitude:  req.Latitude,
+			Longitude: req.Longitude,
+			OpensAt:   req.OpensAt,
+			ClosesAt:  req.ClosesAt,
+			TimeZone:  req.TimeZone,
+			Capacity:  req.Capacity,
+		},
+	}
+}
+
+func (s *converter) GetStationsReqToPb(area models.Area) *carsharing.GetStationsReq {
+	return &carsharing.GetStationsReq{
+		Near: s.areaToPb(area),
+	}
+}
+
+// areaToPb zero radius means no distance filter
+func (s *converter) areaToPb(area models.Area) *carsharing.Area {
+	if area.RadiusKm == 0 {
+		return nil
+	}
+
+	return &carsharing.Area{
+		Latitude:  area.Latitude,
+		Longitude: area.Longitude,
+		RadiusKm:  area.RadiusKm,
+	}
+}
+
+// DEFAULT_CURRENCY is used for the fixed discounts without currency
+const DEFAULT_CURRENCY = "USD"
+
+func (s *converter) CreatePromoCodeReqToPb(req models.CreatePromoCodeReq) *carsharing.CreatePromoCodeReq {
+	promo := &carsharing.CreatePromoCodeReq{
+		Code:           req.Code,
+		Percent:        req.Percent,
+		MaxUses:        req.MaxUses,
+		MaxUsesPerUser: req.MaxUsesPerUser,
+		Categories:     req.Categories,
+	}
+
+	if req.Amount != 0 {
+		currency := req.Currency
+		if currency == "" {
+			currency = DEFAULT_CURRENCY
+		}
+		promo.Amount = &carsharing.Money{Amount: req.Amount, Currency: currency}
+	}
+
+	if req.ValidFrom != 0 {
+		promo.ValidFrom = timestamppb.New(time.Unix(req.ValidFrom, 0))
+	}
+
+	if req.ValidUntil != 0 {
+		promo.ValidUntil = timestamppb.New(time.Unix(req.ValidUntil, 0))
+	}
+
+	return promo
+}
+
+func (s *converter) ExpirePromoCodeReqToPb(code string) *carsharing.ExpirePromoCodeReq {
+	return &carsharing.ExpirePromoCodeReq{
+		Code: code,
+	}
+}
+
 func (s *converter) RegisterReqToPb(req models.RegisterReq) *user.RegisterReq {
 	return &user.RegisterReq{
 		Username:       req.Username,
@@ -77,6 +234,7 @@ func (s *converter) RegisterReqToPb(req models.RegisterReq) *user.RegisterReq {
 		PassportNumber: req.PassportNumber,
 		PaymentSource:  req.PaymentSource,
 		PhoneNumber:    req.PhoneNumber,
+		Client:         clientToPb(req.Client),
 	}
 }
 
@@ -84,6 +242,39 @@ func (s *converter) LoginReqToPb(req models.LoginReq) *user.LoginReq {
 	return &user.LoginReq{
 		Password: req.Password,
 		Email:    req.Email,
+		Client:   clientToPb(req.Client),
+	}
+}
+
+func (s *converter) RefreshTokenReqToPb(refreshToken string) *user.RefreshTokenReq {
+	return &user.RefreshTokenReq{
+		RefreshToken: refreshToken,
+	}
+}
+
+func (s *converter) LogoutReqToPb(token string) *user.LogoutReq {
+	return &user.LogoutReq{
+		Token: token,
+	}
+}
+
+func (s *converter) ListSessionsReqToPb(token string) *user.ListSessionsReq {
+	return &user.ListSessionsReq{
+		Token: token,
+	}
+}
+
+func (s *converter) RevokeSessionReqToPb(token string, sessionID string) *user.RevokeSessionReq {
+	return &user.RevokeSessionReq{
+		Token:     token,
+		SessionID: sessionID,
+	}
+}
+
+func clientToPb(client models.Client) *user.Client {
+	return &user.Client{
+		UserAgent: client.UserAgent,
+		IP:        client.IP,
 	}
 }
 
@@ -93,10 +284,47 @@ func (s *converter) CheckIfAuthorizedReqToPb(token string) *user.CheckIfAuthoriz
 	}
 }
 
-func (s *converter) GetImage(bucket string, id string) *carsharing.GetImageReq {
-	return &carsharing.GetImageReq{
-		Bucket: bucket,
-		Id:     id,
+func (s *converter) GetImageStream(bucket string, id string, variant string, offset, length int64) *carsharing.GetImageStreamReq {
+	return &carsharing.GetImageStreamReq{
+		Bucket:  bucket,
+		Id:      id,
+		Variant: variant,
+		Offset:  offset,
+		Length:  length,
+	}
+}
+
+func (s *converter) UploadCarImageInfoToPb(carUUID string, main bool) *carsharing.UploadCarImageInfo {
+	return &carsharing.UploadCarImageInfo{
+		CarUUID: carUUID,
+		Main:    main,
+	}
+}
+
+func (s *converter) GetCarImagesReqToPb(carUUID string) *carsharing.GetCarImagesReq {
+	return &carsharing.GetCarImagesReq{
+		CarUUID: carUUID,
+	}
+}
+
+func (s *converter) DeleteCarImageReqToPb(carUUID string, id string) *carsharing.DeleteCarImageReq {
+	return &carsharing.DeleteCarImageReq{
+		CarUUID: carUUID,
+		Id:      id,
+	}
+}
+
+func (s *converter) ReorderCarImagesReqToPb(carUUID string, req models.ReorderCarImagesReq) *carsharing.ReorderCarImagesReq {
+	return &carsharing.ReorderCarImagesReq{
+		CarUUID: carUUID,
+		Ids:     req.IDs,
+	}
+}
+
+func (s *converter) SetMainCarImageReqToPb(carUUID string, id string) *carsharing.SetMainCarImageReq {
+	return &carsharing.SetMainCarImageReq{
+		CarUUID: carUUID,
+		Id:      id,
 	}
 }
 
@@ -107,6 +335,86 @@ func (s *converter) UpdateCarPriceToPb(req models.UpdateCarPriceReq) *carsharing
 	}
 }
 
+// UpdateCarReqToPb the mask consists of the set fields
+func (s *converter) UpdateCarReqToPb(carUUID string, req models.UpdateCarReq) *carsharing.UpdateCarReq {
+	var (
+		car  carsharing.Car
+		mask fieldmaskpb.FieldMask
+	)
+
+	if req.Brand != nil {
+		car.Brand = *req.Brand
+		mask.Paths = append(mask.Paths, "Brand")
+	}
+	if req.Type != nil {
+		car.Type = *req.Type
+		mask.Paths = append(mask.Paths, "Type")
+	}
+	if req.MaxSpeed != nil {
+		car.MaxSpeed = *req.MaxSpeed
+		mask.Paths = append(mask.Paths, "MaxSpeed")
+	}
+	if req.Seats != nil {
+		car.Seats = *req.Seats
+		mask.Paths = append(mask.Paths, "Seats")
+	}
+	if req.Category != nil {
+		car.Category = *req.Category
+		mask.Paths = append(mask.Paths, "Category")
+	}
+	if req.Price != nil {
+		car.Price = &carsharing.Money{Amount: *req.Price, Currency: req.Currency}
+		mask.Paths = append(mask.Paths, "Price")
+	}
+	if req.StationUUID != nil {
+		car.StationUUID = *req.StationUUID
+		mask.Paths = append(mask.Paths, "StationUUID")
+	}
+
+	return &carsharing.UpdateCarReq{
+		CarUUID:    carUUID,
+		Car:        &car,
+		UpdateMask: &mask,
+	}
+}
+
+func (s *converter) ScheduleMaintenanceReqToPb(carUUID string, req models.ScheduleMaintenanceReq) *carsharing.ScheduleMaintenanceReq {
+	m := &carsharing.ScheduleMaintenanceReq{
+		CarUUID:    carUUID,
+		Kind:       req.Kind,
+		Reason:     req.Reason,
+		IntervalKm: req.IntervalKm,
+	}
+
+	if req.Start != 0 {
+		m.Start = timestamppb.New(time.Unix(req.Start, 0))
+	}
+	if req.End != 0 {
+		m.End = timestamppb.New(time.Unix(req.End, 0))
+	}
+
+	return m
+}
+
+func (s *converter) CloseMaintenanceReqToPb(uuid string, req models.CloseMaintenanceReq) *carsharing.CloseMaintenanceReq {
+	return &carsharing.CloseMaintenanceReq{
+		UUID:    uuid,
+		Mileage: req.Mileage,
+	}
+}
+
+func (s *converter) GetMaintenancesReqToPb(carUUID string) *carsharing.GetMaintenancesReq {
+	return &carsharing.GetMaintenancesReq{
+		CarUUID: carUUID,
+	}
+}
+
+func (s *converter) GetCarChangesReqToPb(carUUID string) *carsharing.GetCarChangesReq {
+	return &carsharing.GetCarChangesReq{
+		CarUUID: carUUID,
+	}
+}
+
 func (s *converter) GetCarByUUIDReqToPb(uuid string) *carsharing.GetCarByUUIDReq {
 	return &carsharing.GetCarByUUIDReq{
 		UUID: uuid,
@@ -114,13 +422,74 @@ func (s *converter) GetCarByUUIDReqToPb(uuid string) *carsharing.GetCarByUUIDReq
 }
 
 func (s *converter) GetCarsParamsReqToPb(req models.GetCarsByParamsReq) *carsharing.GetCarsByParamsReq {
+	minPrice, maxPrice := s.priceRangeToPb(req.CarFilter)
+
 	return &carsharing.GetCarsByParamsReq{
-		Brand:       req.Brand,
-		Type:        req.Type,
-		MaxSpeed:    req.MaxSpeed,
-		Seats:       req.Seats,
-		Category:    req.Category,
-		PricePerDay: req.PricePerDay,
+		Brand:    req.Brand,
+		Type:     req.Type,
+		MaxSpeed: req.MaxSpeed,
+		Seats:    req.Seats,
+		Category: req.Category,
+		MinPrice: minPrice,
+		MaxPrice: maxPrice,
+		Page:     s.pageToPb(req.Page),
+	}
+}
+
+func (s *converter) GetAvailableCarsReqToPb(req models.GetAvailableCarsReq) *carsharing.GetAvailableCarsReq {
+	minPrice, maxPrice := s.priceRangeToPb(req.CarFilter)
+
+	return &carsharing.GetAvailableCarsReq{
+		Start:    timestamppb.New(time.Unix(req.Start, 0)),
+		End:      timestamppb.New(time.Unix(req.End, 0)),
+		Brand:    req.Brand,
+		Type:     req.Type,
+		MaxSpeed: req.MaxSpeed,
+		Seats:    req.Seats,
+		Category: req.Category,
+		MinPrice: minPrice,
+		MaxPrice: maxPrice,
+		Page:     s.pageToPb(req.Page),
+
+		StationUUID: req.StationUUID,
+		Near:        s.areaToPb(req.Area),
+	}
+}
+
+func (s *converter) SearchCarsReqToPb(req models.SearchCarsReq) *carsharing.SearchCarsReq {
+	return &carsharing.SearchCarsReq{
+		Query:    req.Query,
+		Brand:    req.Brand,
+		Category: req.Category,
+		Seats:    req.Seats,
+		Page:     s.pageToPb(req.Page),
+	}
+}
+
+// priceRangeToPb returns nil for the unset bounds
+func (s *converter) priceRangeToPb(filter models.CarFilter) (minPrice *carsharing.Money, maxPrice *carsharing.Money) {
+	currency := filter.Currency
+	if currency == "" {
+		currency = DEFAULT_CURRENCY
+	}
+
+	if filter.MinPrice != 0 {
+		minPrice = &carsharing.Money{Amount: filter.MinPrice, Currency: currency}
+	}
+
+	if filter.MaxPrice != 0 {
+		maxPrice = &carsharing.Money{Amount: filter.MaxPrice, Currency: currency}
+	}
+
+	return minPrice, maxPrice
+}
+
+func (s *converter) pageToPb(page models.Page) *carsharing.Page {
+	return &carsharing.Page{
+		Size:       page.PageSize,
+		Token:      page.PageToken,
+		SortBy:     page.SortBy,
+		Descending: page.Descending,
 	}
 }
 
@@ -153,5 +522,6 @@ func (s *converter) CreateCarReqToPb(req models.CreateCarReq) *carsharing.Create
 		PricePerDay: req.PricePerDay,
 		MainImage:   req.MainImage,
 		Images:      req.Images,
+		StationUUID: req.StationUUID,
 	}
 }
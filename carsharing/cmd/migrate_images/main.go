@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"github.com/alserov/rently/carsharing/internal/config"
+	"github.com/alserov/rently/carsharing/internal/storage"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// copies the images between the storage backends of the config, e.g.
+// go run ./cmd/migrate_images -c ./config/local.yaml -from local -to s3
+func main() {
+	from := flag.String("from", storage.BACKEND_LOCAL, "backend to copy the images from")
+	to := flag.String("to", "", "backend to copy the images to")
+
+	// the flags are parsed by config
+	cfg := config.MustLoad()
+	if *to == "" || *to == *from {
+		fmt.Println("target backend should be provided and differ from the source one")
+		os.Exit(1)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	copied, err := storage.Migrate(ctx, storage.MustNewBackend(cfg.Storage, *from), storage.MustNewBackend(cfg.Storage, *to))
+	fmt.Printf("copied %d images from %s to %s\n", copied, *from, *to)
+	if err != nil {
+		fmt.Println("migration failed: " + err.Error())
+		os.Exit(1)
+	}
+}
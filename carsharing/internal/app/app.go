@@ -6,20 +6,24 @@ import (
 	"github.com/alserov/rently/carsharing/internal/clients"
 	"github.com/alserov/rently/carsharing/internal/config"
 	"github.com/alserov/rently/carsharing/internal/db/postgres"
+	"github.com/alserov/rently/carsharing/internal/imaging"
 	"github.com/alserov/rently/carsharing/internal/log"
 	"github.com/alserov/rently/carsharing/internal/metrics"
 	"github.com/alserov/rently/carsharing/internal/notifications"
 	"github.com/alserov/rently/carsharing/internal/payment"
+	"github.com/alserov/rently/carsharing/internal/pricing"
 	"github.com/alserov/rently/carsharing/internal/server"
 	"github.com/alserov/rently/carsharing/internal/service"
 	"github.com/alserov/rently/carsharing/internal/storage"
 	"github.com/alserov/rently/carsharing/internal/utils/broker/rabbit"
+	"github.com/alserov/rently/carsharing/internal/workers"
 	"google.golang.org/grpc"
 	"log/slog"
 	"net"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 )
 
 func MustStart(cfg *config.Config) {
@@ -34,7 +38,7 @@ func MustStart(cfg *config.Config) {
 
 	l.Info("starting app", slog.Int("port", cfg.Port))
 
-	ch, conn := rabbit.NewProducer(cfg.Broker.Addr)
+	ch, conn := rabbit.Dial(cfg.Broker.Addr)
 	defer conn.Close()
 	defer ch.Close()
 
@@ -47,22 +51,52 @@ func MustStart(cfg *config.Config) {
 		}
 	}()
 
-	serv := service.NewService(service.Params{
-		Repo:          postgres.NewRepo(postgres.MustConnect(cfg.DB.GetDsn())),
-		Notifications: notifications.NewNotifier(),
-		Payment:       payment.NewPayer("sk_test_51OU56CDOnc0MdcTNBwddO2cn8NrEebjfuAGjBjj9xSyKmiUO4ajJ1vZ0yBoOsAMq0HjHqCmis2niwoj2EZYCDLOA00lcCUlWxh"),
-		ImageStorage:  storage.NewImageStorage(),
-		UserClient:    clients.NewUserClient(cls.UserClient),
+	repo := postgres.NewRepo(postgres.MustConnect(cfg.DB.GetDsn()))
+	payer := payment.MustNewPayer(cfg.Services.Payment)
+	images := storage.NewImageStorage(storage.MustNewBackend(cfg.Storage, cfg.Storage.Backend))
+
+	serv := service.NewCachedService(service.CacheParams{
+		Service: service.NewService(service.Params{
+			Repo:         repo,
+			Payment:      payer,
+			Pricing:      pricing.NewEngine(pricing.RulesFromConfig(cfg.Pricing)...),
+			ImageStorage: images,
+			Images: imaging.NewProcessor(imaging.Params{
+				MaxSize:       cfg.Images.MaxSize,
+				MaxPixels:     cfg.Images.MaxPixels,
+				ThumbnailSize: cfg.Images.ThumbnailSize,
+				MediumSize:    cfg.Images.MediumSize,
+				JPEGQuality:   cfg.Images.JPEGQuality,
+			}),
+			UserClient: clients.NewUserClient(cls.UserClient),
+		}),
+		Cache: redis.NewCache(redis.MustConnect(redis.Params{
+			Addr:     cfg.Cache.Addr,
+			Password: cfg.Cache.Password,
+		})),
+		CarTTL:   cfg.Cache.CarTTL,
+		CarsTTL:  cfg.Cache.CarsTTL,
+		ImageTTL: cfg.Cache.ImageTTL,
 	})
 
+	go workers.StartWithTicker(time.NewTicker(time.Second*5), workers.NewOutboxRelay(workers.OutboxRelayParams{
+		Repo:     repo,
+		Payment:  payer,
+		Notifier: notifications.NewNotifier(rabbit.NewProducer(ch), cfg.Broker.Topics.Notification),
+	}))
+	go workers.StartWithTicker(time.NewTicker(time.Hour), workers.NewReconciler(workers.ReconcilerParams{
+		Repo:    repo,
+		Payment: payer,
+	}))
+	go workers.StartWithTicker(time.NewTicker(time.Hour), workers.NewImageCollector(workers.ImageCollectorParams{
+		Repo:    repo,
+		Storage: images,
+	}))
+
 	gRPCServer := grpc.NewServer()
 
 	server.RegisterGRPCServer(gRPCServer, server.Params{
 		Service: serv,
-		Cache: redis.NewCache(redis.MustConnect(redis.Params{
-			Addr:     cfg.Cache.Addr,
-			Password: cfg.Cache.Password,
-		})),
 		Metrics: metrics.NewMetrics(ch, cfg.Broker.Topics.Metrics),
 	})
 
@@ -2,10 +2,16 @@ package cache
 
 import (
 	"context"
+	"errors"
 	"time"
 )
 
 type Cache interface {
 	Set(ctx context.Context, key string, val interface{}, exp time.Duration) error
+	// Get returns ErrNotFound, if there is no value by the key
 	Get(ctx context.Context, key string, target any) error
+	// Incr increments the counter by the key, missing counter is considered to be 0
+	Incr(ctx context.Context, key string) (int64, error)
 }
+
+var ErrNotFound = errors.New("value is not found in cache")
@@ -0,0 +1,102 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/alserov/rently/carsharing/internal/cache"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// NewCache returns the in-process cache, values are stored as json the same way as in redis
+func NewCache() cache.Cache {
+	return &repository{
+		values: make(map[string]value),
+		now:    time.Now,
+	}
+}
+
+type repository struct {
+	mu     sync.Mutex
+	values map[string]value
+
+	now func() time.Time
+}
+
+type value struct {
+	data []byte
+	// zero means that the value never expires
+	expiresAt time.Time
+}
+
+func (r *repository) Set(_ context.Context, key string, val interface{}, exp time.Duration) error {
+	b, err := json.Marshal(val)
+	if err != nil {
+		return fmt.Errorf("failed to marshal value: %v", err)
+	}
+
+	v := value{data: b}
+	if exp > 0 {
+		v.expiresAt = r.now().Add(exp)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.values[key] = v
+	return nil
+}
+
+func (r *repository) Get(_ context.Context, key string, target any) error {
+	r.mu.Lock()
+	v, ok := r.get(key)
+	r.mu.Unlock()
+
+	if !ok {
+		return cache.ErrNotFound
+	}
+
+	if err := json.Unmarshal(v.data, target); err != nil {
+		return fmt.Errorf("failed to unmarshal value: %v", err)
+	}
+
+	return nil
+}
+
+func (r *repository) Incr(_ context.Context, key string) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	v, _ := r.get(key)
+
+	var counter int64
+	if v.data != nil {
+		var err error
+		if counter, err = strconv.ParseInt(string(v.data), 10, 64); err != nil {
+			return 0, fmt.Errorf("value is not a counter: %v", err)
+		}
+	}
+	counter++
+
+	v.data = []byte(strconv.FormatInt(counter, 10))
+	r.values[key] = v
+
+	return counter, nil
+}
+
+// get returns the value, if it is not expired, mu should be locked
+func (r *repository) get(key string) (value, bool) {
+	v, ok := r.values[key]
+	if !ok {
+		return value{}, false
+	}
+
+	if !v.expiresAt.IsZero() && !r.now().Before(v.expiresAt) {
+		delete(r.values, key)
+		return value{}, false
+	}
+
+	return v, true
+}
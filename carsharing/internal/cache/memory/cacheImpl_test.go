@@ -0,0 +1,49 @@
+package memory
+
+import (
+	"context"
+	"github.com/alserov/rently/carsharing/internal/cache"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestCache(t *testing.T) {
+	ctx := context.Background()
+
+	now := time.Now()
+	c := &repository{
+		values: make(map[string]value),
+		now: func() time.Time {
+			return now
+		},
+	}
+
+	type car struct {
+		Brand string
+		Seats int
+	}
+
+	require.NoError(t, c.Set(ctx, "car", car{Brand: "bmw", Seats: 4}, time.Minute))
+
+	var got car
+	require.NoError(t, c.Get(ctx, "car", &got))
+	require.Equal(t, car{Brand: "bmw", Seats: 4}, got)
+
+	now = now.Add(time.Minute)
+	require.ErrorIs(t, c.Get(ctx, "car", &got), cache.ErrNotFound)
+
+	for i := int64(1); i <= 3; i++ {
+		counter, err := c.Incr(ctx, "counter")
+		require.NoError(t, err)
+		require.Equal(t, i, counter)
+	}
+
+	var counter int64
+	require.NoError(t, c.Get(ctx, "counter", &counter))
+	require.Equal(t, int64(3), counter)
+
+	require.NoError(t, c.Set(ctx, "car", car{}, 0))
+	_, err := c.Incr(ctx, "car")
+	require.Error(t, err)
+}
@@ -3,6 +3,7 @@ package redis
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
@@ -33,13 +34,25 @@ func (r repository) Set(ctx context.Context, key string, val interface{}, exp ti
 
 func (r repository) Get(ctx context.Context, key string, target any) error {
 	val, err := r.db.Get(ctx, key).Result()
+	if errors.Is(err, redis.Nil) {
+		return cache.ErrNotFound
+	}
 	if err != nil {
 		return fmt.Errorf("failed to get from cache: %v", err)
 	}
 
-	if err = json.Unmarshal([]byte(val), &target); err != nil {
+	if err = json.Unmarshal([]byte(val), target); err != nil {
 		return fmt.Errorf("failed to unmarshal value: %v", err)
 	}
 
 	return nil
 }
+
+func (r repository) Incr(ctx context.Context, key string) (int64, error) {
+	val, err := r.db.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to increment counter: %v", err)
+	}
+
+	return val, nil
+}
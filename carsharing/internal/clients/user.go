@@ -21,21 +21,32 @@ type userClient struct {
 	cl user.UserClient
 }
 
+// GetPassportAndPhone the personal data of the renter is stored by the user service, so the rent depends on it anyway,
+// the token is verified by the user service along with the revoked sessions, before the data is returned
 func (u userClient) GetPassportAndPhone(ctx context.Context, token string) (models.UserInfo, error) {
 	info, err := u.cl.GetInfoForRent(ctx, &user.GetInfoForRentReq{Token: token})
 	if err != nil {
-		if st, ok := status.FromError(err); ok {
-			switch st.Code() {
-			case codes.InvalidArgument:
-				return models.UserInfo{}, &models.Error{
-					Msg:    st.Message(),
-					Status: http.StatusBadRequest,
-				}
-			default:
-				return models.UserInfo{}, &models.Error{
-					Msg:    st.Message(),
-					Status: http.StatusInternalServerError,
-				}
+		st, _ := status.FromError(err)
+		switch st.Code() {
+		case codes.InvalidArgument:
+			return models.UserInfo{}, &models.Error{
+				Msg:    st.Message(),
+				Status: http.StatusBadRequest,
+			}
+		case codes.Unauthenticated:
+			return models.UserInfo{}, &models.Error{
+				Msg:    st.Message(),
+				Status: http.StatusUnauthorized,
+			}
+		case codes.PermissionDenied:
+			return models.UserInfo{}, &models.Error{
+				Msg:    st.Message(),
+				Status: http.StatusForbidden,
+			}
+		default:
+			return models.UserInfo{}, &models.Error{
+				Msg:    st.Message(),
+				Status: http.StatusInternalServerError,
 			}
 		}
 	}
@@ -43,6 +54,7 @@ func (u userClient) GetPassportAndPhone(ctx context.Context, token string) (mode
 	return models.UserInfo{
 		PassportNumber: info.PassportNumber,
 		PhoneNumber:    info.PhoneNumber,
+		Email:          info.Email,
 		UUID:           info.UUID,
 	}, nil
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"gopkg.in/yaml.v3"
 	"os"
+	"time"
 )
 
 type Config struct {
@@ -12,20 +13,84 @@ type Config struct {
 	Env      string
 	DB       Postgres
 	Cache    Cache
+	Storage  Storage
+	Images   Images
 	Services Services
 	Broker   Broker
+	Pricing  Pricing
+}
+
+type Pricing struct {
+	ShortRent struct {
+		MaxHours int     `yaml:"maxHours"`
+		Markup   float64 `yaml:"markup"`
+	} `yaml:"shortRent"`
+	WeekendMultiplier float64 `yaml:"weekendMultiplier"`
+	Seasons           []struct {
+		Name string `yaml:"name"`
+		// From and To are month numbers, both inclusive
+		From       int     `yaml:"from"`
+		To         int     `yaml:"to"`
+		Multiplier float64 `yaml:"multiplier"`
+	} `yaml:"seasons"`
+	LongRentDiscounts []struct {
+		MinDays  int     `yaml:"minDays"`
+		Discount float64 `yaml:"discount"`
+	} `yaml:"longRentDiscounts"`
+	CategorySurcharges map[string]float64 `yaml:"categorySurcharges"`
+	// OneWayFee is the share of the day price, which is charged, when the car is dropped off at the other station
+	OneWayFee float64 `yaml:"oneWayFee"`
 }
 
 type Cache struct {
 	Addr     string `yaml:"addr"`
 	Password string `yaml:"password"`
+	// zero ttl means the default one
+	CarTTL   time.Duration `yaml:"carTTL"`
+	CarsTTL  time.Duration `yaml:"carsTTL"`
+	ImageTTL time.Duration `yaml:"imageTTL"`
+}
+
+type Storage struct {
+	// Backend is one of: local, s3, memory
+	Backend string `yaml:"backend"`
+	Local   struct {
+		Path string `yaml:"path"`
+	} `yaml:"local"`
+	S3 S3 `yaml:"s3"`
+}
+
+type Images struct {
+	// MaxSize is the max size of the uploaded image in bytes, zero values mean the default ones
+	MaxSize       int64 `yaml:"maxSize"`
+	MaxPixels     int   `yaml:"maxPixels"`
+	ThumbnailSize int   `yaml:"thumbnailSize"`
+	MediumSize    int   `yaml:"mediumSize"`
+	JPEGQuality   int   `yaml:"jpegQuality"`
+}
+
+type S3 struct {
+	// Endpoint is the url of S3 compatible storage, e.g. http://localhost:9000 for MinIO
+	Endpoint  string `yaml:"endpoint"`
+	Region    string `yaml:"region"`
+	Bucket    string `yaml:"bucket"`
+	AccessKey string `yaml:"accessKey"`
+	SecretKey string `yaml:"secretKey"`
 }
 
 type Services struct {
-	Payment struct {
-		ApiKey string `yaml:"apiKey"`
-	} `yaml:"payment"`
-	User string `yaml:"user"`
+	Payment Payment `yaml:"payment"`
+	User    string  `yaml:"user"`
+}
+
+type Payment struct {
+	// Provider is one of: stripe, fake
+	Provider string `yaml:"provider"`
+	ApiKey   string `yaml:"apiKey"`
+	Fake     struct {
+		Latency         time.Duration `yaml:"latency"`
+		DeclinedSources []string      `yaml:"declinedSources"`
+	} `yaml:"fake"`
 }
 
 type Postgres struct {
@@ -43,19 +108,26 @@ func (p *Postgres) GetDsn() string {
 type Broker struct {
 	Addr   string `yaml:"addr"`
 	Topics struct {
-		Metrics      Metrics `yaml:"metrics"`
-		Notification struct {
-			RentCreated  string `yaml:"rentCreated"`
-			RentCanceled string `yaml:"rentCanceled"`
-		}
+		Metrics      Metrics      `yaml:"metrics"`
+		Notification Notification `yaml:"notification"`
 	} `yaml:"topics"`
 }
 
+type Notification struct {
+	RentCreated  string `yaml:"rentCreated"`
+	RentCanceled string `yaml:"rentCanceled"`
+}
+
 type Metrics struct {
 	NotifyBrandDemand string `yaml:"notifyBrandDemand"`
 	ResponseTime      string `yaml:"responseTime"`
 }
 
+const (
+	PAYMENT_API_KEY_ENV = "PAYMENT_API_KEY"
+	S3_SECRET_KEY_ENV   = "S3_SECRET_KEY"
+)
+
 func MustLoad() *Config {
 	path := fetchConfigPath()
 	if path == "" {
@@ -76,6 +148,14 @@ func MustLoad() *Config {
 		panic("failed to unmarshal config file")
 	}
 
+	if key := os.Getenv(PAYMENT_API_KEY_ENV); key != "" {
+		cfg.Services.Payment.ApiKey = key
+	}
+
+	if key := os.Getenv(S3_SECRET_KEY_ENV); key != "" {
+		cfg.Storage.S3.SecretKey = key
+	}
+
 	return &cfg
 }
 
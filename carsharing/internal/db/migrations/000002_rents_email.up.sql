@@ -0,0 +1 @@
+ALTER TABLE rents ADD COLUMN IF NOT EXISTS email text DEFAULT '';
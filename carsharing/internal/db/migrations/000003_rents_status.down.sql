@@ -0,0 +1 @@
+ALTER TABLE rents DROP COLUMN IF EXISTS status;
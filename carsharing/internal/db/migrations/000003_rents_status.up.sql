@@ -0,0 +1,2 @@
+ALTER TABLE rents ADD COLUMN IF NOT EXISTS status varchar(10) NOT NULL DEFAULT 'reserved'
+    CHECK ( status IN ('reserved', 'active', 'completed', 'canceled', 'no-show') );
@@ -0,0 +1,15 @@
+-- the down migration is not covered by the tests, it allows the overlaps again, so the rents,
+-- canceled by the up migration, are restored from their list, the new rents could overlap them afterwards
+ALTER TABLE rents DROP CONSTRAINT IF EXISTS rents_no_overlap;
+
+UPDATE rents
+SET status = c.previous_status
+FROM rents_overlap_canceled c
+WHERE rents.uuid = c.rent_uuid
+  AND rents.status = 'canceled';
+
+DROP TABLE IF EXISTS rents_overlap_canceled;
+
+ALTER TABLE rents
+    ALTER COLUMN rent_start TYPE timestamp USING rent_start AT TIME ZONE 'UTC',
+    ALTER COLUMN rent_end TYPE timestamp USING rent_end AT TIME ZONE 'UTC';
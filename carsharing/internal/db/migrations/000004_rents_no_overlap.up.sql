@@ -0,0 +1,55 @@
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+ALTER TABLE rents
+    ALTER COLUMN rent_start TYPE timestamptz USING rent_start AT TIME ZONE 'UTC',
+    ALTER COLUMN rent_end TYPE timestamptz USING rent_end AT TIME ZONE 'UTC';
+
+-- the double bookings were allowed before, so the overlapping legacy rents are canceled before the constraint is added,
+-- the active rents are kept first, then the earlier ones, the canceled rents are listed for the refunds and the down migration
+CREATE TABLE IF NOT EXISTS rents_overlap_canceled
+(
+    rent_uuid       varchar(40) NOT NULL,
+    kept_rent_uuid  varchar(40) NOT NULL,
+    previous_status varchar(10) NOT NULL,
+    canceled_at     timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE TEMPORARY TABLE rents_overlap_kept
+(
+    uuid     varchar(40) NOT NULL,
+    car_uuid varchar(40) NOT NULL,
+    period   tstzrange   NOT NULL
+);
+
+DO
+$$
+    DECLARE
+        r    record;
+        kept varchar(40);
+    BEGIN
+        FOR r IN SELECT uuid, car_uuid, tstzrange(rent_start, rent_end, '[)') AS period, status
+                 FROM rents
+                 WHERE status IN ('reserved', 'active')
+                 ORDER BY car_uuid, status = 'active' DESC, rent_start, uuid
+            LOOP
+                -- the rent is compared with the kept ones only, they are before it in the order
+                SELECT k.uuid INTO kept FROM rents_overlap_kept k WHERE k.car_uuid = r.car_uuid AND k.period && r.period LIMIT 1;
+
+                IF kept IS NULL THEN
+                    INSERT INTO rents_overlap_kept (uuid, car_uuid, period) VALUES (r.uuid, r.car_uuid, r.period);
+                ELSE
+                    INSERT INTO rents_overlap_canceled (rent_uuid, kept_rent_uuid, previous_status)
+                    VALUES (r.uuid, kept, r.status);
+                    UPDATE rents SET status = 'canceled' WHERE uuid = r.uuid;
+                END IF;
+            END LOOP;
+    END
+$$;
+
+DROP TABLE rents_overlap_kept;
+
+ALTER TABLE rents
+    ADD CONSTRAINT rents_no_overlap EXCLUDE USING gist (
+        car_uuid WITH =,
+        tstzrange(rent_start, rent_end, '[)') WITH &&
+    ) WHERE ( status IN ('reserved', 'active') );
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS idempotency_keys
+(
+    key        varchar(255) NOT NULL,
+    operation  varchar(20)  NOT NULL,
+    response   jsonb        NOT NULL,
+    created_at timestamptz  NOT NULL DEFAULT now(),
+    PRIMARY KEY (key, operation)
+);
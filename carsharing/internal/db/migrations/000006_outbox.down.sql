@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_charges_rent_uuid;
+DROP TABLE IF EXISTS outbox;
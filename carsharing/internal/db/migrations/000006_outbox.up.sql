@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS outbox
+(
+    id              bigserial PRIMARY KEY,
+    event_type      varchar(20) NOT NULL,
+    payload         jsonb       NOT NULL,
+    attempts        int         NOT NULL DEFAULT 0,
+    last_error      text,
+    created_at      timestamptz NOT NULL DEFAULT now(),
+    next_attempt_at timestamptz NOT NULL DEFAULT now(),
+    processed_at    timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_pending ON outbox (next_attempt_at) WHERE processed_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_charges_rent_uuid ON charges (rent_uuid);
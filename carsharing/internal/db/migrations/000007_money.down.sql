@@ -0,0 +1,7 @@
+ALTER TABLE charges
+    DROP COLUMN IF EXISTS currency,
+    ALTER COLUMN charge_amount TYPE float USING charge_amount::float;
+
+ALTER TABLE cars
+    DROP COLUMN IF EXISTS currency,
+    ALTER COLUMN price_per_day TYPE float USING price_per_day::float / 100;
@@ -0,0 +1,9 @@
+-- prices are stored in minor units of the currency
+ALTER TABLE cars
+    ALTER COLUMN price_per_day TYPE bigint USING round(price_per_day * 100)::bigint,
+    ADD COLUMN IF NOT EXISTS currency char(3) NOT NULL DEFAULT 'USD';
+
+-- charge amounts have already been stored in minor units
+ALTER TABLE charges
+    ALTER COLUMN charge_amount TYPE bigint USING round(charge_amount)::bigint,
+    ADD COLUMN IF NOT EXISTS currency char(3) NOT NULL DEFAULT 'USD';
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_charges_uuid;
+DROP TABLE IF EXISTS promo_redemptions;
+DROP TABLE IF EXISTS promo_codes;
@@ -0,0 +1,32 @@
+CREATE TABLE IF NOT EXISTS promo_codes
+(
+    code              varchar(32) PRIMARY KEY,
+    -- the discount is either a percentage of the rent price or a fixed amount in minor units
+    percent           int         NOT NULL DEFAULT 0,
+    amount            bigint      NOT NULL DEFAULT 0,
+    currency          char(3)     NOT NULL DEFAULT 'USD',
+    -- zero means that the usage is not limited
+    max_uses          int         NOT NULL DEFAULT 0 CHECK ( max_uses >= 0 ),
+    max_uses_per_user int         NOT NULL DEFAULT 0 CHECK ( max_uses_per_user >= 0 ),
+    categories        text[]      NOT NULL DEFAULT '{}',
+    valid_from        timestamptz NOT NULL DEFAULT now(),
+    valid_until       timestamptz,
+    expired_at        timestamptz,
+    created_at        timestamptz NOT NULL DEFAULT now(),
+    CHECK ( (percent BETWEEN 1 AND 100 AND amount = 0) OR (percent = 0 AND amount > 0) ),
+    CHECK ( valid_until IS NULL OR valid_until > valid_from )
+);
+
+CREATE TABLE IF NOT EXISTS promo_redemptions
+(
+    code        varchar(32) NOT NULL REFERENCES promo_codes (code),
+    charge_uuid text        NOT NULL,
+    rent_uuid   varchar(40) NOT NULL,
+    user_uuid   varchar(40) NOT NULL,
+    discount    bigint      NOT NULL CHECK ( discount > 0 ),
+    currency    char(3)     NOT NULL,
+    redeemed_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_promo_redemptions_code ON promo_redemptions (code, user_uuid);
+CREATE INDEX IF NOT EXISTS idx_charges_uuid ON charges (uuid);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_cars_search;
+
+ALTER TABLE cars DROP COLUMN IF EXISTS search;
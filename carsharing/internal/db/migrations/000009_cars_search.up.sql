@@ -0,0 +1,7 @@
+-- the search document is kept in sync with the car by postgres itself
+ALTER TABLE cars
+    ADD COLUMN IF NOT EXISTS search tsvector GENERATED ALWAYS AS (
+        to_tsvector('simple', brand || ' ' || type || ' ' || category)
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_cars_search ON cars USING gin (search);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_images_car_uuid;
+DROP INDEX IF EXISTS idx_images_uuid;
+
+ALTER TABLE images
+    DROP COLUMN IF EXISTS created_at,
+    DROP COLUMN IF EXISTS variants,
+    DROP COLUMN IF EXISTS position;
@@ -0,0 +1,14 @@
+-- the gallery is ordered by position, variants are the names of the stored files of the image
+ALTER TABLE images
+    ADD COLUMN IF NOT EXISTS position   int       NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS variants   text[]    NOT NULL DEFAULT '{original}',
+    ADD COLUMN IF NOT EXISTS created_at timestamp NOT NULL DEFAULT now();
+
+-- the images, saved before, keep the order they were inserted in
+UPDATE images
+SET position = ordered.position
+FROM (SELECT ctid, row_number() OVER (PARTITION BY car_uuid ORDER BY ctid) - 1 AS position FROM images) AS ordered
+WHERE images.ctid = ordered.ctid;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_images_uuid ON images (uuid);
+CREATE INDEX IF NOT EXISTS idx_images_car_uuid ON images (car_uuid, position);
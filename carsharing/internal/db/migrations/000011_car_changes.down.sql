@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_car_changes_car_uuid;
+DROP TABLE IF EXISTS car_changes;
+
+ALTER TABLE cars
+    DROP COLUMN IF EXISTS deleted_at;
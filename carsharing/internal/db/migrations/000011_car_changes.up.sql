@@ -0,0 +1,17 @@
+-- the deleted cars are kept for the history of the rents, they are only hidden from the catalogue
+ALTER TABLE cars
+    ADD COLUMN IF NOT EXISTS deleted_at timestamptz;
+
+CREATE TABLE IF NOT EXISTS car_changes
+(
+    id         bigserial PRIMARY KEY,
+    car_uuid   varchar(40) NOT NULL,
+    -- the uuid of the admin, who made the change
+    actor      varchar(40) NOT NULL,
+    action     varchar(20) NOT NULL,
+    -- the changed fields with the old and the new values
+    changes    jsonb       NOT NULL DEFAULT '{}',
+    changed_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_car_changes_car_uuid ON car_changes (car_uuid, changed_at);
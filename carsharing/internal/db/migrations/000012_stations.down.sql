@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS idx_cars_station_uuid;
+
+ALTER TABLE rents
+    DROP COLUMN IF EXISTS drop_off_station_uuid,
+    DROP COLUMN IF EXISTS pickup_station_uuid;
+
+ALTER TABLE cars
+    DROP COLUMN IF EXISTS station_uuid;
+
+DROP FUNCTION IF EXISTS distance_km;
+DROP TABLE IF EXISTS stations;
@@ -0,0 +1,37 @@
+CREATE TABLE IF NOT EXISTS stations
+(
+    uuid       varchar(40)      PRIMARY KEY,
+    name       text             NOT NULL,
+    address    text             NOT NULL,
+    latitude   double precision NOT NULL CHECK ( latitude BETWEEN -90 AND 90 ),
+    longitude  double precision NOT NULL CHECK ( longitude BETWEEN -180 AND 180 ),
+    -- the opening hours are the minutes from the midnight in the time zone of the station,
+    -- equal ones mean that the station is always open
+    opens_at   int              NOT NULL DEFAULT 0 CHECK ( opens_at BETWEEN 0 AND 1439 ),
+    closes_at  int              NOT NULL DEFAULT 0 CHECK ( closes_at BETWEEN 0 AND 1439 ),
+    time_zone  text             NOT NULL DEFAULT 'UTC',
+    capacity   int              NOT NULL CHECK ( capacity > 0 ),
+    created_at timestamptz      NOT NULL DEFAULT now()
+);
+
+-- distance_km returns the great-circle distance between the points using the haversine formula
+CREATE OR REPLACE FUNCTION distance_km(lat1 double precision, lon1 double precision, lat2 double precision, lon2 double precision)
+    RETURNS double precision
+    LANGUAGE sql
+    IMMUTABLE AS
+$$
+SELECT 2 * 6371 * asin(sqrt(
+            power(sin(radians(lat2 - lat1) / 2), 2) +
+            cos(radians(lat1)) * cos(radians(lat2)) * power(sin(radians(lon2 - lon1) / 2), 2)
+    ))
+$$;
+
+-- the cars, created before, have no home station
+ALTER TABLE cars
+    ADD COLUMN IF NOT EXISTS station_uuid varchar(40) REFERENCES stations (uuid);
+
+ALTER TABLE rents
+    ADD COLUMN IF NOT EXISTS pickup_station_uuid   varchar(40) REFERENCES stations (uuid),
+    ADD COLUMN IF NOT EXISTS drop_off_station_uuid varchar(40) REFERENCES stations (uuid);
+
+CREATE INDEX IF NOT EXISTS idx_cars_station_uuid ON cars (station_uuid);
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS maintenances
+(
+    uuid        varchar(40) PRIMARY KEY,
+    car_uuid    varchar(40) NOT NULL REFERENCES cars (uuid),
+    kind        varchar(10) NOT NULL CHECK ( kind IN ('planned', 'unplanned') ),
+    reason      text        NOT NULL,
+    starts_at   timestamptz NOT NULL,
+    -- null means that the maintenance lasts till it is closed
+    ends_at     timestamptz CHECK ( ends_at >= starts_at ),
+    -- the mileage, after which the next maintenance is due, and the odometer reading on close
+    interval_km bigint      NOT NULL DEFAULT 0 CHECK ( interval_km >= 0 ),
+    mileage     bigint      NOT NULL DEFAULT 0 CHECK ( mileage >= 0 ),
+    closed_at   timestamptz,
+    -- the uuid of the admin, who scheduled the maintenance
+    created_by  varchar(40) NOT NULL,
+    created_at  timestamptz NOT NULL DEFAULT now(),
+
+    CONSTRAINT maintenances_no_overlap EXCLUDE USING gist (
+        car_uuid WITH =,
+        tstzrange(starts_at, ends_at, '[)') WITH &&
+    )
+);
@@ -0,0 +1,9 @@
+-- the keys of the different users can not share the old primary key
+DELETE FROM idempotency_keys a USING idempotency_keys b
+    WHERE a.key = b.key AND a.operation = b.operation AND a.ctid > b.ctid;
+
+ALTER TABLE idempotency_keys
+    DROP CONSTRAINT IF EXISTS idempotency_keys_pkey,
+    DROP COLUMN IF EXISTS request_hash,
+    DROP COLUMN IF EXISTS user_uuid,
+    ADD PRIMARY KEY (key, operation);
@@ -0,0 +1,6 @@
+-- the keys are scoped to the user and bound to the request, the old keys have no hash, so they are not replayed
+ALTER TABLE idempotency_keys
+    ADD COLUMN IF NOT EXISTS user_uuid    varchar(40) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS request_hash char(64)    NOT NULL DEFAULT '',
+    DROP CONSTRAINT IF EXISTS idempotency_keys_pkey,
+    ADD PRIMARY KEY (key, operation, user_uuid);
@@ -0,0 +1,3 @@
+ALTER TABLE promo_codes
+    DROP CONSTRAINT IF EXISTS promo_codes_discount_check,
+    ADD CONSTRAINT promo_codes_check CHECK ( (percent BETWEEN 1 AND 100 AND amount = 0) OR (percent = 0 AND amount > 0) );
@@ -0,0 +1,6 @@
+-- the whole price can not be discounted, the 100% codes were never applicable, so they are expired
+UPDATE promo_codes SET expired_at = COALESCE(expired_at, now()), percent = 99 WHERE percent = 100;
+
+ALTER TABLE promo_codes
+    DROP CONSTRAINT IF EXISTS promo_codes_check,
+    ADD CONSTRAINT promo_codes_discount_check CHECK ( (percent BETWEEN 1 AND 99 AND amount = 0) OR (percent = 0 AND amount > 0) );
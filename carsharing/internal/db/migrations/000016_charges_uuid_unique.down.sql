@@ -0,0 +1,5 @@
+-- the disambiguated ids are kept
+ALTER TABLE charges
+    DROP CONSTRAINT IF EXISTS charges_uuid_unique;
+
+CREATE INDEX IF NOT EXISTS idx_charges_uuid ON charges (uuid);
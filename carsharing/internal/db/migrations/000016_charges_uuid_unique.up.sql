@@ -0,0 +1,14 @@
+-- the fake gateway reused the charge ids after the restarts, only its charges can be duplicated,
+-- so they are made unique with their rents, the redemptions are moved first by the same rent
+UPDATE promo_redemptions
+SET charge_uuid = charge_uuid || ':' || rent_uuid
+WHERE charge_uuid IN (SELECT uuid FROM charges GROUP BY uuid HAVING count(*) > 1);
+
+UPDATE charges
+SET uuid = uuid || ':' || rent_uuid
+WHERE uuid IN (SELECT uuid FROM charges GROUP BY uuid HAVING count(*) > 1);
+
+DROP INDEX IF EXISTS idx_charges_uuid;
+
+ALTER TABLE charges
+    ADD CONSTRAINT charges_uuid_unique UNIQUE (uuid);
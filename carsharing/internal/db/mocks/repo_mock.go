@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: internal/db/repository.go
+// Source: repository.go
 
 // Package repomock is a generated GoMock package.
 package repomock
@@ -37,6 +37,20 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddCarImage mocks base method.
+func (m *MockRepository) AddCarImage(ctx context.Context, carUUID string, image models.CarImage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCarImage", ctx, carUUID, image)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddCarImage indicates an expected call of AddCarImage.
+func (mr *MockRepositoryMockRecorder) AddCarImage(ctx, carUUID, image interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCarImage", reflect.TypeOf((*MockRepository)(nil).AddCarImage), ctx, carUUID, image)
+}
+
 // CancelRentTx mocks base method.
 func (m *MockRepository) CancelRentTx(ctx context.Context, tx db.SqlTx, rentUUID string) (models.CancelRentInfo, error) {
 	m.ctrl.T.Helper()
@@ -82,18 +96,32 @@ func (mr *MockRepositoryMockRecorder) CheckRent(ctx, rentUUID interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckRent", reflect.TypeOf((*MockRepository)(nil).CheckRent), ctx, rentUUID)
 }
 
+// CloseMaintenance mocks base method.
+func (m *MockRepository) CloseMaintenance(ctx context.Context, req models.CloseMaintenanceReq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseMaintenance", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseMaintenance indicates an expected call of CloseMaintenance.
+func (mr *MockRepositoryMockRecorder) CloseMaintenance(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseMaintenance", reflect.TypeOf((*MockRepository)(nil).CloseMaintenance), ctx, req)
+}
+
 // CreateCar mocks base method.
-func (m *MockRepository) CreateCar(ctx context.Context, car models.Car) error {
+func (m *MockRepository) CreateCar(ctx context.Context, car models.Car, actor string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateCar", ctx, car)
+	ret := m.ctrl.Call(m, "CreateCar", ctx, car, actor)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateCar indicates an expected call of CreateCar.
-func (mr *MockRepositoryMockRecorder) CreateCar(ctx, car interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) CreateCar(ctx, car, actor interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCar", reflect.TypeOf((*MockRepository)(nil).CreateCar), ctx, car)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCar", reflect.TypeOf((*MockRepository)(nil).CreateCar), ctx, car, actor)
 }
 
 // CreateChargeTx mocks base method.
@@ -110,48 +138,147 @@ func (mr *MockRepositoryMockRecorder) CreateChargeTx(ctx, tx, req interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChargeTx", reflect.TypeOf((*MockRepository)(nil).CreateChargeTx), ctx, tx, req)
 }
 
-// CreateRentTx mocks base method.
-func (m *MockRepository) CreateRentTx(ctx context.Context, tx db.SqlTx, req models.CreateRentReq) (float32, error) {
+// CreateIdempotencyKeyTx mocks base method.
+func (m *MockRepository) CreateIdempotencyKeyTx(ctx context.Context, tx db.SqlTx, key models.IdempotencyKey) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateRentTx", ctx, tx, req)
-	ret0, _ := ret[0].(float32)
+	ret := m.ctrl.Call(m, "CreateIdempotencyKeyTx", ctx, tx, key)
+	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// CreateIdempotencyKeyTx indicates an expected call of CreateIdempotencyKeyTx.
+func (mr *MockRepositoryMockRecorder) CreateIdempotencyKeyTx(ctx, tx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIdempotencyKeyTx", reflect.TypeOf((*MockRepository)(nil).CreateIdempotencyKeyTx), ctx, tx, key)
+}
+
+// CreateOutboxEventTx mocks base method.
+func (m *MockRepository) CreateOutboxEventTx(ctx context.Context, tx db.SqlTx, event models.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOutboxEventTx", ctx, tx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOutboxEventTx indicates an expected call of CreateOutboxEventTx.
+func (mr *MockRepositoryMockRecorder) CreateOutboxEventTx(ctx, tx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOutboxEventTx", reflect.TypeOf((*MockRepository)(nil).CreateOutboxEventTx), ctx, tx, event)
+}
+
+// CreatePromoCode mocks base method.
+func (m *MockRepository) CreatePromoCode(ctx context.Context, promo models.PromoCode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePromoCode", ctx, promo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePromoCode indicates an expected call of CreatePromoCode.
+func (mr *MockRepositoryMockRecorder) CreatePromoCode(ctx, promo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePromoCode", reflect.TypeOf((*MockRepository)(nil).CreatePromoCode), ctx, promo)
+}
+
+// CreatePromoRedemptionTx mocks base method.
+func (m *MockRepository) CreatePromoRedemptionTx(ctx context.Context, tx db.SqlTx, redemption models.PromoRedemption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePromoRedemptionTx", ctx, tx, redemption)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePromoRedemptionTx indicates an expected call of CreatePromoRedemptionTx.
+func (mr *MockRepositoryMockRecorder) CreatePromoRedemptionTx(ctx, tx, redemption interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePromoRedemptionTx", reflect.TypeOf((*MockRepository)(nil).CreatePromoRedemptionTx), ctx, tx, redemption)
+}
+
+// CreateRentTx mocks base method.
+func (m *MockRepository) CreateRentTx(ctx context.Context, tx db.SqlTx, req models.CreateRentReq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRentTx", ctx, tx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
 // CreateRentTx indicates an expected call of CreateRentTx.
 func (mr *MockRepositoryMockRecorder) CreateRentTx(ctx, tx, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRentTx", reflect.TypeOf((*MockRepository)(nil).CreateRentTx), ctx, tx, req)
 }
 
+// CreateStation mocks base method.
+func (m *MockRepository) CreateStation(ctx context.Context, station models.Station) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStation", ctx, station)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateStation indicates an expected call of CreateStation.
+func (mr *MockRepositoryMockRecorder) CreateStation(ctx, station interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStation", reflect.TypeOf((*MockRepository)(nil).CreateStation), ctx, station)
+}
+
 // DeleteCar mocks base method.
-func (m *MockRepository) DeleteCar(ctx context.Context, uuid string) error {
+func (m *MockRepository) DeleteCar(ctx context.Context, uuid, actor string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteCar", ctx, uuid)
+	ret := m.ctrl.Call(m, "DeleteCar", ctx, uuid, actor)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteCar indicates an expected call of DeleteCar.
-func (mr *MockRepositoryMockRecorder) DeleteCar(ctx, uuid interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) DeleteCar(ctx, uuid, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCar", reflect.TypeOf((*MockRepository)(nil).DeleteCar), ctx, uuid, actor)
+}
+
+// DeleteCarImage mocks base method.
+func (m *MockRepository) DeleteCarImage(ctx context.Context, carUUID, imageId string) (models.CarImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCarImage", ctx, carUUID, imageId)
+	ret0, _ := ret[0].(models.CarImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCarImage indicates an expected call of DeleteCarImage.
+func (mr *MockRepositoryMockRecorder) DeleteCarImage(ctx, carUUID, imageId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCarImage", reflect.TypeOf((*MockRepository)(nil).DeleteCarImage), ctx, carUUID, imageId)
+}
+
+// ExpirePromoCode mocks base method.
+func (m *MockRepository) ExpirePromoCode(ctx context.Context, code string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpirePromoCode", ctx, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpirePromoCode indicates an expected call of ExpirePromoCode.
+func (mr *MockRepositoryMockRecorder) ExpirePromoCode(ctx, code interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCar", reflect.TypeOf((*MockRepository)(nil).DeleteCar), ctx, uuid)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpirePromoCode", reflect.TypeOf((*MockRepository)(nil).ExpirePromoCode), ctx, code)
 }
 
 // GetAvailableCars mocks base method.
-func (m *MockRepository) GetAvailableCars(ctx context.Context, period models.Period) ([]models.CarMainInfo, error) {
+func (m *MockRepository) GetAvailableCars(ctx context.Context, period models.Period, params models.CarParams, page models.Page) (models.CarsPage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAvailableCars", ctx, period)
-	ret0, _ := ret[0].([]models.CarMainInfo)
+	ret := m.ctrl.Call(m, "GetAvailableCars", ctx, period, params, page)
+	ret0, _ := ret[0].(models.CarsPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAvailableCars indicates an expected call of GetAvailableCars.
-func (mr *MockRepositoryMockRecorder) GetAvailableCars(ctx, period interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) GetAvailableCars(ctx, period, params, page interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailableCars", reflect.TypeOf((*MockRepository)(nil).GetAvailableCars), ctx, period)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailableCars", reflect.TypeOf((*MockRepository)(nil).GetAvailableCars), ctx, period, params, page)
 }
 
 // GetCarByUUID mocks base method.
@@ -169,142 +296,960 @@ func (mr *MockRepositoryMockRecorder) GetCarByUUID(ctx, uuid interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCarByUUID", reflect.TypeOf((*MockRepository)(nil).GetCarByUUID), ctx, uuid)
 }
 
+// GetCarChanges mocks base method.
+func (m *MockRepository) GetCarChanges(ctx context.Context, carUUID string) ([]models.CarChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCarChanges", ctx, carUUID)
+	ret0, _ := ret[0].([]models.CarChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCarChanges indicates an expected call of GetCarChanges.
+func (mr *MockRepositoryMockRecorder) GetCarChanges(ctx, carUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCarChanges", reflect.TypeOf((*MockRepository)(nil).GetCarChanges), ctx, carUUID)
+}
+
+// GetCarImages mocks base method.
+func (m *MockRepository) GetCarImages(ctx context.Context, carUUID string) ([]models.CarImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCarImages", ctx, carUUID)
+	ret0, _ := ret[0].([]models.CarImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCarImages indicates an expected call of GetCarImages.
+func (mr *MockRepositoryMockRecorder) GetCarImages(ctx, carUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCarImages", reflect.TypeOf((*MockRepository)(nil).GetCarImages), ctx, carUUID)
+}
+
+// GetCarPricing mocks base method.
+func (m *MockRepository) GetCarPricing(ctx context.Context, uuid string) (models.CarPricing, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCarPricing", ctx, uuid)
+	ret0, _ := ret[0].(models.CarPricing)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCarPricing indicates an expected call of GetCarPricing.
+func (mr *MockRepositoryMockRecorder) GetCarPricing(ctx, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCarPricing", reflect.TypeOf((*MockRepository)(nil).GetCarPricing), ctx, uuid)
+}
+
 // GetCarsByParams mocks base method.
-func (m *MockRepository) GetCarsByParams(ctx context.Context, params models.CarParams) ([]models.CarMainInfo, error) {
+func (m *MockRepository) GetCarsByParams(ctx context.Context, params models.CarParams, page models.Page) (models.CarsPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCarsByParams", ctx, params, page)
+	ret0, _ := ret[0].(models.CarsPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCarsByParams indicates an expected call of GetCarsByParams.
+func (mr *MockRepositoryMockRecorder) GetCarsByParams(ctx, params, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCarsByParams", reflect.TypeOf((*MockRepository)(nil).GetCarsByParams), ctx, params, page)
+}
+
+// GetExistingCharges mocks base method.
+func (m *MockRepository) GetExistingCharges(ctx context.Context, chargeUUIDs []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExistingCharges", ctx, chargeUUIDs)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExistingCharges indicates an expected call of GetExistingCharges.
+func (mr *MockRepositoryMockRecorder) GetExistingCharges(ctx, chargeUUIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExistingCharges", reflect.TypeOf((*MockRepository)(nil).GetExistingCharges), ctx, chargeUUIDs)
+}
+
+// GetExistingImages mocks base method.
+func (m *MockRepository) GetExistingImages(ctx context.Context, ids []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExistingImages", ctx, ids)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExistingImages indicates an expected call of GetExistingImages.
+func (mr *MockRepositoryMockRecorder) GetExistingImages(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExistingImages", reflect.TypeOf((*MockRepository)(nil).GetExistingImages), ctx, ids)
+}
+
+// GetIdempotencyKey mocks base method.
+func (m *MockRepository) GetIdempotencyKey(ctx context.Context, key, operation, userUUID string) (models.IdempotencyKey, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIdempotencyKey", ctx, key, operation, userUUID)
+	ret0, _ := ret[0].(models.IdempotencyKey)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetIdempotencyKey indicates an expected call of GetIdempotencyKey.
+func (mr *MockRepositoryMockRecorder) GetIdempotencyKey(ctx, key, operation, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIdempotencyKey", reflect.TypeOf((*MockRepository)(nil).GetIdempotencyKey), ctx, key, operation, userUUID)
+}
+
+// GetMaintenances mocks base method.
+func (m *MockRepository) GetMaintenances(ctx context.Context, carUUID string) ([]models.Maintenance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaintenances", ctx, carUUID)
+	ret0, _ := ret[0].([]models.Maintenance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMaintenances indicates an expected call of GetMaintenances.
+func (mr *MockRepositoryMockRecorder) GetMaintenances(ctx, carUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaintenances", reflect.TypeOf((*MockRepository)(nil).GetMaintenances), ctx, carUUID)
+}
+
+// GetPendingOutboxEventTx mocks base method.
+func (m *MockRepository) GetPendingOutboxEventTx(ctx context.Context, tx db.SqlTx, maxAttempts int) (models.OutboxEvent, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingOutboxEventTx", ctx, tx, maxAttempts)
+	ret0, _ := ret[0].(models.OutboxEvent)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPendingOutboxEventTx indicates an expected call of GetPendingOutboxEventTx.
+func (mr *MockRepositoryMockRecorder) GetPendingOutboxEventTx(ctx, tx, maxAttempts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingOutboxEventTx", reflect.TypeOf((*MockRepository)(nil).GetPendingOutboxEventTx), ctx, tx, maxAttempts)
+}
+
+// GetPromoCodeTx mocks base method.
+func (m *MockRepository) GetPromoCodeTx(ctx context.Context, tx db.SqlTx, code string) (models.PromoCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPromoCodeTx", ctx, tx, code)
+	ret0, _ := ret[0].(models.PromoCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPromoCodeTx indicates an expected call of GetPromoCodeTx.
+func (mr *MockRepositoryMockRecorder) GetPromoCodeTx(ctx, tx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPromoCodeTx", reflect.TypeOf((*MockRepository)(nil).GetPromoCodeTx), ctx, tx, code)
+}
+
+// GetPromoUsageTx mocks base method.
+func (m *MockRepository) GetPromoUsageTx(ctx context.Context, tx db.SqlTx, code, userUUID string) (models.PromoUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPromoUsageTx", ctx, tx, code, userUUID)
+	ret0, _ := ret[0].(models.PromoUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPromoUsageTx indicates an expected call of GetPromoUsageTx.
+func (mr *MockRepositoryMockRecorder) GetPromoUsageTx(ctx, tx, code, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPromoUsageTx", reflect.TypeOf((*MockRepository)(nil).GetPromoUsageTx), ctx, tx, code, userUUID)
+}
+
+// GetRentStatusTx mocks base method.
+func (m *MockRepository) GetRentStatusTx(ctx context.Context, tx db.SqlTx, rentUUID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRentStatusTx", ctx, tx, rentUUID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRentStatusTx indicates an expected call of GetRentStatusTx.
+func (mr *MockRepositoryMockRecorder) GetRentStatusTx(ctx, tx, rentUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRentStatusTx", reflect.TypeOf((*MockRepository)(nil).GetRentStatusTx), ctx, tx, rentUUID)
+}
+
+// GetRentsWhatStartsOnDate mocks base method.
+func (m *MockRepository) GetRentsWhatStartsOnDate(ctx context.Context, date time.Time) ([]models.RentStartData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRentsWhatStartsOnDate", ctx, date)
+	ret0, _ := ret[0].([]models.RentStartData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRentsWhatStartsOnDate indicates an expected call of GetRentsWhatStartsOnDate.
+func (mr *MockRepositoryMockRecorder) GetRentsWhatStartsOnDate(ctx, date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRentsWhatStartsOnDate", reflect.TypeOf((*MockRepository)(nil).GetRentsWhatStartsOnDate), ctx, date)
+}
+
+// GetRentsWithoutCharge mocks base method.
+func (m *MockRepository) GetRentsWithoutCharge(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRentsWithoutCharge", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRentsWithoutCharge indicates an expected call of GetRentsWithoutCharge.
+func (mr *MockRepositoryMockRecorder) GetRentsWithoutCharge(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRentsWithoutCharge", reflect.TypeOf((*MockRepository)(nil).GetRentsWithoutCharge), ctx)
+}
+
+// GetStation mocks base method.
+func (m *MockRepository) GetStation(ctx context.Context, uuid string) (models.Station, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStation", ctx, uuid)
+	ret0, _ := ret[0].(models.Station)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStation indicates an expected call of GetStation.
+func (mr *MockRepositoryMockRecorder) GetStation(ctx, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStation", reflect.TypeOf((*MockRepository)(nil).GetStation), ctx, uuid)
+}
+
+// GetStations mocks base method.
+func (m *MockRepository) GetStations(ctx context.Context, near models.Area) ([]models.Station, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStations", ctx, near)
+	ret0, _ := ret[0].([]models.Station)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStations indicates an expected call of GetStations.
+func (mr *MockRepositoryMockRecorder) GetStations(ctx, near interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStations", reflect.TypeOf((*MockRepository)(nil).GetStations), ctx, near)
+}
+
+// GetStuckCharges mocks base method.
+func (m *MockRepository) GetStuckCharges(ctx context.Context, rentEndedBefore time.Time) ([]models.Charge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStuckCharges", ctx, rentEndedBefore)
+	ret0, _ := ret[0].([]models.Charge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStuckCharges indicates an expected call of GetStuckCharges.
+func (mr *MockRepositoryMockRecorder) GetStuckCharges(ctx, rentEndedBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStuckCharges", reflect.TypeOf((*MockRepository)(nil).GetStuckCharges), ctx, rentEndedBefore)
+}
+
+// LinkLegacyImages mocks base method.
+func (m *MockRepository) LinkLegacyImages(ctx context.Context, carUUID string, ids []string) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCarsByParams", ctx, params)
-	ret0, _ := ret[0].([]models.CarMainInfo)
+	ret := m.ctrl.Call(m, "LinkLegacyImages", ctx, carUUID, ids)
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCarsByParams indicates an expected call of GetCarsByParams.
-func (mr *MockRepositoryMockRecorder) GetCarsByParams(ctx, params interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCarsByParams", reflect.TypeOf((*MockRepository)(nil).GetCarsByParams), ctx, params)
+// LinkLegacyImages indicates an expected call of LinkLegacyImages.
+func (mr *MockRepositoryMockRecorder) LinkLegacyImages(ctx, carUUID, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkLegacyImages", reflect.TypeOf((*MockRepository)(nil).LinkLegacyImages), ctx, carUUID, ids)
+}
+
+// MarkOutboxEventFailedTx mocks base method.
+func (m *MockRepository) MarkOutboxEventFailedTx(ctx context.Context, tx db.SqlTx, id int64, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOutboxEventFailedTx", ctx, tx, id, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkOutboxEventFailedTx indicates an expected call of MarkOutboxEventFailedTx.
+func (mr *MockRepositoryMockRecorder) MarkOutboxEventFailedTx(ctx, tx, id, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOutboxEventFailedTx", reflect.TypeOf((*MockRepository)(nil).MarkOutboxEventFailedTx), ctx, tx, id, reason)
+}
+
+// MarkOutboxEventProcessedTx mocks base method.
+func (m *MockRepository) MarkOutboxEventProcessedTx(ctx context.Context, tx db.SqlTx, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOutboxEventProcessedTx", ctx, tx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkOutboxEventProcessedTx indicates an expected call of MarkOutboxEventProcessedTx.
+func (mr *MockRepositoryMockRecorder) MarkOutboxEventProcessedTx(ctx, tx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOutboxEventProcessedTx", reflect.TypeOf((*MockRepository)(nil).MarkOutboxEventProcessedTx), ctx, tx, id)
+}
+
+// RefundChargeTx mocks base method.
+func (m *MockRepository) RefundChargeTx(ctx context.Context, tx db.SqlTx, chargeUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundChargeTx", ctx, tx, chargeUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefundChargeTx indicates an expected call of RefundChargeTx.
+func (mr *MockRepositoryMockRecorder) RefundChargeTx(ctx, tx, chargeUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundChargeTx", reflect.TypeOf((*MockRepository)(nil).RefundChargeTx), ctx, tx, chargeUUID)
+}
+
+// ReorderCarImages mocks base method.
+func (m *MockRepository) ReorderCarImages(ctx context.Context, carUUID string, ids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderCarImages", ctx, carUUID, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderCarImages indicates an expected call of ReorderCarImages.
+func (mr *MockRepositoryMockRecorder) ReorderCarImages(ctx, carUUID, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderCarImages", reflect.TypeOf((*MockRepository)(nil).ReorderCarImages), ctx, carUUID, ids)
+}
+
+// RequeueChargeEvent mocks base method.
+func (m *MockRepository) RequeueChargeEvent(ctx context.Context, eventType, chargeUUID string, maxAttempts int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequeueChargeEvent", ctx, eventType, chargeUUID, maxAttempts)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequeueChargeEvent indicates an expected call of RequeueChargeEvent.
+func (mr *MockRepositoryMockRecorder) RequeueChargeEvent(ctx, eventType, chargeUUID, maxAttempts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequeueChargeEvent", reflect.TypeOf((*MockRepository)(nil).RequeueChargeEvent), ctx, eventType, chargeUUID, maxAttempts)
+}
+
+// ScheduleMaintenance mocks base method.
+func (m_2 *MockRepository) ScheduleMaintenance(ctx context.Context, m models.Maintenance) ([]models.RentConflict, error) {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "ScheduleMaintenance", ctx, m)
+	ret0, _ := ret[0].([]models.RentConflict)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleMaintenance indicates an expected call of ScheduleMaintenance.
+func (mr *MockRepositoryMockRecorder) ScheduleMaintenance(ctx, m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleMaintenance", reflect.TypeOf((*MockRepository)(nil).ScheduleMaintenance), ctx, m)
+}
+
+// SearchCars mocks base method.
+func (m *MockRepository) SearchCars(ctx context.Context, req models.SearchCarsReq, page models.Page) (models.SearchCarsRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchCars", ctx, req, page)
+	ret0, _ := ret[0].(models.SearchCarsRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchCars indicates an expected call of SearchCars.
+func (mr *MockRepositoryMockRecorder) SearchCars(ctx, req, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchCars", reflect.TypeOf((*MockRepository)(nil).SearchCars), ctx, req, page)
+}
+
+// SetMainCarImage mocks base method.
+func (m *MockRepository) SetMainCarImage(ctx context.Context, carUUID, imageId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMainCarImage", ctx, carUUID, imageId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMainCarImage indicates an expected call of SetMainCarImage.
+func (mr *MockRepositoryMockRecorder) SetMainCarImage(ctx, carUUID, imageId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMainCarImage", reflect.TypeOf((*MockRepository)(nil).SetMainCarImage), ctx, carUUID, imageId)
+}
+
+// StartTx mocks base method.
+func (m *MockRepository) StartTx(ctx context.Context) (db.SqlTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTx", ctx)
+	ret0, _ := ret[0].(db.SqlTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartTx indicates an expected call of StartTx.
+func (mr *MockRepositoryMockRecorder) StartTx(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTx", reflect.TypeOf((*MockRepository)(nil).StartTx), ctx)
+}
+
+// UpdateCar mocks base method.
+func (m *MockRepository) UpdateCar(ctx context.Context, req models.UpdateCarReq, actor string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCar", ctx, req, actor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCar indicates an expected call of UpdateCar.
+func (mr *MockRepositoryMockRecorder) UpdateCar(ctx, req, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCar", reflect.TypeOf((*MockRepository)(nil).UpdateCar), ctx, req, actor)
+}
+
+// UpdateChargeStatusTx mocks base method.
+func (m *MockRepository) UpdateChargeStatusTx(ctx context.Context, tx db.SqlTx, chargeUUID, status string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateChargeStatusTx", ctx, tx, chargeUUID, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateChargeStatusTx indicates an expected call of UpdateChargeStatusTx.
+func (mr *MockRepositoryMockRecorder) UpdateChargeStatusTx(ctx, tx, chargeUUID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChargeStatusTx", reflect.TypeOf((*MockRepository)(nil).UpdateChargeStatusTx), ctx, tx, chargeUUID, status)
+}
+
+// UpdateRentStatusTx mocks base method.
+func (m *MockRepository) UpdateRentStatusTx(ctx context.Context, tx db.SqlTx, rentUUID, status string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRentStatusTx", ctx, tx, rentUUID, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRentStatusTx indicates an expected call of UpdateRentStatusTx.
+func (mr *MockRepositoryMockRecorder) UpdateRentStatusTx(ctx, tx, rentUUID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRentStatusTx", reflect.TypeOf((*MockRepository)(nil).UpdateRentStatusTx), ctx, tx, rentUUID, status)
+}
+
+// MockPromoRepository is a mock of PromoRepository interface.
+type MockPromoRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPromoRepositoryMockRecorder
+}
+
+// MockPromoRepositoryMockRecorder is the mock recorder for MockPromoRepository.
+type MockPromoRepositoryMockRecorder struct {
+	mock *MockPromoRepository
+}
+
+// NewMockPromoRepository creates a new mock instance.
+func NewMockPromoRepository(ctrl *gomock.Controller) *MockPromoRepository {
+	mock := &MockPromoRepository{ctrl: ctrl}
+	mock.recorder = &MockPromoRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPromoRepository) EXPECT() *MockPromoRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreatePromoCode mocks base method.
+func (m *MockPromoRepository) CreatePromoCode(ctx context.Context, promo models.PromoCode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePromoCode", ctx, promo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePromoCode indicates an expected call of CreatePromoCode.
+func (mr *MockPromoRepositoryMockRecorder) CreatePromoCode(ctx, promo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePromoCode", reflect.TypeOf((*MockPromoRepository)(nil).CreatePromoCode), ctx, promo)
+}
+
+// ExpirePromoCode mocks base method.
+func (m *MockPromoRepository) ExpirePromoCode(ctx context.Context, code string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpirePromoCode", ctx, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpirePromoCode indicates an expected call of ExpirePromoCode.
+func (mr *MockPromoRepositoryMockRecorder) ExpirePromoCode(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpirePromoCode", reflect.TypeOf((*MockPromoRepository)(nil).ExpirePromoCode), ctx, code)
+}
+
+// MockPaymentRepository is a mock of PaymentRepository interface.
+type MockPaymentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentRepositoryMockRecorder
+}
+
+// MockPaymentRepositoryMockRecorder is the mock recorder for MockPaymentRepository.
+type MockPaymentRepositoryMockRecorder struct {
+	mock *MockPaymentRepository
+}
+
+// NewMockPaymentRepository creates a new mock instance.
+func NewMockPaymentRepository(ctrl *gomock.Controller) *MockPaymentRepository {
+	mock := &MockPaymentRepository{ctrl: ctrl}
+	mock.recorder = &MockPaymentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentRepository) EXPECT() *MockPaymentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetExistingCharges mocks base method.
+func (m *MockPaymentRepository) GetExistingCharges(ctx context.Context, chargeUUIDs []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExistingCharges", ctx, chargeUUIDs)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExistingCharges indicates an expected call of GetExistingCharges.
+func (mr *MockPaymentRepositoryMockRecorder) GetExistingCharges(ctx, chargeUUIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExistingCharges", reflect.TypeOf((*MockPaymentRepository)(nil).GetExistingCharges), ctx, chargeUUIDs)
+}
+
+// GetRentsWithoutCharge mocks base method.
+func (m *MockPaymentRepository) GetRentsWithoutCharge(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRentsWithoutCharge", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRentsWithoutCharge indicates an expected call of GetRentsWithoutCharge.
+func (mr *MockPaymentRepositoryMockRecorder) GetRentsWithoutCharge(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRentsWithoutCharge", reflect.TypeOf((*MockPaymentRepository)(nil).GetRentsWithoutCharge), ctx)
+}
+
+// GetStuckCharges mocks base method.
+func (m *MockPaymentRepository) GetStuckCharges(ctx context.Context, rentEndedBefore time.Time) ([]models.Charge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStuckCharges", ctx, rentEndedBefore)
+	ret0, _ := ret[0].([]models.Charge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStuckCharges indicates an expected call of GetStuckCharges.
+func (mr *MockPaymentRepositoryMockRecorder) GetStuckCharges(ctx, rentEndedBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStuckCharges", reflect.TypeOf((*MockPaymentRepository)(nil).GetStuckCharges), ctx, rentEndedBefore)
+}
+
+// RequeueChargeEvent mocks base method.
+func (m *MockPaymentRepository) RequeueChargeEvent(ctx context.Context, eventType, chargeUUID string, maxAttempts int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequeueChargeEvent", ctx, eventType, chargeUUID, maxAttempts)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequeueChargeEvent indicates an expected call of RequeueChargeEvent.
+func (mr *MockPaymentRepositoryMockRecorder) RequeueChargeEvent(ctx, eventType, chargeUUID, maxAttempts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequeueChargeEvent", reflect.TypeOf((*MockPaymentRepository)(nil).RequeueChargeEvent), ctx, eventType, chargeUUID, maxAttempts)
+}
+
+// MockIdempotencyRepository is a mock of IdempotencyRepository interface.
+type MockIdempotencyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdempotencyRepositoryMockRecorder
+}
+
+// MockIdempotencyRepositoryMockRecorder is the mock recorder for MockIdempotencyRepository.
+type MockIdempotencyRepositoryMockRecorder struct {
+	mock *MockIdempotencyRepository
+}
+
+// NewMockIdempotencyRepository creates a new mock instance.
+func NewMockIdempotencyRepository(ctrl *gomock.Controller) *MockIdempotencyRepository {
+	mock := &MockIdempotencyRepository{ctrl: ctrl}
+	mock.recorder = &MockIdempotencyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdempotencyRepository) EXPECT() *MockIdempotencyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetIdempotencyKey mocks base method.
+func (m *MockIdempotencyRepository) GetIdempotencyKey(ctx context.Context, key, operation, userUUID string) (models.IdempotencyKey, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIdempotencyKey", ctx, key, operation, userUUID)
+	ret0, _ := ret[0].(models.IdempotencyKey)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetIdempotencyKey indicates an expected call of GetIdempotencyKey.
+func (mr *MockIdempotencyRepositoryMockRecorder) GetIdempotencyKey(ctx, key, operation, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIdempotencyKey", reflect.TypeOf((*MockIdempotencyRepository)(nil).GetIdempotencyKey), ctx, key, operation, userUUID)
+}
+
+// MockAdminRepository is a mock of AdminRepository interface.
+type MockAdminRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminRepositoryMockRecorder
+}
+
+// MockAdminRepositoryMockRecorder is the mock recorder for MockAdminRepository.
+type MockAdminRepositoryMockRecorder struct {
+	mock *MockAdminRepository
+}
+
+// NewMockAdminRepository creates a new mock instance.
+func NewMockAdminRepository(ctrl *gomock.Controller) *MockAdminRepository {
+	mock := &MockAdminRepository{ctrl: ctrl}
+	mock.recorder = &MockAdminRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminRepository) EXPECT() *MockAdminRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateCar mocks base method.
+func (m *MockAdminRepository) CreateCar(ctx context.Context, car models.Car, actor string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCar", ctx, car, actor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCar indicates an expected call of CreateCar.
+func (mr *MockAdminRepositoryMockRecorder) CreateCar(ctx, car, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCar", reflect.TypeOf((*MockAdminRepository)(nil).CreateCar), ctx, car, actor)
+}
+
+// DeleteCar mocks base method.
+func (m *MockAdminRepository) DeleteCar(ctx context.Context, uuid, actor string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCar", ctx, uuid, actor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCar indicates an expected call of DeleteCar.
+func (mr *MockAdminRepositoryMockRecorder) DeleteCar(ctx, uuid, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCar", reflect.TypeOf((*MockAdminRepository)(nil).DeleteCar), ctx, uuid, actor)
+}
+
+// GetCarChanges mocks base method.
+func (m *MockAdminRepository) GetCarChanges(ctx context.Context, carUUID string) ([]models.CarChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCarChanges", ctx, carUUID)
+	ret0, _ := ret[0].([]models.CarChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCarChanges indicates an expected call of GetCarChanges.
+func (mr *MockAdminRepositoryMockRecorder) GetCarChanges(ctx, carUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCarChanges", reflect.TypeOf((*MockAdminRepository)(nil).GetCarChanges), ctx, carUUID)
+}
+
+// UpdateCar mocks base method.
+func (m *MockAdminRepository) UpdateCar(ctx context.Context, req models.UpdateCarReq, actor string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCar", ctx, req, actor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCar indicates an expected call of UpdateCar.
+func (mr *MockAdminRepositoryMockRecorder) UpdateCar(ctx, req, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCar", reflect.TypeOf((*MockAdminRepository)(nil).UpdateCar), ctx, req, actor)
+}
+
+// MockImageRepository is a mock of ImageRepository interface.
+type MockImageRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockImageRepositoryMockRecorder
+}
+
+// MockImageRepositoryMockRecorder is the mock recorder for MockImageRepository.
+type MockImageRepositoryMockRecorder struct {
+	mock *MockImageRepository
+}
+
+// NewMockImageRepository creates a new mock instance.
+func NewMockImageRepository(ctrl *gomock.Controller) *MockImageRepository {
+	mock := &MockImageRepository{ctrl: ctrl}
+	mock.recorder = &MockImageRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImageRepository) EXPECT() *MockImageRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddCarImage mocks base method.
+func (m *MockImageRepository) AddCarImage(ctx context.Context, carUUID string, image models.CarImage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCarImage", ctx, carUUID, image)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddCarImage indicates an expected call of AddCarImage.
+func (mr *MockImageRepositoryMockRecorder) AddCarImage(ctx, carUUID, image interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCarImage", reflect.TypeOf((*MockImageRepository)(nil).AddCarImage), ctx, carUUID, image)
+}
+
+// DeleteCarImage mocks base method.
+func (m *MockImageRepository) DeleteCarImage(ctx context.Context, carUUID, imageId string) (models.CarImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCarImage", ctx, carUUID, imageId)
+	ret0, _ := ret[0].(models.CarImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCarImage indicates an expected call of DeleteCarImage.
+func (mr *MockImageRepositoryMockRecorder) DeleteCarImage(ctx, carUUID, imageId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCarImage", reflect.TypeOf((*MockImageRepository)(nil).DeleteCarImage), ctx, carUUID, imageId)
+}
+
+// GetCarImages mocks base method.
+func (m *MockImageRepository) GetCarImages(ctx context.Context, carUUID string) ([]models.CarImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCarImages", ctx, carUUID)
+	ret0, _ := ret[0].([]models.CarImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCarImages indicates an expected call of GetCarImages.
+func (mr *MockImageRepositoryMockRecorder) GetCarImages(ctx, carUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCarImages", reflect.TypeOf((*MockImageRepository)(nil).GetCarImages), ctx, carUUID)
+}
+
+// GetExistingImages mocks base method.
+func (m *MockImageRepository) GetExistingImages(ctx context.Context, ids []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExistingImages", ctx, ids)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExistingImages indicates an expected call of GetExistingImages.
+func (mr *MockImageRepositoryMockRecorder) GetExistingImages(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExistingImages", reflect.TypeOf((*MockImageRepository)(nil).GetExistingImages), ctx, ids)
+}
+
+// LinkLegacyImages mocks base method.
+func (m *MockImageRepository) LinkLegacyImages(ctx context.Context, carUUID string, ids []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkLegacyImages", ctx, carUUID, ids)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LinkLegacyImages indicates an expected call of LinkLegacyImages.
+func (mr *MockImageRepositoryMockRecorder) LinkLegacyImages(ctx, carUUID, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkLegacyImages", reflect.TypeOf((*MockImageRepository)(nil).LinkLegacyImages), ctx, carUUID, ids)
+}
+
+// ReorderCarImages mocks base method.
+func (m *MockImageRepository) ReorderCarImages(ctx context.Context, carUUID string, ids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderCarImages", ctx, carUUID, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderCarImages indicates an expected call of ReorderCarImages.
+func (mr *MockImageRepositoryMockRecorder) ReorderCarImages(ctx, carUUID, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderCarImages", reflect.TypeOf((*MockImageRepository)(nil).ReorderCarImages), ctx, carUUID, ids)
+}
+
+// SetMainCarImage mocks base method.
+func (m *MockImageRepository) SetMainCarImage(ctx context.Context, carUUID, imageId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMainCarImage", ctx, carUUID, imageId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMainCarImage indicates an expected call of SetMainCarImage.
+func (mr *MockImageRepositoryMockRecorder) SetMainCarImage(ctx, carUUID, imageId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMainCarImage", reflect.TypeOf((*MockImageRepository)(nil).SetMainCarImage), ctx, carUUID, imageId)
+}
+
+// MockStationRepository is a mock of StationRepository interface.
+type MockStationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockStationRepositoryMockRecorder
+}
+
+// MockStationRepositoryMockRecorder is the mock recorder for MockStationRepository.
+type MockStationRepositoryMockRecorder struct {
+	mock *MockStationRepository
 }
 
-// GetRentsWhatStartsOnDate mocks base method.
-func (m *MockRepository) GetRentsWhatStartsOnDate(ctx context.Context, date time.Time) ([]models.RentStartData, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRentsWhatStartsOnDate", ctx, date)
-	ret0, _ := ret[0].([]models.RentStartData)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+// NewMockStationRepository creates a new mock instance.
+func NewMockStationRepository(ctrl *gomock.Controller) *MockStationRepository {
+	mock := &MockStationRepository{ctrl: ctrl}
+	mock.recorder = &MockStationRepositoryMockRecorder{mock}
+	return mock
 }
 
-// GetRentsWhatStartsOnDate indicates an expected call of GetRentsWhatStartsOnDate.
-func (mr *MockRepositoryMockRecorder) GetRentsWhatStartsOnDate(ctx, date interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRentsWhatStartsOnDate", reflect.TypeOf((*MockRepository)(nil).GetRentsWhatStartsOnDate), ctx, date)
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStationRepository) EXPECT() *MockStationRepositoryMockRecorder {
+	return m.recorder
 }
 
-// RefundChargeTx mocks base method.
-func (m *MockRepository) RefundChargeTx(ctx context.Context, tx db.SqlTx, chargeUUID string) error {
+// CreateStation mocks base method.
+func (m *MockStationRepository) CreateStation(ctx context.Context, station models.Station) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RefundChargeTx", ctx, tx, chargeUUID)
+	ret := m.ctrl.Call(m, "CreateStation", ctx, station)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// RefundChargeTx indicates an expected call of RefundChargeTx.
-func (mr *MockRepositoryMockRecorder) RefundChargeTx(ctx, tx, chargeUUID interface{}) *gomock.Call {
+// CreateStation indicates an expected call of CreateStation.
+func (mr *MockStationRepositoryMockRecorder) CreateStation(ctx, station interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundChargeTx", reflect.TypeOf((*MockRepository)(nil).RefundChargeTx), ctx, tx, chargeUUID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStation", reflect.TypeOf((*MockStationRepository)(nil).CreateStation), ctx, station)
 }
 
-// StartTx mocks base method.
-func (m *MockRepository) StartTx(ctx context.Context) (db.SqlTx, error) {
+// GetStation mocks base method.
+func (m *MockStationRepository) GetStation(ctx context.Context, uuid string) (models.Station, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StartTx", ctx)
-	ret0, _ := ret[0].(db.SqlTx)
+	ret := m.ctrl.Call(m, "GetStation", ctx, uuid)
+	ret0, _ := ret[0].(models.Station)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// StartTx indicates an expected call of StartTx.
-func (mr *MockRepositoryMockRecorder) StartTx(ctx interface{}) *gomock.Call {
+// GetStation indicates an expected call of GetStation.
+func (mr *MockStationRepositoryMockRecorder) GetStation(ctx, uuid interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTx", reflect.TypeOf((*MockRepository)(nil).StartTx), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStation", reflect.TypeOf((*MockStationRepository)(nil).GetStation), ctx, uuid)
 }
 
-// UpdateCarPrice mocks base method.
-func (m *MockRepository) UpdateCarPrice(ctx context.Context, req models.UpdateCarPriceReq) error {
+// GetStations mocks base method.
+func (m *MockStationRepository) GetStations(ctx context.Context, near models.Area) ([]models.Station, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateCarPrice", ctx, req)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetStations", ctx, near)
+	ret0, _ := ret[0].([]models.Station)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpdateCarPrice indicates an expected call of UpdateCarPrice.
-func (mr *MockRepositoryMockRecorder) UpdateCarPrice(ctx, req interface{}) *gomock.Call {
+// GetStations indicates an expected call of GetStations.
+func (mr *MockStationRepositoryMockRecorder) GetStations(ctx, near interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCarPrice", reflect.TypeOf((*MockRepository)(nil).UpdateCarPrice), ctx, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStations", reflect.TypeOf((*MockStationRepository)(nil).GetStations), ctx, near)
 }
 
-// MockAdminRepository is a mock of AdminRepository interface.
-type MockAdminRepository struct {
+// MockMaintenanceRepository is a mock of MaintenanceRepository interface.
+type MockMaintenanceRepository struct {
 	ctrl     *gomock.Controller
-	recorder *MockAdminRepositoryMockRecorder
+	recorder *MockMaintenanceRepositoryMockRecorder
 }
 
-// MockAdminRepositoryMockRecorder is the mock recorder for MockAdminRepository.
-type MockAdminRepositoryMockRecorder struct {
-	mock *MockAdminRepository
+// MockMaintenanceRepositoryMockRecorder is the mock recorder for MockMaintenanceRepository.
+type MockMaintenanceRepositoryMockRecorder struct {
+	mock *MockMaintenanceRepository
 }
 
-// NewMockAdminRepository creates a new mock instance.
-func NewMockAdminRepository(ctrl *gomock.Controller) *MockAdminRepository {
-	mock := &MockAdminRepository{ctrl: ctrl}
-	mock.recorder = &MockAdminRepositoryMockRecorder{mock}
+// NewMockMaintenanceRepository creates a new mock instance.
+func NewMockMaintenanceRepository(ctrl *gomock.Controller) *MockMaintenanceRepository {
+	mock := &MockMaintenanceRepository{ctrl: ctrl}
+	mock.recorder = &MockMaintenanceRepositoryMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockAdminRepository) EXPECT() *MockAdminRepositoryMockRecorder {
+func (m *MockMaintenanceRepository) EXPECT() *MockMaintenanceRepositoryMockRecorder {
 	return m.recorder
 }
 
-// CreateCar mocks base method.
-func (m *MockAdminRepository) CreateCar(ctx context.Context, car models.Car) error {
+// CloseMaintenance mocks base method.
+func (m *MockMaintenanceRepository) CloseMaintenance(ctx context.Context, req models.CloseMaintenanceReq) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateCar", ctx, car)
+	ret := m.ctrl.Call(m, "CloseMaintenance", ctx, req)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// CreateCar indicates an expected call of CreateCar.
-func (mr *MockAdminRepositoryMockRecorder) CreateCar(ctx, car interface{}) *gomock.Call {
+// CloseMaintenance indicates an expected call of CloseMaintenance.
+func (mr *MockMaintenanceRepositoryMockRecorder) CloseMaintenance(ctx, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCar", reflect.TypeOf((*MockAdminRepository)(nil).CreateCar), ctx, car)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseMaintenance", reflect.TypeOf((*MockMaintenanceRepository)(nil).CloseMaintenance), ctx, req)
 }
 
-// DeleteCar mocks base method.
-func (m *MockAdminRepository) DeleteCar(ctx context.Context, uuid string) error {
+// GetMaintenances mocks base method.
+func (m *MockMaintenanceRepository) GetMaintenances(ctx context.Context, carUUID string) ([]models.Maintenance, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteCar", ctx, uuid)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetMaintenances", ctx, carUUID)
+	ret0, _ := ret[0].([]models.Maintenance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteCar indicates an expected call of DeleteCar.
-func (mr *MockAdminRepositoryMockRecorder) DeleteCar(ctx, uuid interface{}) *gomock.Call {
+// GetMaintenances indicates an expected call of GetMaintenances.
+func (mr *MockMaintenanceRepositoryMockRecorder) GetMaintenances(ctx, carUUID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCar", reflect.TypeOf((*MockAdminRepository)(nil).DeleteCar), ctx, uuid)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaintenances", reflect.TypeOf((*MockMaintenanceRepository)(nil).GetMaintenances), ctx, carUUID)
 }
 
-// UpdateCarPrice mocks base method.
-func (m *MockAdminRepository) UpdateCarPrice(ctx context.Context, req models.UpdateCarPriceReq) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateCarPrice", ctx, req)
-	ret0, _ := ret[0].(error)
-	return ret0
+// ScheduleMaintenance mocks base method.
+func (m_2 *MockMaintenanceRepository) ScheduleMaintenance(ctx context.Context, m models.Maintenance) ([]models.RentConflict, error) {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "ScheduleMaintenance", ctx, m)
+	ret0, _ := ret[0].([]models.RentConflict)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpdateCarPrice indicates an expected call of UpdateCarPrice.
-func (mr *MockAdminRepositoryMockRecorder) UpdateCarPrice(ctx, req interface{}) *gomock.Call {
+// ScheduleMaintenance indicates an expected call of ScheduleMaintenance.
+func (mr *MockMaintenanceRepositoryMockRecorder) ScheduleMaintenance(ctx, m interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCarPrice", reflect.TypeOf((*MockAdminRepository)(nil).UpdateCarPrice), ctx, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleMaintenance", reflect.TypeOf((*MockMaintenanceRepository)(nil).ScheduleMaintenance), ctx, m)
 }
 
 // MockCarRepository is a mock of CarRepository interface.
@@ -331,18 +1276,18 @@ func (m *MockCarRepository) EXPECT() *MockCarRepositoryMockRecorder {
 }
 
 // GetAvailableCars mocks base method.
-func (m *MockCarRepository) GetAvailableCars(ctx context.Context, period models.Period) ([]models.CarMainInfo, error) {
+func (m *MockCarRepository) GetAvailableCars(ctx context.Context, period models.Period, params models.CarParams, page models.Page) (models.CarsPage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAvailableCars", ctx, period)
-	ret0, _ := ret[0].([]models.CarMainInfo)
+	ret := m.ctrl.Call(m, "GetAvailableCars", ctx, period, params, page)
+	ret0, _ := ret[0].(models.CarsPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAvailableCars indicates an expected call of GetAvailableCars.
-func (mr *MockCarRepositoryMockRecorder) GetAvailableCars(ctx, period interface{}) *gomock.Call {
+func (mr *MockCarRepositoryMockRecorder) GetAvailableCars(ctx, period, params, page interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailableCars", reflect.TypeOf((*MockCarRepository)(nil).GetAvailableCars), ctx, period)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailableCars", reflect.TypeOf((*MockCarRepository)(nil).GetAvailableCars), ctx, period, params, page)
 }
 
 // GetCarByUUID mocks base method.
@@ -360,19 +1305,49 @@ func (mr *MockCarRepositoryMockRecorder) GetCarByUUID(ctx, uuid interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCarByUUID", reflect.TypeOf((*MockCarRepository)(nil).GetCarByUUID), ctx, uuid)
 }
 
+// GetCarPricing mocks base method.
+func (m *MockCarRepository) GetCarPricing(ctx context.Context, uuid string) (models.CarPricing, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCarPricing", ctx, uuid)
+	ret0, _ := ret[0].(models.CarPricing)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCarPricing indicates an expected call of GetCarPricing.
+func (mr *MockCarRepositoryMockRecorder) GetCarPricing(ctx, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCarPricing", reflect.TypeOf((*MockCarRepository)(nil).GetCarPricing), ctx, uuid)
+}
+
 // GetCarsByParams mocks base method.
-func (m *MockCarRepository) GetCarsByParams(ctx context.Context, params models.CarParams) ([]models.CarMainInfo, error) {
+func (m *MockCarRepository) GetCarsByParams(ctx context.Context, params models.CarParams, page models.Page) (models.CarsPage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCarsByParams", ctx, params)
-	ret0, _ := ret[0].([]models.CarMainInfo)
+	ret := m.ctrl.Call(m, "GetCarsByParams", ctx, params, page)
+	ret0, _ := ret[0].(models.CarsPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCarsByParams indicates an expected call of GetCarsByParams.
-func (mr *MockCarRepositoryMockRecorder) GetCarsByParams(ctx, params interface{}) *gomock.Call {
+func (mr *MockCarRepositoryMockRecorder) GetCarsByParams(ctx, params, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCarsByParams", reflect.TypeOf((*MockCarRepository)(nil).GetCarsByParams), ctx, params, page)
+}
+
+// SearchCars mocks base method.
+func (m *MockCarRepository) SearchCars(ctx context.Context, req models.SearchCarsReq, page models.Page) (models.SearchCarsRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchCars", ctx, req, page)
+	ret0, _ := ret[0].(models.SearchCarsRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchCars indicates an expected call of SearchCars.
+func (mr *MockCarRepositoryMockRecorder) SearchCars(ctx, req, page interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCarsByParams", reflect.TypeOf((*MockCarRepository)(nil).GetCarsByParams), ctx, params)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchCars", reflect.TypeOf((*MockCarRepository)(nil).SearchCars), ctx, req, page)
 }
 
 // MockRentRepository is a mock of RentRepository interface.
@@ -457,21 +1432,124 @@ func (mr *MockRentRepositoryMockRecorder) CreateChargeTx(ctx, tx, req interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChargeTx", reflect.TypeOf((*MockRentRepository)(nil).CreateChargeTx), ctx, tx, req)
 }
 
-// CreateRentTx mocks base method.
-func (m *MockRentRepository) CreateRentTx(ctx context.Context, tx db.SqlTx, req models.CreateRentReq) (float32, error) {
+// CreateIdempotencyKeyTx mocks base method.
+func (m *MockRentRepository) CreateIdempotencyKeyTx(ctx context.Context, tx db.SqlTx, key models.IdempotencyKey) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateRentTx", ctx, tx, req)
-	ret0, _ := ret[0].(float32)
+	ret := m.ctrl.Call(m, "CreateIdempotencyKeyTx", ctx, tx, key)
+	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// CreateIdempotencyKeyTx indicates an expected call of CreateIdempotencyKeyTx.
+func (mr *MockRentRepositoryMockRecorder) CreateIdempotencyKeyTx(ctx, tx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIdempotencyKeyTx", reflect.TypeOf((*MockRentRepository)(nil).CreateIdempotencyKeyTx), ctx, tx, key)
+}
+
+// CreateOutboxEventTx mocks base method.
+func (m *MockRentRepository) CreateOutboxEventTx(ctx context.Context, tx db.SqlTx, event models.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOutboxEventTx", ctx, tx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOutboxEventTx indicates an expected call of CreateOutboxEventTx.
+func (mr *MockRentRepositoryMockRecorder) CreateOutboxEventTx(ctx, tx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOutboxEventTx", reflect.TypeOf((*MockRentRepository)(nil).CreateOutboxEventTx), ctx, tx, event)
+}
+
+// CreatePromoRedemptionTx mocks base method.
+func (m *MockRentRepository) CreatePromoRedemptionTx(ctx context.Context, tx db.SqlTx, redemption models.PromoRedemption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePromoRedemptionTx", ctx, tx, redemption)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePromoRedemptionTx indicates an expected call of CreatePromoRedemptionTx.
+func (mr *MockRentRepositoryMockRecorder) CreatePromoRedemptionTx(ctx, tx, redemption interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePromoRedemptionTx", reflect.TypeOf((*MockRentRepository)(nil).CreatePromoRedemptionTx), ctx, tx, redemption)
+}
+
+// CreateRentTx mocks base method.
+func (m *MockRentRepository) CreateRentTx(ctx context.Context, tx db.SqlTx, req models.CreateRentReq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRentTx", ctx, tx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
 // CreateRentTx indicates an expected call of CreateRentTx.
 func (mr *MockRentRepositoryMockRecorder) CreateRentTx(ctx, tx, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRentTx", reflect.TypeOf((*MockRentRepository)(nil).CreateRentTx), ctx, tx, req)
 }
 
+// GetPendingOutboxEventTx mocks base method.
+func (m *MockRentRepository) GetPendingOutboxEventTx(ctx context.Context, tx db.SqlTx, maxAttempts int) (models.OutboxEvent, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingOutboxEventTx", ctx, tx, maxAttempts)
+	ret0, _ := ret[0].(models.OutboxEvent)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPendingOutboxEventTx indicates an expected call of GetPendingOutboxEventTx.
+func (mr *MockRentRepositoryMockRecorder) GetPendingOutboxEventTx(ctx, tx, maxAttempts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingOutboxEventTx", reflect.TypeOf((*MockRentRepository)(nil).GetPendingOutboxEventTx), ctx, tx, maxAttempts)
+}
+
+// GetPromoCodeTx mocks base method.
+func (m *MockRentRepository) GetPromoCodeTx(ctx context.Context, tx db.SqlTx, code string) (models.PromoCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPromoCodeTx", ctx, tx, code)
+	ret0, _ := ret[0].(models.PromoCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPromoCodeTx indicates an expected call of GetPromoCodeTx.
+func (mr *MockRentRepositoryMockRecorder) GetPromoCodeTx(ctx, tx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPromoCodeTx", reflect.TypeOf((*MockRentRepository)(nil).GetPromoCodeTx), ctx, tx, code)
+}
+
+// GetPromoUsageTx mocks base method.
+func (m *MockRentRepository) GetPromoUsageTx(ctx context.Context, tx db.SqlTx, code, userUUID string) (models.PromoUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPromoUsageTx", ctx, tx, code, userUUID)
+	ret0, _ := ret[0].(models.PromoUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPromoUsageTx indicates an expected call of GetPromoUsageTx.
+func (mr *MockRentRepositoryMockRecorder) GetPromoUsageTx(ctx, tx, code, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPromoUsageTx", reflect.TypeOf((*MockRentRepository)(nil).GetPromoUsageTx), ctx, tx, code, userUUID)
+}
+
+// GetRentStatusTx mocks base method.
+func (m *MockRentRepository) GetRentStatusTx(ctx context.Context, tx db.SqlTx, rentUUID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRentStatusTx", ctx, tx, rentUUID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRentStatusTx indicates an expected call of GetRentStatusTx.
+func (mr *MockRentRepositoryMockRecorder) GetRentStatusTx(ctx, tx, rentUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRentStatusTx", reflect.TypeOf((*MockRentRepository)(nil).GetRentStatusTx), ctx, tx, rentUUID)
+}
+
 // GetRentsWhatStartsOnDate mocks base method.
 func (m *MockRentRepository) GetRentsWhatStartsOnDate(ctx context.Context, date time.Time) ([]models.RentStartData, error) {
 	m.ctrl.T.Helper()
@@ -487,6 +1565,34 @@ func (mr *MockRentRepositoryMockRecorder) GetRentsWhatStartsOnDate(ctx, date int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRentsWhatStartsOnDate", reflect.TypeOf((*MockRentRepository)(nil).GetRentsWhatStartsOnDate), ctx, date)
 }
 
+// MarkOutboxEventFailedTx mocks base method.
+func (m *MockRentRepository) MarkOutboxEventFailedTx(ctx context.Context, tx db.SqlTx, id int64, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOutboxEventFailedTx", ctx, tx, id, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkOutboxEventFailedTx indicates an expected call of MarkOutboxEventFailedTx.
+func (mr *MockRentRepositoryMockRecorder) MarkOutboxEventFailedTx(ctx, tx, id, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOutboxEventFailedTx", reflect.TypeOf((*MockRentRepository)(nil).MarkOutboxEventFailedTx), ctx, tx, id, reason)
+}
+
+// MarkOutboxEventProcessedTx mocks base method.
+func (m *MockRentRepository) MarkOutboxEventProcessedTx(ctx context.Context, tx db.SqlTx, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOutboxEventProcessedTx", ctx, tx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkOutboxEventProcessedTx indicates an expected call of MarkOutboxEventProcessedTx.
+func (mr *MockRentRepositoryMockRecorder) MarkOutboxEventProcessedTx(ctx, tx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOutboxEventProcessedTx", reflect.TypeOf((*MockRentRepository)(nil).MarkOutboxEventProcessedTx), ctx, tx, id)
+}
+
 // RefundChargeTx mocks base method.
 func (m *MockRentRepository) RefundChargeTx(ctx context.Context, tx db.SqlTx, chargeUUID string) error {
 	m.ctrl.T.Helper()
@@ -516,6 +1622,34 @@ func (mr *MockRentRepositoryMockRecorder) StartTx(ctx interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTx", reflect.TypeOf((*MockRentRepository)(nil).StartTx), ctx)
 }
 
+// UpdateChargeStatusTx mocks base method.
+func (m *MockRentRepository) UpdateChargeStatusTx(ctx context.Context, tx db.SqlTx, chargeUUID, status string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateChargeStatusTx", ctx, tx, chargeUUID, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateChargeStatusTx indicates an expected call of UpdateChargeStatusTx.
+func (mr *MockRentRepositoryMockRecorder) UpdateChargeStatusTx(ctx, tx, chargeUUID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChargeStatusTx", reflect.TypeOf((*MockRentRepository)(nil).UpdateChargeStatusTx), ctx, tx, chargeUUID, status)
+}
+
+// UpdateRentStatusTx mocks base method.
+func (m *MockRentRepository) UpdateRentStatusTx(ctx context.Context, tx db.SqlTx, rentUUID, status string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRentStatusTx", ctx, tx, rentUUID, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRentStatusTx indicates an expected call of UpdateRentStatusTx.
+func (mr *MockRentRepositoryMockRecorder) UpdateRentStatusTx(ctx, tx, rentUUID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRentStatusTx", reflect.TypeOf((*MockRentRepository)(nil).UpdateRentStatusTx), ctx, tx, rentUUID, status)
+}
+
 // MockTx is a mock of Tx interface.
 type MockTx struct {
 	ctrl     *gomock.Controller
@@ -568,21 +1702,152 @@ func (mr *MockTxMockRecorder) CreateChargeTx(ctx, tx, req interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChargeTx", reflect.TypeOf((*MockTx)(nil).CreateChargeTx), ctx, tx, req)
 }
 
-// CreateRentTx mocks base method.
-func (m *MockTx) CreateRentTx(ctx context.Context, tx db.SqlTx, req models.CreateRentReq) (float32, error) {
+// CreateIdempotencyKeyTx mocks base method.
+func (m *MockTx) CreateIdempotencyKeyTx(ctx context.Context, tx db.SqlTx, key models.IdempotencyKey) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateRentTx", ctx, tx, req)
-	ret0, _ := ret[0].(float32)
+	ret := m.ctrl.Call(m, "CreateIdempotencyKeyTx", ctx, tx, key)
+	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// CreateIdempotencyKeyTx indicates an expected call of CreateIdempotencyKeyTx.
+func (mr *MockTxMockRecorder) CreateIdempotencyKeyTx(ctx, tx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIdempotencyKeyTx", reflect.TypeOf((*MockTx)(nil).CreateIdempotencyKeyTx), ctx, tx, key)
+}
+
+// CreateOutboxEventTx mocks base method.
+func (m *MockTx) CreateOutboxEventTx(ctx context.Context, tx db.SqlTx, event models.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOutboxEventTx", ctx, tx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOutboxEventTx indicates an expected call of CreateOutboxEventTx.
+func (mr *MockTxMockRecorder) CreateOutboxEventTx(ctx, tx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOutboxEventTx", reflect.TypeOf((*MockTx)(nil).CreateOutboxEventTx), ctx, tx, event)
+}
+
+// CreatePromoRedemptionTx mocks base method.
+func (m *MockTx) CreatePromoRedemptionTx(ctx context.Context, tx db.SqlTx, redemption models.PromoRedemption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePromoRedemptionTx", ctx, tx, redemption)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePromoRedemptionTx indicates an expected call of CreatePromoRedemptionTx.
+func (mr *MockTxMockRecorder) CreatePromoRedemptionTx(ctx, tx, redemption interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePromoRedemptionTx", reflect.TypeOf((*MockTx)(nil).CreatePromoRedemptionTx), ctx, tx, redemption)
+}
+
+// CreateRentTx mocks base method.
+func (m *MockTx) CreateRentTx(ctx context.Context, tx db.SqlTx, req models.CreateRentReq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRentTx", ctx, tx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
 // CreateRentTx indicates an expected call of CreateRentTx.
 func (mr *MockTxMockRecorder) CreateRentTx(ctx, tx, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRentTx", reflect.TypeOf((*MockTx)(nil).CreateRentTx), ctx, tx, req)
 }
 
+// GetPendingOutboxEventTx mocks base method.
+func (m *MockTx) GetPendingOutboxEventTx(ctx context.Context, tx db.SqlTx, maxAttempts int) (models.OutboxEvent, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingOutboxEventTx", ctx, tx, maxAttempts)
+	ret0, _ := ret[0].(models.OutboxEvent)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPendingOutboxEventTx indicates an expected call of GetPendingOutboxEventTx.
+func (mr *MockTxMockRecorder) GetPendingOutboxEventTx(ctx, tx, maxAttempts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingOutboxEventTx", reflect.TypeOf((*MockTx)(nil).GetPendingOutboxEventTx), ctx, tx, maxAttempts)
+}
+
+// GetPromoCodeTx mocks base method.
+func (m *MockTx) GetPromoCodeTx(ctx context.Context, tx db.SqlTx, code string) (models.PromoCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPromoCodeTx", ctx, tx, code)
+	ret0, _ := ret[0].(models.PromoCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPromoCodeTx indicates an expected call of GetPromoCodeTx.
+func (mr *MockTxMockRecorder) GetPromoCodeTx(ctx, tx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPromoCodeTx", reflect.TypeOf((*MockTx)(nil).GetPromoCodeTx), ctx, tx, code)
+}
+
+// GetPromoUsageTx mocks base method.
+func (m *MockTx) GetPromoUsageTx(ctx context.Context, tx db.SqlTx, code, userUUID string) (models.PromoUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPromoUsageTx", ctx, tx, code, userUUID)
+	ret0, _ := ret[0].(models.PromoUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPromoUsageTx indicates an expected call of GetPromoUsageTx.
+func (mr *MockTxMockRecorder) GetPromoUsageTx(ctx, tx, code, userUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPromoUsageTx", reflect.TypeOf((*MockTx)(nil).GetPromoUsageTx), ctx, tx, code, userUUID)
+}
+
+// GetRentStatusTx mocks base method.
+func (m *MockTx) GetRentStatusTx(ctx context.Context, tx db.SqlTx, rentUUID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRentStatusTx", ctx, tx, rentUUID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRentStatusTx indicates an expected call of GetRentStatusTx.
+func (mr *MockTxMockRecorder) GetRentStatusTx(ctx, tx, rentUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRentStatusTx", reflect.TypeOf((*MockTx)(nil).GetRentStatusTx), ctx, tx, rentUUID)
+}
+
+// MarkOutboxEventFailedTx mocks base method.
+func (m *MockTx) MarkOutboxEventFailedTx(ctx context.Context, tx db.SqlTx, id int64, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOutboxEventFailedTx", ctx, tx, id, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkOutboxEventFailedTx indicates an expected call of MarkOutboxEventFailedTx.
+func (mr *MockTxMockRecorder) MarkOutboxEventFailedTx(ctx, tx, id, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOutboxEventFailedTx", reflect.TypeOf((*MockTx)(nil).MarkOutboxEventFailedTx), ctx, tx, id, reason)
+}
+
+// MarkOutboxEventProcessedTx mocks base method.
+func (m *MockTx) MarkOutboxEventProcessedTx(ctx context.Context, tx db.SqlTx, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOutboxEventProcessedTx", ctx, tx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkOutboxEventProcessedTx indicates an expected call of MarkOutboxEventProcessedTx.
+func (mr *MockTxMockRecorder) MarkOutboxEventProcessedTx(ctx, tx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOutboxEventProcessedTx", reflect.TypeOf((*MockTx)(nil).MarkOutboxEventProcessedTx), ctx, tx, id)
+}
+
 // RefundChargeTx mocks base method.
 func (m *MockTx) RefundChargeTx(ctx context.Context, tx db.SqlTx, chargeUUID string) error {
 	m.ctrl.T.Helper()
@@ -611,3 +1876,31 @@ func (mr *MockTxMockRecorder) StartTx(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTx", reflect.TypeOf((*MockTx)(nil).StartTx), ctx)
 }
+
+// UpdateChargeStatusTx mocks base method.
+func (m *MockTx) UpdateChargeStatusTx(ctx context.Context, tx db.SqlTx, chargeUUID, status string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateChargeStatusTx", ctx, tx, chargeUUID, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateChargeStatusTx indicates an expected call of UpdateChargeStatusTx.
+func (mr *MockTxMockRecorder) UpdateChargeStatusTx(ctx, tx, chargeUUID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChargeStatusTx", reflect.TypeOf((*MockTx)(nil).UpdateChargeStatusTx), ctx, tx, chargeUUID, status)
+}
+
+// UpdateRentStatusTx mocks base method.
+func (m *MockTx) UpdateRentStatusTx(ctx context.Context, tx db.SqlTx, rentUUID, status string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRentStatusTx", ctx, tx, rentUUID, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRentStatusTx indicates an expected call of UpdateRentStatusTx.
+func (mr *MockTxMockRecorder) UpdateRentStatusTx(ctx, tx, rentUUID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRentStatusTx", reflect.TypeOf((*MockTx)(nil).UpdateRentStatusTx), ctx, tx, rentUUID, status)
+}
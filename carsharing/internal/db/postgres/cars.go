@@ -0,0 +1,263 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"github.com/jmoiron/sqlx"
+	"net/http"
+)
+
+const ERR_CAR_HAS_RENTS = "the car has active or upcoming rents, they have to be finished or canceled first"
+
+func (r *repository) UpdateCar(ctx context.Context, req models.UpdateCarReq, actor string) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to start tx: %v", err),
+		}
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	query := `SELECT uuid, brand, type, max_speed, seats, category, price_per_day AS "price_per_day.amount", currency AS "price_per_day.currency",
+       			COALESCE(station_uuid, '') AS station_uuid
+				FROM cars WHERE uuid = $1 AND deleted_at IS NULL FOR UPDATE`
+
+	var current models.Car
+	err = tx.GetContext(ctx, &current, query, req.CarUUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &models.Error{
+			Status: http.StatusNotFound,
+			Msg:    fmt.Sprintf("car with uuid: %s not found", req.CarUUID),
+		}
+	}
+	if err != nil {
+		return &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to get car: %v", err),
+		}
+	}
+
+	updated := applyCarFields(current, req.Car, req.Fields)
+
+	changes := diffCar(current, updated)
+	if len(changes) == 0 {
+		return nil
+	}
+
+	if _, moved := changes[models.CAR_FIELD_STATION]; moved && updated.StationUUID != "" {
+		if err = takeStationPlace(ctx, tx, updated.StationUUID); err != nil {
+			return err
+		}
+	}
+
+	query = `UPDATE cars SET brand = $1, type = $2, max_speed = $3, seats = $4, category = $5, price_per_day = $6, currency = $7,
+				station_uuid = NULLIF($8, '')
+				WHERE uuid = $9`
+
+	_, err = tx.ExecContext(ctx, query, updated.Brand, updated.Type, updated.MaxSpeed, updated.Seats, updated.Category,
+		updated.PricePerDay.Amount, updated.PricePerDay.Currency, updated.StationUUID, req.CarUUID)
+	if err != nil {
+		return &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to execute update query: %v", err),
+		}
+	}
+
+	err = insertCarChange(ctx, tx, models.CarChange{
+		CarUUID: req.CarUUID,
+		Actor:   actor,
+		Action:  models.CAR_CHANGE_UPDATE,
+		Changes: changes,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to commit tx: %v", err),
+		}
+	}
+
+	return nil
+}
+
+// DeleteCar the car is only marked as deleted, so the rents keep referring to it
+func (r *repository) DeleteCar(ctx context.Context, uuid string, actor string) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to start tx: %v", err),
+		}
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// the lock makes the concurrent rent creation wait, till the car is deleted
+	err = tx.GetContext(ctx, &uuid, `SELECT uuid FROM cars WHERE uuid = $1 AND deleted_at IS NULL FOR UPDATE`, uuid)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &models.Error{
+			Status: http.StatusNotFound,
+			Msg:    fmt.Sprintf("car with uuid: %s not found", uuid),
+		}
+	}
+	if err != nil {
+		return &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to get car: %v", err),
+		}
+	}
+
+	query := `SELECT EXISTS (SELECT 1 FROM rents WHERE car_uuid = $1 AND (status = $2 OR (status = $3 AND rent_end > now())))`
+
+	var hasRents bool
+	if err = tx.GetContext(ctx, &hasRents, query, uuid, models.RENT_STATUS_ACTIVE, models.RENT_STATUS_RESERVED); err != nil {
+		return &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to check rents: %v", err),
+		}
+	}
+	if hasRents {
+		return &models.Error{
+			Status: http.StatusConflict,
+			Msg:    ERR_CAR_HAS_RENTS,
+		}
+	}
+
+	if _, err = tx.ExecContext(ctx, `UPDATE cars SET deleted_at = now() WHERE uuid = $1`, uuid); err != nil {
+		return &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to execute delete query: %v", err),
+		}
+	}
+
+	err = insertCarChange(ctx, tx, models.CarChange{
+		CarUUID: uuid,
+		Actor:   actor,
+		Action:  models.CAR_CHANGE_DELETE,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to commit tx: %v", err),
+		}
+	}
+
+	return nil
+}
+
+func (r *repository) GetCarChanges(ctx context.Context, carUUID string) ([]models.CarChange, error) {
+	query := `SELECT id, car_uuid, actor, action, changes, changed_at FROM car_changes WHERE car_uuid = $1 ORDER BY changed_at, id`
+
+	var rows []struct {
+		models.CarChange
+		Changes []byte `db:"changes"`
+	}
+	if err := r.db.SelectContext(ctx, &rows, query, carUUID); err != nil {
+		return nil, &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to get car changes: %v", err),
+		}
+	}
+
+	if len(rows) == 0 {
+		return nil, &models.Error{
+			Status: http.StatusNotFound,
+			Msg:    fmt.Sprintf("no changes of the car with uuid: %s found", carUUID),
+		}
+	}
+
+	changes := make([]models.CarChange, 0, len(rows))
+	for _, row := range rows {
+		change := row.CarChange
+		if err := json.Unmarshal(row.Changes, &change.Changes); err != nil {
+			return nil, &models.Error{
+				Status: http.StatusInternalServerError,
+				Msg:    fmt.Sprintf("failed to unmarshal car changes: %v", err),
+			}
+		}
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
+
+func insertCarChange(ctx context.Context, tx sqlx.ExtContext, change models.CarChange) error {
+	if change.Changes == nil {
+		change.Changes = map[string]models.FieldChange{}
+	}
+
+	b, err := json.Marshal(change.Changes)
+	if err != nil {
+		return &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to marshal car changes: %v", err),
+		}
+	}
+
+	query := `INSERT INTO car_changes (car_uuid, actor, action, changes) VALUES ($1, $2, $3, $4)`
+
+	if _, err = tx.ExecContext(ctx, query, change.CarUUID, change.Actor, change.Action, string(b)); err != nil {
+		return &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to insert car change: %v", err),
+		}
+	}
+
+	return nil
+}
+
+// applyCarFields returns the car with the fields taken from the update
+func applyCarFields(car models.Car, update models.Car, fields []string) models.Car {
+	for _, field := range fields {
+		switch field {
+		case models.CAR_FIELD_BRAND:
+			car.Brand = update.Brand
+		case models.CAR_FIELD_TYPE:
+			car.Type = update.Type
+		case models.CAR_FIELD_MAX_SPEED:
+			car.MaxSpeed = update.MaxSpeed
+		case models.CAR_FIELD_SEATS:
+			car.Seats = update.Seats
+		case models.CAR_FIELD_CATEGORY:
+			car.Category = update.Category
+		case models.CAR_FIELD_PRICE:
+			car.PricePerDay = update.PricePerDay
+		case models.CAR_FIELD_STATION:
+			car.StationUUID = update.StationUUID
+		}
+	}
+
+	return car
+}
+
+// diffCar returns the changed fields, the unchanged ones are not recorded
+func diffCar(old, new models.Car) map[string]models.FieldChange {
+	changes := make(map[string]models.FieldChange)
+
+	add := func(field string, old, new any) {
+		if old != new {
+			changes[field] = models.FieldChange{Old: old, New: new}
+		}
+	}
+
+	add(models.CAR_FIELD_BRAND, old.Brand, new.Brand)
+	add(models.CAR_FIELD_TYPE, old.Type, new.Type)
+	add(models.CAR_FIELD_MAX_SPEED, old.MaxSpeed, new.MaxSpeed)
+	add(models.CAR_FIELD_SEATS, old.Seats, new.Seats)
+	add(models.CAR_FIELD_CATEGORY, old.Category, new.Category)
+	add(models.CAR_FIELD_PRICE, old.PricePerDay, new.PricePerDay)
+	add(models.CAR_FIELD_STATION, old.StationUUID, new.StationUUID)
+
+	return changes
+}
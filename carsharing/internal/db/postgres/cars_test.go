@@ -0,0 +1,138 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestDiffCar(t *testing.T) {
+	car := models.Car{
+		Brand:       "bmw",
+		Type:        "sedan",
+		MaxSpeed:    250,
+		Seats:       5,
+		Category:    "premium",
+		PricePerDay: models.NewMoney(100_00, models.DEFAULT_CURRENCY),
+	}
+
+	update := models.Car{
+		Brand:       "audi",
+		Seats:       5,
+		PricePerDay: models.NewMoney(150_00, models.DEFAULT_CURRENCY),
+	}
+
+	// the fields out of the mask are kept, the unchanged ones are not recorded
+	updated := applyCarFields(car, update, []string{models.CAR_FIELD_BRAND, models.CAR_FIELD_SEATS, models.CAR_FIELD_PRICE})
+	require.Equal(t, "audi", updated.Brand)
+	require.Equal(t, "sedan", updated.Type)
+	require.Equal(t, map[string]models.FieldChange{
+		models.CAR_FIELD_BRAND: {Old: "bmw", New: "audi"},
+		models.CAR_FIELD_PRICE: {Old: car.PricePerDay, New: update.PricePerDay},
+	}, diffCar(car, updated))
+
+	require.Empty(t, diffCar(car, applyCarFields(car, update, nil)))
+}
+
+func TestRepository_UpdateAndDeleteCar(t *testing.T) {
+	dsn := os.Getenv(testDSN)
+	if dsn == "" {
+		t.Skipf("%s is not set", testDSN)
+	}
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir("../../.."))
+	defer func() {
+		require.NoError(t, os.Chdir(wd))
+	}()
+
+	conn := MustConnect(dsn)
+	defer conn.Close()
+
+	ctx := context.Background()
+	repo := NewRepo(conn)
+
+	car := models.Car{
+		UUID:        uuid.New().String(),
+		Brand:       "test",
+		Type:        "test",
+		MaxSpeed:    200,
+		Seats:       4,
+		Category:    "test",
+		PricePerDay: models.NewMoney(100_00, models.DEFAULT_CURRENCY),
+		MainImage:   "main-" + uuid.New().String(),
+	}
+	require.NoError(t, repo.CreateCar(ctx, car, "creator"))
+	defer func() {
+		_, err = conn.Exec(`DELETE FROM car_changes WHERE car_uuid = $1`, car.UUID)
+		require.NoError(t, err)
+		_, err = conn.Exec(`DELETE FROM rents WHERE car_uuid = $1`, car.UUID)
+		require.NoError(t, err)
+		_, err = conn.Exec(`DELETE FROM images WHERE car_uuid = $1`, car.UUID)
+		require.NoError(t, err)
+		_, err = conn.Exec(`DELETE FROM cars WHERE uuid = $1`, car.UUID)
+		require.NoError(t, err)
+	}()
+
+	err = repo.UpdateCar(ctx, models.UpdateCarReq{
+		CarUUID: car.UUID,
+		Car:     models.Car{Seats: 7, Brand: "ignored"},
+		Fields:  []string{models.CAR_FIELD_SEATS},
+	}, "editor")
+	require.NoError(t, err)
+
+	updated, err := repo.GetCarByUUID(ctx, car.UUID)
+	require.NoError(t, err)
+	require.EqualValues(t, 7, updated.Seats)
+	require.Equal(t, car.Brand, updated.Brand)
+
+	// the upcoming rent blocks the deletion
+	start := time.Now().Add(time.Hour * 24).Truncate(time.Second)
+	_, err = conn.Exec(`INSERT INTO rents (uuid, car_uuid, user_uuid, phone_number, passport_number, rent_start, rent_end, status)
+				VALUES ($1, $2, 'test', '9999999999', 'AB1234567', $3, $4, $5)`,
+		uuid.New().String(), car.UUID, start, start.Add(time.Hour*24), models.RENT_STATUS_RESERVED)
+	require.NoError(t, err)
+
+	var e *models.Error
+	require.True(t, errors.As(repo.DeleteCar(ctx, car.UUID, "editor"), &e))
+	require.Equal(t, http.StatusConflict, e.Status)
+
+	_, err = conn.Exec(`UPDATE rents SET status = $1 WHERE car_uuid = $2`, models.RENT_STATUS_CANCELED, car.UUID)
+	require.NoError(t, err)
+	require.NoError(t, repo.DeleteCar(ctx, car.UUID, "editor"))
+
+	// the deleted car can not be found, rented or changed
+	_, err = repo.GetCarByUUID(ctx, car.UUID)
+	require.True(t, errors.As(err, &e))
+	require.Equal(t, http.StatusNotFound, e.Status)
+
+	_, err = repo.GetCarPricing(ctx, car.UUID)
+	require.True(t, errors.As(err, &e))
+	require.Equal(t, http.StatusNotFound, e.Status)
+
+	err = repo.UpdateCar(ctx, models.UpdateCarReq{CarUUID: car.UUID, Fields: []string{models.CAR_FIELD_SEATS}}, "editor")
+	require.True(t, errors.As(err, &e))
+	require.Equal(t, http.StatusNotFound, e.Status)
+
+	changes, err := repo.GetCarChanges(ctx, car.UUID)
+	require.NoError(t, err)
+	require.Len(t, changes, 3)
+
+	require.Equal(t, models.CAR_CHANGE_CREATE, changes[0].Action)
+	require.Equal(t, "creator", changes[0].Actor)
+
+	require.Equal(t, models.CAR_CHANGE_UPDATE, changes[1].Action)
+	require.Equal(t, "editor", changes[1].Actor)
+	require.Equal(t, map[string]models.FieldChange{
+		models.CAR_FIELD_SEATS: {Old: float64(4), New: float64(7)},
+	}, changes[1].Changes)
+
+	require.Equal(t, models.CAR_CHANGE_DELETE, changes[2].Action)
+}
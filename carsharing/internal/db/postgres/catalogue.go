@@ -0,0 +1,213 @@
+package postgres
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"net/http"
+	"strings"
+)
+
+const ERR_INVALID_PAGE_TOKEN = "invalid page token"
+
+// carSortColumns whitelists the columns, cars can be sorted by
+var carSortColumns = map[string]string{
+	models.SORT_BY_PRICE:     "price_per_day",
+	models.SORT_BY_MAX_SPEED: "max_speed",
+	models.SORT_BY_SEATS:     "seats",
+	models.SORT_BY_BRAND:     "brand",
+}
+
+func (r *repository) GetCarsByParams(_ context.Context, params models.CarParams, page models.Page) (models.CarsPage, error) {
+	var q carsQuery
+	q.filter(params)
+
+	return r.selectCarsPage(q, page)
+}
+
+// GetAvailableCars selects the cars, which have no reserved or active rents and no maintenances intersecting with the period
+func (r *repository) GetAvailableCars(_ context.Context, period models.Period, params models.CarParams, page models.Page) (models.CarsPage, error) {
+	var q carsQuery
+	q.filter(params)
+	q.where(`NOT EXISTS (SELECT 1 FROM rents WHERE rents.car_uuid = cars.uuid AND status IN (?, ?) AND rent_start < ? AND rent_end > ?)`,
+		models.RENT_STATUS_RESERVED, models.RENT_STATUS_ACTIVE, period.End, period.Start)
+	q.where(`NOT EXISTS (SELECT 1 FROM maintenances WHERE maintenances.car_uuid = cars.uuid
+			AND tstzrange(starts_at, ends_at, '[)') && tstzrange(?::timestamptz, ?::timestamptz, '[)'))`,
+		period.Start, period.End)
+
+	return r.selectCarsPage(q, page)
+}
+
+// carsQuery collects the conditions of the cars query, the args are bound to '?' placeholders
+type carsQuery struct {
+	// search is the tsquery, which is available as 'query' in the conditions and the sort
+	search     string
+	conditions []string
+	args       []any
+}
+
+func (q *carsQuery) where(condition string, args ...any) {
+	q.conditions = append(q.conditions, "("+condition+")")
+	q.args = append(q.args, args...)
+}
+
+// from returns the FROM and WHERE clauses with their args
+func (q *carsQuery) from() (string, []any) {
+	var args []any
+
+	from := "FROM cars LEFT JOIN images ON cars.image_uuid = images.uuid"
+	if q.search != "" {
+		from += ", to_tsquery('simple', ?) AS query"
+		args = append(args, q.search)
+	}
+
+	// the deleted cars are kept for the rents history only
+	from += " WHERE " + strings.Join(append([]string{"cars.deleted_at IS NULL"}, q.conditions...), " AND ")
+
+	return from, append(args, q.args...)
+}
+
+// filter adds the conditions of the set params
+func (q *carsQuery) filter(params models.CarParams) {
+	if params.Brand != "" {
+		q.where("LOWER(brand) = LOWER(?)", params.Brand)
+	}
+	if params.Type != "" {
+		q.where("LOWER(type) = LOWER(?)", params.Type)
+	}
+	if params.MaxSpeed != 0 {
+		q.where("max_speed > ?", params.MaxSpeed)
+	}
+	if params.Seats != 0 {
+		q.where("seats = ?", params.Seats)
+	}
+	if params.Category != "" {
+		q.where("LOWER(category) = LOWER(?)", params.Category)
+	}
+	if params.MinPrice.Amount != 0 {
+		q.where("price_per_day >= ? AND currency = ?", params.MinPrice.Amount, params.MinPrice.Currency)
+	}
+	if params.MaxPrice.Amount != 0 {
+		q.where("price_per_day <= ? AND currency = ?", params.MaxPrice.Amount, params.MaxPrice.Currency)
+	}
+	if params.StationUUID != "" {
+		q.where("cars.station_uuid = ?", params.StationUUID)
+	}
+	if params.Near.RadiusKm > 0 {
+		q.where("cars.station_uuid IN (SELECT uuid FROM stations WHERE distance_km(latitude, longitude, ?, ?) <= ?)",
+			params.Near.Latitude, params.Near.Longitude, params.Near.RadiusKm)
+	}
+}
+
+// carsCursor points to the last car of the page, Value is the sort column value of the car
+type carsCursor struct {
+	SortBy     string `json:"s,omitempty"`
+	Descending bool   `json:"d,omitempty"`
+	Value      string `json:"v,omitempty"`
+	UUID       string `json:"u"`
+}
+
+// carRow is the car with the value of the column, cars are sorted by
+type carRow struct {
+	models.CarMainInfo
+	SortKey string `db:"sort_key"`
+}
+
+// selectCarsPage selects the page of cars using keyset pagination, so the pages stay consistent,
+// when cars are added or removed between the requests
+func (r *repository) selectCarsPage(q carsQuery, page models.Page) (models.CarsPage, error) {
+	if page.Size == 0 {
+		page.Size = models.DEFAULT_PAGE_SIZE
+	}
+
+	sortColumn := "cars.uuid"
+	if page.SortBy != "" {
+		column, ok := carSortColumns[page.SortBy]
+		if page.SortBy == models.SORT_BY_RELEVANCE && q.search != "" {
+			column, ok = "ts_rank(cars.search, query)", true
+		}
+		if !ok {
+			return models.CarsPage{}, &models.Error{
+				Msg:    fmt.Sprintf("cars can not be sorted by %s", page.SortBy),
+				Status: http.StatusBadRequest,
+			}
+		}
+		sortColumn = column
+	}
+
+	direction, comparison := "ASC", ">"
+	if page.Descending {
+		direction, comparison = "DESC", "<"
+	}
+
+	if page.Token != "" {
+		cursor, err := decodeCarsCursor(page.Token)
+		if err != nil || cursor.SortBy != page.SortBy || cursor.Descending != page.Descending {
+			return models.CarsPage{}, &models.Error{
+				Msg:    ERR_INVALID_PAGE_TOKEN,
+				Status: http.StatusBadRequest,
+			}
+		}
+
+		if page.SortBy == "" {
+			q.where(fmt.Sprintf("cars.uuid %s ?", comparison), cursor.UUID)
+		} else {
+			q.where(fmt.Sprintf("(%s, cars.uuid) %s (?, ?)", sortColumn, comparison), cursor.Value, cursor.UUID)
+		}
+	}
+
+	from, args := q.from()
+	query := fmt.Sprintf(`SELECT cars.uuid, brand, type, category, price_per_day AS "price_per_day.amount", currency AS "price_per_day.currency",
+				COALESCE(images.uuid, '') AS image, COALESCE(cars.station_uuid, '') AS station_uuid, %s::text AS sort_key %s`, sortColumn, from)
+	// one more car is selected to find out if there is the next page
+	query += fmt.Sprintf(" ORDER BY %s %s, cars.uuid %s LIMIT %d", sortColumn, direction, direction, page.Size+1)
+
+	var rows []carRow
+	if err := r.db.Select(&rows, r.db.Rebind(query), args...); err != nil {
+		return models.CarsPage{}, &models.Error{
+			Msg:    fmt.Sprintf("failed to select cars: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	var res models.CarsPage
+	if len(rows) > page.Size {
+		rows = rows[:page.Size]
+
+		last := rows[len(rows)-1]
+		res.NextPageToken = encodeCarsCursor(carsCursor{
+			SortBy:     page.SortBy,
+			Descending: page.Descending,
+			Value:      last.SortKey,
+			UUID:       last.UUID,
+		})
+	}
+
+	res.Cars = make([]models.CarMainInfo, 0, len(rows))
+	for _, row := range rows {
+		res.Cars = append(res.Cars, row.CarMainInfo)
+	}
+
+	return res, nil
+}
+
+func encodeCarsCursor(cursor carsCursor) string {
+	b, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCarsCursor(token string) (carsCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return carsCursor{}, err
+	}
+
+	var cursor carsCursor
+	if err = json.Unmarshal(b, &cursor); err != nil {
+		return carsCursor{}, err
+	}
+
+	return cursor, nil
+}
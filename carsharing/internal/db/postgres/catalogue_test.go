@@ -0,0 +1,166 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestCarsCursor(t *testing.T) {
+	cursor := carsCursor{
+		SortBy:     models.SORT_BY_PRICE,
+		Descending: true,
+		Value:      "10000",
+		UUID:       uuid.New().String(),
+	}
+
+	decoded, err := decodeCarsCursor(encodeCarsCursor(cursor))
+	require.NoError(t, err)
+	require.Equal(t, cursor, decoded)
+
+	_, err = decodeCarsCursor("not a token")
+	require.Error(t, err)
+}
+
+func TestRepository_GetCarsByParamsPages(t *testing.T) {
+	dsn := os.Getenv(testDSN)
+	if dsn == "" {
+		t.Skipf("%s is not set", testDSN)
+	}
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir("../../.."))
+	defer func() {
+		require.NoError(t, os.Chdir(wd))
+	}()
+
+	conn := MustConnect(dsn)
+	defer conn.Close()
+
+	ctx := context.Background()
+	repo := NewRepo(conn)
+
+	// the brand isolates the test cars from the others
+	brand := "test-" + uuid.New().String()[:8]
+	prices := []int64{300_00, 100_00, 500_00, 200_00, 400_00}
+	for _, price := range prices {
+		_, err = conn.Exec(`INSERT INTO cars (uuid, brand, type, max_speed, seats, category, price_per_day, image_uuid)
+				VALUES ($1, $2, 'test', 200, 4, 'test', $3, $4)`, uuid.New().String(), brand, price, uuid.New().String())
+		require.NoError(t, err)
+	}
+	defer func() {
+		_, err = conn.Exec(`DELETE FROM cars WHERE brand = $1`, brand)
+		require.NoError(t, err)
+	}()
+
+	page := models.Page{Size: 2, SortBy: models.SORT_BY_PRICE, Descending: true}
+
+	var (
+		got   []int64
+		pages int
+	)
+	for {
+		res, err := repo.GetCarsByParams(ctx, models.CarParams{Brand: brand}, page)
+		require.NoError(t, err)
+
+		for _, car := range res.Cars {
+			got = append(got, car.PricePerDay.Amount)
+		}
+		pages++
+
+		if res.NextPageToken == "" {
+			break
+		}
+		page.Token = res.NextPageToken
+	}
+	require.Equal(t, []int64{500_00, 400_00, 300_00, 200_00, 100_00}, got)
+	require.Equal(t, 3, pages)
+
+	// price range is inclusive
+	res, err := repo.GetCarsByParams(ctx, models.CarParams{
+		Brand:    brand,
+		MinPrice: models.NewMoney(200_00, models.DEFAULT_CURRENCY),
+		MaxPrice: models.NewMoney(400_00, models.DEFAULT_CURRENCY),
+	}, models.Page{SortBy: models.SORT_BY_PRICE})
+	require.NoError(t, err)
+	require.Len(t, res.Cars, 3)
+	require.Empty(t, res.NextPageToken)
+
+	// token of another sort order is rejected
+	_, err = repo.GetCarsByParams(ctx, models.CarParams{Brand: brand}, models.Page{Token: page.Token, SortBy: models.SORT_BY_SEATS})
+	var e *models.Error
+	require.True(t, errors.As(err, &e))
+	require.Equal(t, http.StatusBadRequest, e.Status)
+}
+
+func TestRepository_GetAvailableCars(t *testing.T) {
+	dsn := os.Getenv(testDSN)
+	if dsn == "" {
+		t.Skipf("%s is not set", testDSN)
+	}
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir("../../.."))
+	defer func() {
+		require.NoError(t, os.Chdir(wd))
+	}()
+
+	conn := MustConnect(dsn)
+	defer conn.Close()
+
+	ctx := context.Background()
+	repo := NewRepo(conn)
+
+	brand := "test-" + uuid.New().String()[:8]
+	rented, free := uuid.New().String(), uuid.New().String()
+	for _, carUUID := range []string{rented, free} {
+		_, err = conn.Exec(`INSERT INTO cars (uuid, brand, type, max_speed, seats, category, price_per_day, image_uuid)
+				VALUES ($1, $2, 'test', 200, 4, 'test', 10000, $3)`, carUUID, brand, uuid.New().String())
+		require.NoError(t, err)
+	}
+
+	start := time.Now().Add(time.Hour * 24).Truncate(time.Second)
+	_, err = conn.Exec(`INSERT INTO rents (uuid, car_uuid, phone_number, passport_number, rent_start, rent_end)
+				VALUES ($1, $2, 'test', 'test', $3, $4)`, uuid.New().String(), rented, start, start.Add(time.Hour*24))
+	require.NoError(t, err)
+	defer func() {
+		_, err = conn.Exec(`DELETE FROM rents WHERE car_uuid = $1`, rented)
+		require.NoError(t, err)
+		_, err = conn.Exec(`DELETE FROM cars WHERE brand = $1`, brand)
+		require.NoError(t, err)
+	}()
+
+	period := func(start, end time.Time) models.Period {
+		return models.Period{Start: &start, End: &end}
+	}
+
+	tests := []struct {
+		name     string
+		period   models.Period
+		expected []string
+	}{
+		{name: "intersects", period: period(start.Add(time.Hour), start.Add(time.Hour*48)), expected: []string{free}},
+		{name: "contains", period: period(start.Add(-time.Hour), start.Add(time.Hour*25)), expected: []string{free}},
+		{name: "ends at rent start", period: period(start.Add(-time.Hour), start), expected: []string{rented, free}},
+		{name: "starts at rent end", period: period(start.Add(time.Hour*24), start.Add(time.Hour*25)), expected: []string{rented, free}},
+	}
+
+	for _, tc := range tests {
+		res, err := repo.GetAvailableCars(ctx, tc.period, models.CarParams{Brand: brand}, models.Page{})
+		require.NoError(t, err, tc.name)
+
+		got := make([]string, 0, len(res.Cars))
+		for _, car := range res.Cars {
+			got = append(got, car.UUID)
+		}
+		require.ElementsMatch(t, tc.expected, got, tc.name)
+	}
+}
@@ -0,0 +1,296 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"github.com/lib/pq"
+	"net/http"
+	"sort"
+)
+
+const (
+	ERR_IMAGE_NOT_FOUND   = "image not found"
+	ERR_MAIN_IMAGE_DELETE = "the main image can not be deleted, set another main image first"
+	ERR_INVALID_ORDER     = "the order has to contain every image of the car exactly once"
+)
+
+// IMAGE_VARIANTS are stored for every processed image
+var IMAGE_VARIANTS = []string{models.IMAGE_VARIANT_THUMBNAIL, models.IMAGE_VARIANT_MEDIUM, models.IMAGE_VARIANT_ORIGINAL}
+
+type imageRow struct {
+	ID       string         `db:"uuid"`
+	Position int32          `db:"position"`
+	Variants pq.StringArray `db:"variants"`
+}
+
+func (r *repository) AddCarImage(ctx context.Context, carUUID string, image models.CarImage) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to start tx: %v", err),
+		}
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// the car row is locked, so the concurrent uploads get different positions
+	var uuid string
+	err = tx.GetContext(ctx, &uuid, `SELECT uuid FROM cars WHERE uuid = $1 AND deleted_at IS NULL FOR UPDATE`, carUUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &models.Error{
+			Status: http.StatusNotFound,
+			Msg:    fmt.Sprintf("car with uuid: %s not found", carUUID),
+		}
+	}
+	if err != nil {
+		return &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to check car: %v", err),
+		}
+	}
+
+	query := `INSERT INTO images (uuid, car_uuid, position, variants)
+				SELECT $1, $2, COALESCE(MAX(position) + 1, 0), $3 FROM images WHERE car_uuid = $2`
+
+	if _, err = tx.ExecContext(ctx, query, image.ID, carUUID, pq.Array(image.Variants)); err != nil {
+		return &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to execute insert query: %v", err),
+		}
+	}
+
+	if image.Main {
+		if _, err = tx.ExecContext(ctx, `UPDATE cars SET image_uuid = $1 WHERE uuid = $2`, image.ID, carUUID); err != nil {
+			return &models.Error{
+				Status: http.StatusInternalServerError,
+				Msg:    fmt.Sprintf("failed to execute update query: %v", err),
+			}
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to commit tx: %v", err),
+		}
+	}
+
+	return nil
+}
+
+func (r *repository) GetCarImages(ctx context.Context, carUUID string) ([]models.CarImage, error) {
+	query := `SELECT images.uuid, images.position, images.variants, images.uuid = cars.image_uuid AS main
+				FROM cars LEFT JOIN images ON images.car_uuid = cars.uuid
+				WHERE cars.uuid = $1
+				ORDER BY images.position`
+
+	var rows []struct {
+		ID       sql.NullString `db:"uuid"`
+		Position sql.NullInt32  `db:"position"`
+		Main     sql.NullBool   `db:"main"`
+		Variants pq.StringArray `db:"variants"`
+	}
+	if err := r.db.SelectContext(ctx, &rows, query, carUUID); err != nil {
+		return nil, &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to get images: %v", err),
+		}
+	}
+
+	// the car without images is returned as a single row with nulls
+	if len(rows) == 0 {
+		return nil, &models.Error{
+			Status: http.StatusNotFound,
+			Msg:    fmt.Sprintf("car with uuid: %s not found", carUUID),
+		}
+	}
+
+	images := make([]models.CarImage, 0, len(rows))
+	for _, row := range rows {
+		if !row.ID.Valid {
+			continue
+		}
+		images = append(images, models.CarImage{
+			ID:       row.ID.String,
+			Position: row.Position.Int32,
+			Main:     row.Main.Bool,
+			Variants: row.Variants,
+		})
+	}
+
+	return images, nil
+}
+
+func (r *repository) DeleteCarImage(ctx context.Context, carUUID string, imageId string) (models.CarImage, error) {
+	query := `DELETE FROM images
+				WHERE uuid = $1 AND car_uuid = $2 AND NOT EXISTS (SELECT 1 FROM cars WHERE uuid = $2 AND image_uuid = $1)
+				RETURNING uuid, position, variants`
+
+	var row imageRow
+	err := r.db.GetContext(ctx, &row, query, imageId, carUUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.CarImage{}, r.imageNotDeletedError(ctx, carUUID, imageId)
+	}
+	if err != nil {
+		return models.CarImage{}, &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to delete image: %v", err),
+		}
+	}
+
+	// the positions of the next images are not shifted, the order is kept by the gaps as well
+	return models.CarImage{
+		ID:       row.ID,
+		Position: row.Position,
+		Variants: row.Variants,
+	}, nil
+}
+
+// imageNotDeletedError tells the main image from the missing one
+func (r *repository) imageNotDeletedError(ctx context.Context, carUUID string, imageId string) error {
+	var main bool
+	err := r.db.GetContext(ctx, &main, `SELECT EXISTS (SELECT 1 FROM cars WHERE uuid = $1 AND image_uuid = $2)`, carUUID, imageId)
+	if err != nil {
+		return &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to check image: %v", err),
+		}
+	}
+
+	if main {
+		return &models.Error{
+			Status: http.StatusConflict,
+			Msg:    ERR_MAIN_IMAGE_DELETE,
+		}
+	}
+
+	return &models.Error{
+		Status: http.StatusNotFound,
+		Msg:    ERR_IMAGE_NOT_FOUND,
+	}
+}
+
+func (r *repository) ReorderCarImages(ctx context.Context, carUUID string, ids []string) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to start tx: %v", err),
+		}
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var current []string
+	if err = tx.SelectContext(ctx, &current, `SELECT uuid FROM images WHERE car_uuid = $1 FOR UPDATE`, carUUID); err != nil {
+		return &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to get images: %v", err),
+		}
+	}
+
+	if len(current) == 0 {
+		return &models.Error{
+			Status: http.StatusNotFound,
+			Msg:    ERR_IMAGE_NOT_FOUND,
+		}
+	}
+	if !samePermutation(current, ids) {
+		return &models.Error{
+			Status: http.StatusBadRequest,
+			Msg:    ERR_INVALID_ORDER,
+		}
+	}
+
+	query := `UPDATE images SET position = array_position($2::text[], uuid) - 1 WHERE car_uuid = $1`
+
+	if _, err = tx.ExecContext(ctx, query, carUUID, pq.Array(ids)); err != nil {
+		return &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to reorder images: %v", err),
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to commit tx: %v", err),
+		}
+	}
+
+	return nil
+}
+
+func samePermutation(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	a, b = append([]string(nil), a...), append([]string(nil), b...)
+	sort.Strings(a)
+	sort.Strings(b)
+
+	for i := range a {
+		if a[i] != b[i] || (i > 0 && b[i] == b[i-1]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r *repository) SetMainCarImage(ctx context.Context, carUUID string, imageId string) error {
+	query := `UPDATE cars SET image_uuid = $1
+				WHERE uuid = $2 AND EXISTS (SELECT 1 FROM images WHERE uuid = $1 AND car_uuid = $2)`
+
+	res, err := r.db.ExecContext(ctx, query, imageId, carUUID)
+	if err != nil {
+		return &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to set main image: %v", err),
+		}
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+		return &models.Error{
+			Status: http.StatusNotFound,
+			Msg:    ERR_IMAGE_NOT_FOUND,
+		}
+	}
+
+	return nil
+}
+
+func (r *repository) LinkLegacyImages(ctx context.Context, carUUID string, ids []string) ([]string, error) {
+	// the legacy images have only the original, they are appended in the passed order
+	query := `INSERT INTO images (uuid, car_uuid, position, variants)
+				SELECT legacy.id, cars.uuid, COALESCE((SELECT MAX(position) FROM images WHERE car_uuid = cars.uuid), -1) + legacy.ord, '{original}'
+				FROM cars, unnest($2::text[]) WITH ORDINALITY AS legacy(id, ord)
+				WHERE cars.uuid = $1
+				ON CONFLICT (uuid) DO NOTHING
+				RETURNING uuid`
+
+	var linked []string
+	if err := r.db.SelectContext(ctx, &linked, query, carUUID, pq.Array(ids)); err != nil {
+		return nil, &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to link images: %v", err),
+		}
+	}
+
+	return linked, nil
+}
+
+func (r *repository) GetExistingImages(ctx context.Context, ids []string) ([]string, error) {
+	var existing []string
+	if err := r.db.SelectContext(ctx, &existing, `SELECT uuid FROM images WHERE uuid = ANY($1)`, pq.Array(ids)); err != nil {
+		return nil, &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to get images: %v", err),
+		}
+	}
+
+	return existing, nil
+}
@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"os"
+	"testing"
+)
+
+func TestSamePermutation(t *testing.T) {
+	require.True(t, samePermutation([]string{"a", "b", "c"}, []string{"c", "a", "b"}))
+	require.False(t, samePermutation([]string{"a", "b", "c"}, []string{"a", "b"}))
+	require.False(t, samePermutation([]string{"a", "b", "c"}, []string{"a", "b", "d"}))
+	require.False(t, samePermutation([]string{"a", "b", "b"}, []string{"a", "a", "b"}))
+}
+
+func TestRepository_CarImages(t *testing.T) {
+	dsn := os.Getenv(testDSN)
+	if dsn == "" {
+		t.Skipf("%s is not set", testDSN)
+	}
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir("../../.."))
+	defer func() {
+		require.NoError(t, os.Chdir(wd))
+	}()
+
+	conn := MustConnect(dsn)
+	defer conn.Close()
+
+	ctx := context.Background()
+	repo := NewRepo(conn)
+
+	car := models.Car{
+		UUID:        uuid.New().String(),
+		Brand:       "test",
+		Type:        "test",
+		MaxSpeed:    200,
+		Seats:       4,
+		Category:    "test",
+		PricePerDay: models.NewMoney(100_00, models.DEFAULT_CURRENCY),
+		MainImage:   "main-" + uuid.New().String(),
+		Images:      []string{"first-" + uuid.New().String()},
+	}
+	require.NoError(t, repo.CreateCar(ctx, car, "test"))
+	defer func() {
+		_, err = conn.Exec(`DELETE FROM car_changes WHERE car_uuid = $1`, car.UUID)
+		require.NoError(t, err)
+		_, err = conn.Exec(`DELETE FROM images WHERE car_uuid = $1`, car.UUID)
+		require.NoError(t, err)
+		_, err = conn.Exec(`DELETE FROM cars WHERE uuid = $1`, car.UUID)
+		require.NoError(t, err)
+	}()
+
+	added := "added-" + uuid.New().String()
+	require.NoError(t, repo.AddCarImage(ctx, car.UUID, models.CarImage{ID: added, Variants: IMAGE_VARIANTS}))
+
+	images, err := repo.GetCarImages(ctx, car.UUID)
+	require.NoError(t, err)
+	require.Equal(t, []string{car.MainImage, car.Images[0], added}, imageIDs(images))
+	require.True(t, images[0].Main)
+	require.Equal(t, IMAGE_VARIANTS, images[2].Variants)
+
+	require.NoError(t, repo.ReorderCarImages(ctx, car.UUID, []string{added, car.MainImage, car.Images[0]}))
+	require.Error(t, repo.ReorderCarImages(ctx, car.UUID, []string{added}))
+
+	require.NoError(t, repo.SetMainCarImage(ctx, car.UUID, added))
+
+	got, err := repo.GetCarByUUID(ctx, car.UUID)
+	require.NoError(t, err)
+	require.Equal(t, added, got.MainImage)
+	require.Equal(t, []string{added, car.MainImage, car.Images[0]}, got.Images)
+
+	// the main image is changed first
+	_, err = repo.DeleteCarImage(ctx, car.UUID, added)
+	var e *models.Error
+	require.True(t, errors.As(err, &e))
+	require.Equal(t, http.StatusConflict, e.Status)
+
+	deleted, err := repo.DeleteCarImage(ctx, car.UUID, car.MainImage)
+	require.NoError(t, err)
+	require.Equal(t, car.MainImage, deleted.ID)
+
+	existing, err := repo.GetExistingImages(ctx, []string{added, car.MainImage})
+	require.NoError(t, err)
+	require.Equal(t, []string{added}, existing)
+
+	legacy := "legacy-" + uuid.New().String()
+	linked, err := repo.LinkLegacyImages(ctx, car.UUID, []string{legacy, added})
+	require.NoError(t, err)
+	require.Equal(t, []string{legacy}, linked)
+
+	images, err = repo.GetCarImages(ctx, car.UUID)
+	require.NoError(t, err)
+	require.Equal(t, []string{added, car.Images[0], legacy}, imageIDs(images))
+	require.Equal(t, []string{models.IMAGE_VARIANT_ORIGINAL}, images[2].Variants)
+
+	linked, err = repo.LinkLegacyImages(ctx, uuid.New().String(), []string{"legacy-" + uuid.New().String()})
+	require.NoError(t, err)
+	require.Empty(t, linked)
+}
+
+func imageIDs(images []models.CarImage) []string {
+	ids := make([]string, 0, len(images))
+	for _, image := range images {
+		ids = append(ids, image.ID)
+	}
+	return ids
+}
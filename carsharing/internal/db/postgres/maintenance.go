@@ -0,0 +1,179 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"net/http"
+	"time"
+)
+
+const (
+	ERR_MAINTENANCE_NOT_FOUND = "maintenance not found"
+	ERR_MAINTENANCE_CLOSED    = "the maintenance is already closed"
+	ERR_MAINTENANCE_OVERLAP   = "the car already has a maintenance in this period"
+	ERR_CAR_IN_MAINTENANCE    = "this car is in maintenance in this period"
+)
+
+// ScheduleMaintenance the rents, which intersect with the maintenance, are not canceled, they are returned as the conflicts
+func (r *repository) ScheduleMaintenance(ctx context.Context, m models.Maintenance) ([]models.RentConflict, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to start tx: %v", err),
+		}
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// the lock makes the concurrent rent creation wait, so the conflicts are complete
+	err = tx.GetContext(ctx, &m.CarUUID, `SELECT uuid FROM cars WHERE uuid = $1 AND deleted_at IS NULL FOR UPDATE`, m.CarUUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, &models.Error{
+			Status: http.StatusNotFound,
+			Msg:    fmt.Sprintf("car with uuid: %s not found", m.CarUUID),
+		}
+	}
+	if err != nil {
+		return nil, &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to get car: %v", err),
+		}
+	}
+
+	query := `INSERT INTO maintenances (uuid, car_uuid, kind, reason, starts_at, ends_at, interval_km, created_by)
+				VALUES (:uuid, :car_uuid, :kind, :reason, :starts_at, :ends_at, :interval_km, :created_by)`
+
+	_, err = tx.NamedExecContext(ctx, query, m)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == EXCLUSION_VIOLATION {
+		return nil, &models.Error{
+			Status: http.StatusConflict,
+			Msg:    ERR_MAINTENANCE_OVERLAP,
+		}
+	}
+	if err != nil {
+		return nil, &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to insert maintenance: %v", err),
+		}
+	}
+
+	query = `SELECT uuid, status, rent_start, rent_end FROM rents
+				WHERE car_uuid = $1 AND status IN ($2, $3) AND tstzrange(rent_start, rent_end, '[)') && tstzrange($4::timestamptz, $5::timestamptz, '[)')
+				ORDER BY rent_start, uuid`
+
+	var conflicts []models.RentConflict
+	err = tx.SelectContext(ctx, &conflicts, query, m.CarUUID, models.RENT_STATUS_RESERVED, models.RENT_STATUS_ACTIVE, m.Start, m.End)
+	if err != nil {
+		return nil, &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to get conflicting rents: %v", err),
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to commit tx: %v", err),
+		}
+	}
+
+	return conflicts, nil
+}
+
+// CloseMaintenance the maintenance, which is closed before its end, makes the car available from now,
+// the one, which is closed before its start, is canceled
+func (r *repository) CloseMaintenance(ctx context.Context, req models.CloseMaintenanceReq) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to start tx: %v", err),
+		}
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var closed *time.Time
+	err = tx.GetContext(ctx, &closed, `SELECT closed_at FROM maintenances WHERE uuid = $1 FOR UPDATE`, req.UUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &models.Error{
+			Status: http.StatusNotFound,
+			Msg:    fmt.Sprintf("%s: %s", ERR_MAINTENANCE_NOT_FOUND, req.UUID),
+		}
+	}
+	if err != nil {
+		return &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to get maintenance: %v", err),
+		}
+	}
+	if closed != nil {
+		return &models.Error{
+			Status: http.StatusConflict,
+			Msg:    ERR_MAINTENANCE_CLOSED,
+		}
+	}
+
+	query := `UPDATE maintenances SET closed_at = now(), mileage = $2,
+				ends_at = GREATEST(starts_at, LEAST(COALESCE(ends_at, now()), now()))
+				WHERE uuid = $1`
+
+	if _, err = tx.ExecContext(ctx, query, req.UUID, req.Mileage); err != nil {
+		return &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to close maintenance: %v", err),
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to commit tx: %v", err),
+		}
+	}
+
+	return nil
+}
+
+func (r *repository) GetMaintenances(ctx context.Context, carUUID string) ([]models.Maintenance, error) {
+	query := `SELECT uuid, car_uuid, kind, reason, starts_at, ends_at, interval_km, mileage, closed_at, created_by
+				FROM maintenances WHERE car_uuid = $1 ORDER BY starts_at, uuid`
+
+	var maintenances []models.Maintenance
+	if err := r.db.SelectContext(ctx, &maintenances, query, carUUID); err != nil {
+		return nil, &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to get maintenances: %v", err),
+		}
+	}
+
+	return maintenances, nil
+}
+
+// checkNoMaintenance fails, if the car has a maintenance intersecting with the period
+func checkNoMaintenance(ctx context.Context, q sqlx.QueryerContext, carUUID string, from, to time.Time) error {
+	query := `SELECT EXISTS (SELECT 1 FROM maintenances
+				WHERE car_uuid = $1 AND tstzrange(starts_at, ends_at, '[)') && tstzrange($2::timestamptz, $3::timestamptz, '[)'))`
+
+	var found bool
+	if err := sqlx.GetContext(ctx, q, &found, query, carUUID, from, to); err != nil {
+		return &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to check maintenances: %v", err),
+		}
+	}
+
+	if found {
+		return &models.Error{
+			Status: http.StatusConflict,
+			Msg:    ERR_CAR_IN_MAINTENANCE,
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestRepository_Maintenance(t *testing.T) {
+	dsn := os.Getenv(testDSN)
+	if dsn == "" {
+		t.Skipf("%s is not set", testDSN)
+	}
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir("../../.."))
+	defer func() {
+		require.NoError(t, os.Chdir(wd))
+	}()
+
+	conn := MustConnect(dsn)
+	defer conn.Close()
+
+	ctx := context.Background()
+	repo := NewRepo(conn)
+
+	carUUID := uuid.New().String()
+	_, err = conn.Exec(`INSERT INTO cars (uuid, brand, type, max_speed, seats, category, price_per_day, image_uuid)
+				VALUES ($1, 'test', 'test', 200, 4, 'test', 10000, $2)`, carUUID, uuid.New().String())
+	require.NoError(t, err)
+	defer func() {
+		_, err = conn.Exec(`DELETE FROM maintenances WHERE car_uuid = $1`, carUUID)
+		require.NoError(t, err)
+		_, err = conn.Exec(`DELETE FROM rents WHERE car_uuid = $1`, carUUID)
+		require.NoError(t, err)
+		_, err = conn.Exec(`DELETE FROM cars WHERE uuid = $1`, carUUID)
+		require.NoError(t, err)
+	}()
+
+	start := time.Now().Add(time.Hour * 24).Truncate(time.Second)
+
+	tx, err := repo.StartTx(ctx)
+	require.NoError(t, err)
+	rentUUID := uuid.New().String()
+	require.NoError(t, repo.CreateRentTx(ctx, tx, models.CreateRentReq{
+		RentUUID:  rentUUID,
+		CarUUID:   carUUID,
+		RentStart: start,
+		RentEnd:   start.Add(time.Hour * 24),
+	}))
+	require.NoError(t, tx.Commit())
+
+	end := start.Add(time.Hour * 48)
+	planned := models.Maintenance{
+		UUID:       uuid.New().String(),
+		CarUUID:    carUUID,
+		Kind:       models.MAINTENANCE_PLANNED,
+		Reason:     "oil change",
+		Start:      start.Add(time.Hour * 12),
+		End:        &end,
+		IntervalKm: 10_000,
+		Actor:      "test",
+	}
+	conflicts, err := repo.ScheduleMaintenance(ctx, planned)
+	require.NoError(t, err)
+	require.Len(t, conflicts, 1)
+	require.Equal(t, rentUUID, conflicts[0].RentUUID)
+
+	// the maintenances of the same car can not intersect
+	overlapping := planned
+	overlapping.UUID = uuid.New().String()
+	_, err = repo.ScheduleMaintenance(ctx, overlapping)
+	var e *models.Error
+	require.True(t, errors.As(err, &e))
+	require.Equal(t, http.StatusConflict, e.Status)
+
+	available, err := repo.CheckIfCarAvailableInPeriod(ctx, carUUID, start.Add(time.Hour*30), start.Add(time.Hour*31))
+	require.NoError(t, err)
+	require.False(t, available)
+
+	cars, err := repo.GetAvailableCars(ctx, models.Period{Start: ptr(start.Add(time.Hour * 30)), End: ptr(start.Add(time.Hour * 31))}, models.CarParams{}, models.Page{Size: 100})
+	require.NoError(t, err)
+	for _, car := range cars.Cars {
+		require.NotEqual(t, carUUID, car.UUID)
+	}
+
+	// the rent can not be created during the maintenance
+	tx, err = repo.StartTx(ctx)
+	require.NoError(t, err)
+	err = repo.CreateRentTx(ctx, tx, models.CreateRentReq{
+		RentUUID:  uuid.New().String(),
+		CarUUID:   carUUID,
+		RentStart: start.Add(time.Hour * 30),
+		RentEnd:   start.Add(time.Hour * 31),
+	})
+	require.True(t, errors.As(err, &e))
+	require.Equal(t, http.StatusConflict, e.Status)
+	require.NoError(t, tx.Rollback())
+
+	// the maintenance, closed before its start, does not block the car anymore
+	require.NoError(t, repo.CloseMaintenance(ctx, models.CloseMaintenanceReq{UUID: planned.UUID, Mileage: 20_000}))
+	require.Error(t, repo.CloseMaintenance(ctx, models.CloseMaintenanceReq{UUID: planned.UUID}))
+
+	available, err = repo.CheckIfCarAvailableInPeriod(ctx, carUUID, start.Add(time.Hour*30), start.Add(time.Hour*31))
+	require.NoError(t, err)
+	require.True(t, available)
+
+	maintenances, err := repo.GetMaintenances(ctx, carUUID)
+	require.NoError(t, err)
+	require.Len(t, maintenances, 1)
+	require.NotNil(t, maintenances[0].ClosedAt)
+	require.EqualValues(t, 20_000, maintenances[0].Mileage)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,139 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/alserov/rently/carsharing/internal/db"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"github.com/lib/pq"
+	"net/http"
+)
+
+const (
+	ERR_PROMO_CODE_NOT_FOUND = "promo code not found"
+	ERR_PROMO_CODE_EXPIRED   = "promo code is already expired"
+)
+
+func (r *repository) CreatePromoCode(_ context.Context, promo models.PromoCode) error {
+	query := `INSERT INTO promo_codes (code, percent, amount, currency, max_uses, max_uses_per_user, categories, valid_from, valid_until)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+
+	_, err := r.db.Exec(query, promo.Code, promo.Percent, promo.Amount.Amount, promo.Amount.Currency, promo.MaxUses, promo.MaxUsesPerUser,
+		pq.Array(promo.Categories), promo.ValidFrom, promo.ValidUntil)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == UNIQUE_VIOLATION {
+		return &models.Error{
+			Msg:    fmt.Sprintf("promo code %s already exists", promo.Code),
+			Status: http.StatusConflict,
+		}
+	}
+	if err != nil {
+		return &models.Error{
+			Msg:    fmt.Sprintf("failed to create promo code: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return nil
+}
+
+func (r *repository) ExpirePromoCode(_ context.Context, code string) error {
+	query := `UPDATE promo_codes SET expired_at = now() WHERE code = $1 AND expired_at IS NULL`
+
+	res, err := r.db.Exec(query, code)
+	if err != nil {
+		return &models.Error{
+			Msg:    fmt.Sprintf("failed to expire promo code: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return &models.Error{
+			Msg:    fmt.Sprintf("failed to get affected rows: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+	if affected == 1 {
+		return nil
+	}
+
+	// nothing is updated for the missing and the already expired codes
+	var exists bool
+	if err = r.db.Get(&exists, `SELECT EXISTS (SELECT 1 FROM promo_codes WHERE code = $1)`, code); err != nil {
+		return &models.Error{
+			Msg:    fmt.Sprintf("failed to get promo code: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+	if exists {
+		return &models.Error{
+			Msg:    ERR_PROMO_CODE_EXPIRED,
+			Status: http.StatusConflict,
+		}
+	}
+
+	return &models.Error{
+		Msg:    ERR_PROMO_CODE_NOT_FOUND,
+		Status: http.StatusNotFound,
+	}
+}
+
+func (r *repository) GetPromoCodeTx(_ context.Context, tx db.SqlTx, code string) (models.PromoCode, error) {
+	// the code is locked, so concurrent redemptions can not exceed the usage limits
+	query := `SELECT code, percent, amount, currency, max_uses, max_uses_per_user, categories, valid_from, valid_until, expired_at
+				FROM promo_codes WHERE code = $1 FOR UPDATE`
+
+	var promo models.PromoCode
+	err := tx.QueryRowx(query, code).Scan(&promo.Code, &promo.Percent, &promo.Amount.Amount, &promo.Amount.Currency, &promo.MaxUses,
+		&promo.MaxUsesPerUser, pq.Array(&promo.Categories), &promo.ValidFrom, &promo.ValidUntil, &promo.ExpiredAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.PromoCode{}, &models.Error{
+			Msg:    ERR_PROMO_CODE_NOT_FOUND,
+			Status: http.StatusBadRequest,
+		}
+	}
+	if err != nil {
+		return models.PromoCode{}, &models.Error{
+			Msg:    fmt.Sprintf("failed to get promo code: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return promo, nil
+}
+
+func (r *repository) GetPromoUsageTx(_ context.Context, tx db.SqlTx, code string, userUUID string) (models.PromoUsage, error) {
+	query := `SELECT count(*) AS total, count(*) FILTER ( WHERE promo_redemptions.user_uuid = $2 ) AS by_user
+				FROM promo_redemptions JOIN charges ON charges.uuid = promo_redemptions.charge_uuid
+				WHERE promo_redemptions.code = $1 AND charges.status IN ($3, $4)`
+
+	var usage models.PromoUsage
+	err := tx.QueryRowx(query, code, userUUID, models.CHARGE_STATUS_AUTHORIZED, models.CHARGE_STATUS_SUCCEEDED).StructScan(&usage)
+	if err != nil {
+		return models.PromoUsage{}, &models.Error{
+			Msg:    fmt.Sprintf("failed to count promo code redemptions: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return usage, nil
+}
+
+func (r *repository) CreatePromoRedemptionTx(_ context.Context, tx db.SqlTx, redemption models.PromoRedemption) error {
+	query := `INSERT INTO promo_redemptions (code, charge_uuid, rent_uuid, user_uuid, discount, currency) VALUES ($1, $2, $3, $4, $5, $6)`
+
+	_, err := tx.Exec(query, redemption.Code, redemption.ChargeUUID, redemption.RentUUID, redemption.UserUUID,
+		redemption.Discount.Amount, redemption.Discount.Currency)
+	if err != nil {
+		return &models.Error{
+			Msg:    fmt.Sprintf("failed to create promo code redemption: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return nil
+}
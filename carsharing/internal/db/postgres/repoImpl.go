@@ -8,6 +8,7 @@ import (
 	"github.com/alserov/rently/carsharing/internal/db"
 	"github.com/alserov/rently/carsharing/internal/models"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"net/http"
@@ -21,7 +22,14 @@ func NewRepo(db *sqlx.DB) db.Repository {
 }
 
 const (
-	ERR_NO_ROWS = "nothing found"
+	ERR_NO_ROWS           = "nothing found"
+	ERR_CAR_NOT_AVAILABLE = "this car is already rented in this period"
+)
+
+const (
+	// EXCLUSION_VIOLATION is raised by rents_no_overlap constraint when rent periods of the same car intersect
+	EXCLUSION_VIOLATION pq.ErrorCode = "23P01"
+	UNIQUE_VIOLATION    pq.ErrorCode = "23505"
 )
 
 type repository struct {
@@ -37,12 +45,173 @@ func (r *repository) StartTx(ctx context.Context) (db.SqlTx, error) {
 	return db.SqlTx{Tx: tx}, nil
 }
 
+func (r *repository) GetIdempotencyKey(_ context.Context, key string, operation string, userUUID string) (models.IdempotencyKey, bool, error) {
+	query := `SELECT key, operation, user_uuid, request_hash, response FROM idempotency_keys
+				WHERE key = $1 AND operation = $2 AND user_uuid = $3`
+
+	var stored models.IdempotencyKey
+	err := r.db.QueryRowx(query, key, operation, userUUID).StructScan(&stored)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.IdempotencyKey{}, false, nil
+	}
+	if err != nil {
+		return models.IdempotencyKey{}, false, &models.Error{
+			Msg:    fmt.Sprintf("failed to get idempotency key: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return stored, true, nil
+}
+
+func (r *repository) CreateIdempotencyKeyTx(_ context.Context, tx db.SqlTx, key models.IdempotencyKey) (bool, error) {
+	query := `INSERT INTO idempotency_keys (key, operation, user_uuid, request_hash, response) VALUES ($1, $2, $3, $4, $5) ON CONFLICT DO NOTHING`
+
+	res, err := tx.Exec(query, key.Key, key.Operation, key.UserUUID, key.RequestHash, string(key.Response))
+	if err != nil {
+		return false, &models.Error{
+			Msg:    fmt.Sprintf("failed to insert idempotency key: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	inserted, err := res.RowsAffected()
+	if err != nil {
+		return false, &models.Error{
+			Msg:    fmt.Sprintf("failed to get affected rows: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return inserted == 1, nil
+}
+
 func (r *repository) RefundChargeTx(ctx context.Context, tx db.SqlTx, chargeUUID string) error {
+	return r.UpdateChargeStatusTx(ctx, tx, chargeUUID, models.CHARGE_STATUS_REFUNDING)
+}
+
+func (r *repository) UpdateChargeStatusTx(_ context.Context, tx db.SqlTx, chargeUUID string, status string) error {
 	query := `UPDATE charges SET status = $1 WHERE uuid = $2`
 
-	if err := tx.QueryRowx(query, CHARGE_STATUS_REFUNDED, chargeUUID).Err(); err != nil {
+	if _, err := tx.Exec(query, status, chargeUUID); err != nil {
+		return &models.Error{
+			Msg:    fmt.Sprintf("failed to switch charge status to '%s': %v", status, err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return nil
+}
+
+func (r *repository) GetExistingCharges(ctx context.Context, chargeUUIDs []string) ([]string, error) {
+	var existing []string
+	if err := r.db.SelectContext(ctx, &existing, `SELECT uuid FROM charges WHERE uuid = ANY($1)`, pq.Array(chargeUUIDs)); err != nil {
+		return nil, &models.Error{
+			Msg:    fmt.Sprintf("failed to get charges: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return existing, nil
+}
+
+func (r *repository) GetRentsWithoutCharge(_ context.Context) ([]string, error) {
+	query := `SELECT uuid FROM rents
+				WHERE status IN ($1, $2) AND NOT EXISTS (
+				    SELECT 1 FROM charges WHERE charges.rent_uuid = rents.uuid AND charges.status IN ($3, $4)
+				)`
+
+	var rentUUIDs []string
+	err := r.db.Select(&rentUUIDs, query, models.RENT_STATUS_RESERVED, models.RENT_STATUS_ACTIVE, models.CHARGE_STATUS_AUTHORIZED, models.CHARGE_STATUS_SUCCEEDED)
+	if err != nil {
+		return nil, &models.Error{
+			Msg:    fmt.Sprintf("failed to select rents without charge: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return rentUUIDs, nil
+}
+
+func (r *repository) GetStuckCharges(ctx context.Context, rentEndedBefore time.Time) ([]models.Charge, error) {
+	query := `SELECT charges.uuid AS charge_uuid, charges.rent_uuid, charges.status FROM charges
+				JOIN rents ON rents.uuid = charges.rent_uuid
+				WHERE (charges.status = $1 AND rents.rent_end < $2) OR charges.status = $3`
+
+	var charges []models.Charge
+	err := r.db.SelectContext(ctx, &charges, query, models.CHARGE_STATUS_AUTHORIZED, rentEndedBefore, models.CHARGE_STATUS_REFUNDING)
+	if err != nil {
+		return nil, &models.Error{
+			Msg:    fmt.Sprintf("failed to select stuck charges: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return charges, nil
+}
+
+func (r *repository) RequeueChargeEvent(ctx context.Context, eventType string, chargeUUID string, maxAttempts int) (bool, error) {
+	query := `UPDATE outbox SET attempts = 0, next_attempt_at = now()
+				WHERE processed_at IS NULL AND attempts >= $1 AND event_type = $2 AND payload ->> 'chargeUUID' = $3`
+
+	res, err := r.db.ExecContext(ctx, query, maxAttempts, eventType, chargeUUID)
+	if err != nil {
+		return false, &models.Error{
+			Msg:    fmt.Sprintf("failed to requeue charge event: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	requeued, err := res.RowsAffected()
+	if err != nil {
+		return false, &models.Error{
+			Msg:    fmt.Sprintf("failed to requeue charge event: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return requeued > 0, nil
+}
+
+func (r *repository) CreateOutboxEventTx(_ context.Context, tx db.SqlTx, event models.OutboxEvent) error {
+	query := `INSERT INTO outbox (event_type, payload) VALUES ($1, $2)`
+
+	if _, err := tx.Exec(query, event.Type, string(event.Payload)); err != nil {
+		return &models.Error{
+			Msg:    fmt.Sprintf("failed to insert outbox event: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return nil
+}
+
+func (r *repository) GetPendingOutboxEventTx(_ context.Context, tx db.SqlTx, maxAttempts int) (models.OutboxEvent, bool, error) {
+	query := `SELECT id, event_type, payload, attempts FROM outbox
+				WHERE processed_at IS NULL AND attempts < $1 AND next_attempt_at <= now()
+				ORDER BY id LIMIT 1 FOR UPDATE SKIP LOCKED`
+
+	var event models.OutboxEvent
+	err := tx.QueryRowx(query, maxAttempts).StructScan(&event)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.OutboxEvent{}, false, nil
+	}
+	if err != nil {
+		return models.OutboxEvent{}, false, &models.Error{
+			Msg:    fmt.Sprintf("failed to get outbox event: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return event, true, nil
+}
+
+func (r *repository) MarkOutboxEventProcessedTx(_ context.Context, tx db.SqlTx, id int64) error {
+	query := `UPDATE outbox SET processed_at = now() WHERE id = $1`
+
+	if _, err := tx.Exec(query, id); err != nil {
 		return &models.Error{
-			Msg:    fmt.Sprintf("failed to switch charge status to '%s': %v", CHARGE_STATUS_REFUNDED, err),
+			Msg:    fmt.Sprintf("failed to mark outbox event as processed: %v", err),
 			Status: http.StatusInternalServerError,
 		}
 	}
@@ -50,11 +219,28 @@ func (r *repository) RefundChargeTx(ctx context.Context, tx db.SqlTx, chargeUUID
 	return nil
 }
 
+func (r *repository) MarkOutboxEventFailedTx(_ context.Context, tx db.SqlTx, id int64, reason string) error {
+	// every next attempt is postponed for a minute more than the previous one
+	query := `UPDATE outbox SET attempts = attempts + 1, last_error = $2, next_attempt_at = now() + (attempts + 1) * interval '1 minute'
+				WHERE id = $1`
+
+	if _, err := tx.Exec(query, id, reason); err != nil {
+		return &models.Error{
+			Msg:    fmt.Sprintf("failed to mark outbox event as failed: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return nil
+}
+
+// CheckIfCarAvailableInPeriod the car is not available, if it has the rents or the maintenances intersecting with the period
 func (r *repository) CheckIfCarAvailableInPeriod(_ context.Context, carUUID string, from, to time.Time) (bool, error) {
-	query := `SELECT count(*) FROM rents WHERE car_uuid = $1 AND $2 BETWEEN rent_start AND rent_end OR $3 BETWEEN rent_start AND rent_end`
+	query := `SELECT (SELECT count(*) FROM rents WHERE car_uuid = $1 AND status IN ($4, $5) AND rent_start < $3 AND rent_end > $2) +
+					 (SELECT count(*) FROM maintenances WHERE car_uuid = $1 AND tstzrange(starts_at, ends_at, '[)') && tstzrange($2, $3, '[)'))`
 
 	var found int
-	if err := r.db.QueryRowx(query, carUUID, from, to).Scan(&found); err != nil {
+	if err := r.db.QueryRowx(query, carUUID, from, to, models.RENT_STATUS_RESERVED, models.RENT_STATUS_ACTIVE).Scan(&found); err != nil {
 		return false, &models.Error{
 			Msg:    fmt.Sprintf("failed to check if car is available: %v", err),
 			Status: http.StatusInternalServerError,
@@ -65,9 +251,9 @@ func (r *repository) CheckIfCarAvailableInPeriod(_ context.Context, carUUID stri
 }
 
 func (r *repository) GetRentsWhatStartsOnDate(ctx context.Context, tomorrowDate time.Time) ([]models.RentStartData, error) {
-	query := `SELECT car_uuid, user_uuid, rent_start, rent_end FROM rents WHERE user_uuid != '' AND rent_start = $1`
+	query := `SELECT car_uuid, user_uuid, rent_start, rent_end FROM rents WHERE user_uuid != '' AND rent_start = $1 AND status = $2`
 
-	rows, err := r.db.Queryx(query, tomorrowDate)
+	rows, err := r.db.Queryx(query, tomorrowDate, models.RENT_STATUS_RESERVED)
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
 		return nil, &models.Error{
 			Msg:    fmt.Sprintf("failed to select rents which start on %v: %v", tomorrowDate, err),
@@ -90,15 +276,10 @@ func (r *repository) GetRentsWhatStartsOnDate(ctx context.Context, tomorrowDate
 	return rentData, nil
 }
 
-const (
-	CHARGE_STATUS_SUCCEEDED = "succeeded"
-	CHARGE_STATUS_REFUNDED  = "refunded"
-)
-
 func (r *repository) CreateChargeTx(ctx context.Context, tx db.SqlTx, req models.Charge) error {
-	query := `INSERT INTO charges (uuid, rent_uuid,charge_amount, status) VALUES ($1,$2,$3, $4)`
+	query := `INSERT INTO charges (uuid, rent_uuid,charge_amount, currency, status) VALUES ($1,$2,$3, $4, $5)`
 
-	if err := tx.QueryRowx(query, req.ChargeUUID, req.RentUUID, req.ChargeAmount, CHARGE_STATUS_SUCCEEDED).Err(); err != nil {
+	if err := tx.QueryRowx(query, req.ChargeUUID, req.RentUUID, req.ChargeAmount.Amount, req.ChargeAmount.Currency, models.CHARGE_STATUS_AUTHORIZED).Err(); err != nil {
 		return &models.Error{
 			Msg:    fmt.Sprintf("failed to insert charge: %v", err),
 			Status: http.StatusInternalServerError,
@@ -108,10 +289,48 @@ func (r *repository) CreateChargeTx(ctx context.Context, tx db.SqlTx, req models
 	return nil
 }
 
+func (r *repository) GetRentStatusTx(_ context.Context, tx db.SqlTx, rentUUID string) (string, error) {
+	query := `SELECT status FROM rents WHERE uuid = $1 FOR UPDATE`
+
+	var status string
+	err := tx.QueryRowx(query, rentUUID).Scan(&status)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", &models.Error{
+			Msg:    fmt.Sprintf("rent with uuid: %s not found", rentUUID),
+			Status: http.StatusNotFound,
+		}
+	}
+	if err != nil {
+		return "", &models.Error{
+			Msg:    fmt.Sprintf("failed to get rent status: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return status, nil
+}
+
+func (r *repository) UpdateRentStatusTx(_ context.Context, tx db.SqlTx, rentUUID string, status string) error {
+	query := `UPDATE rents SET status = $1 WHERE uuid = $2`
+
+	if _, err := tx.Exec(query, status, rentUUID); err != nil {
+		return &models.Error{
+			Msg:    fmt.Sprintf("failed to switch rent status to '%s': %v", status, err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return nil
+}
+
 func (r *repository) CancelRentTx(ctx context.Context, tx db.SqlTx, rentUUID string) (models.CancelRentInfo, error) {
-	query := `DELETE FROM rents WHERE uuid = $1 RETURNING (SELECT uuid FROM charges WHERE rent_uuid = $1)`
+	query := `UPDATE rents SET status = $2 WHERE uuid = $1
+				RETURNING car_uuid, user_uuid, email, rent_start, rent_end,
+					(SELECT uuid FROM charges WHERE rent_uuid = $1) AS uuid,
+					(SELECT charge_amount FROM charges WHERE rent_uuid = $1) AS "rent_price.amount",
+					(SELECT currency FROM charges WHERE rent_uuid = $1) AS "rent_price.currency"`
 
-	row := tx.QueryRowx(query, rentUUID)
+	row := tx.QueryRowx(query, rentUUID, models.RENT_STATUS_CANCELED)
 
 	var rentInfo models.CancelRentInfo
 	if err := row.StructScan(&rentInfo); err != nil {
@@ -124,22 +343,26 @@ func (r *repository) CancelRentTx(ctx context.Context, tx db.SqlTx, rentUUID str
 	return rentInfo, nil
 }
 
-func (r *repository) CreateCar(ctx context.Context, car models.Car) error {
-	query := `INSERT INTO cars (uuid, brand, type,max_speed,seats,category,price_per_day, image_uuid)
-				VALUES ($1,$2,$3,$4,$5,$6,$7, $8)`
-
-	_, err := r.db.Query(query, car.UUID, car.Brand, car.Type, car.MaxSpeed, car.Seats, car.Category, car.PricePerDay, car.MainImage)
+func (r *repository) CreateCar(ctx context.Context, car models.Car, actor string) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
 		return &models.Error{
 			Status: http.StatusInternalServerError,
-			Msg:    fmt.Sprintf("failed to execute query: %v", err),
+			Msg:    fmt.Sprintf("failed to start tx: %v", err),
+		}
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if car.StationUUID != "" {
+		if err = takeStationPlace(ctx, tx, car.StationUUID); err != nil {
+			return err
 		}
 	}
 
-	query = `INSERT INTO images (uuid, car_uuid)
-				VALUES ($1,$2)`
+	query := `INSERT INTO cars (uuid, brand, type,max_speed,seats,category,price_per_day, currency, image_uuid, station_uuid)
+				VALUES ($1,$2,$3,$4,$5,$6,$7, $8, $9, NULLIF($10, ''))`
 
-	_, err = r.db.Query(query, car.MainImage, car.UUID)
+	_, err = tx.ExecContext(ctx, query, car.UUID, car.Brand, car.Type, car.MaxSpeed, car.Seats, car.Category, car.PricePerDay.Amount, car.PricePerDay.Currency, car.MainImage, car.StationUUID)
 	if err != nil {
 		return &models.Error{
 			Status: http.StatusInternalServerError,
@@ -147,102 +370,86 @@ func (r *repository) CreateCar(ctx context.Context, car models.Car) error {
 		}
 	}
 
-	return nil
-}
-
-func (r *repository) DeleteCar(ctx context.Context, uuid string) error {
-	query := `DELETE FROM cars WHERE uuid = $1`
+	// the main image opens the gallery
+	query = `INSERT INTO images (uuid, car_uuid, position, variants)
+				SELECT image.uuid, $1, image.position - 1, $3 FROM unnest($2::text[]) WITH ORDINALITY AS image(uuid, position)`
 
-	err := r.db.QueryRow(query, uuid).Err()
-	if errors.Is(err, sql.ErrNoRows) {
+	_, err = tx.ExecContext(ctx, query, car.UUID, pq.Array(append([]string{car.MainImage}, car.Images...)), pq.Array(IMAGE_VARIANTS))
+	if err != nil {
 		return &models.Error{
-			Msg:    fmt.Sprintf("car with uuid: %s not found", uuid),
-			Status: http.StatusNotFound,
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to execute query: %v", err),
 		}
 	}
+
+	err = insertCarChange(ctx, tx, models.CarChange{
+		CarUUID: car.UUID,
+		Actor:   actor,
+		Action:  models.CAR_CHANGE_CREATE,
+		Changes: diffCar(models.Car{}, car),
+	})
 	if err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
 		return &models.Error{
-			Msg:    fmt.Sprintf("failed to execute delete query: %v", err),
 			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to commit tx: %v", err),
 		}
 	}
 
 	return nil
 }
 
-func (r *repository) UpdateCarPrice(ctx context.Context, req models.UpdateCarPriceReq) error {
-	query := `UPDATE cars SET price_per_day = $1 WHERE uuid = $2`
-
-	_, err := r.db.Exec(query, req.Price, req.CarUUID)
+func (r *repository) CreateRentTx(ctx context.Context, tx db.SqlTx, req models.CreateRentReq) error {
+	// the shared lock keeps the car from being deleted, till the rent is committed
+	var carUUID string
+	err := tx.Get(&carUUID, `SELECT uuid FROM cars WHERE uuid = $1 AND deleted_at IS NULL FOR SHARE`, req.CarUUID)
 	if errors.Is(err, sql.ErrNoRows) {
 		return &models.Error{
 			Status: http.StatusNotFound,
-			Msg:    fmt.Sprintf("carsharing with uuid: %s not found", req.CarUUID),
+			Msg:    fmt.Sprintf("car not found: %s", req.CarUUID),
 		}
 	}
 	if err != nil {
 		return &models.Error{
 			Status: http.StatusInternalServerError,
-			Msg:    fmt.Sprintf("failed to execute delete query: %v", err),
+			Msg:    fmt.Sprintf("failed to get car: %v", err),
 		}
 	}
 
-	return nil
-}
-
-func (r *repository) CreateRentTx(_ context.Context, tx db.SqlTx, req models.CreateRentReq) (float32, error) {
-	query := `INSERT INTO rents(uuid,car_uuid, user_uuid,phone_number,passport_number,rent_start,rent_end)
-				VALUES ($1,$2,$3,$4,$5, $6, $7) 
-				RETURNING (SELECT price_per_day FROM cars WHERE uuid = $2 LIMIT 1)`
-
-	var carPricePerDay float32
-	err := tx.QueryRowx(query, req.RentUUID, req.CarUUID, req.UserUUID, req.PhoneNumber, req.PassportNumber, req.RentStart, req.RentEnd).Scan(&carPricePerDay)
-	if err != nil {
-		return 0, &models.Error{
-			Status: http.StatusInternalServerError,
-			Msg:    fmt.Sprintf("failed to create rent: %v", err),
-		}
+	// the maintenance is scheduled under the exclusive lock of the car, so it can not appear till the commit
+	if err = checkNoMaintenance(ctx, tx, req.CarUUID, req.RentStart, req.RentEnd); err != nil {
+		return err
 	}
 
-	return carPricePerDay, nil
-}
+	query := `INSERT INTO rents(uuid,car_uuid, user_uuid,phone_number,passport_number,email,rent_start,rent_end, pickup_station_uuid, drop_off_station_uuid)
+				VALUES ($1,$2,$3,$4,$5, $6, $7, $8, NULLIF($9, ''), NULLIF($10, ''))`
 
-func (r *repository) GetCarsByParams(ctx context.Context, params models.CarParams) ([]models.CarMainInfo, error) {
-	query := `SELECT cars.uuid,brand, type,category, price_per_day, images.uuid as image FROM cars LEFT JOIN images ON cars.image_uuid = images.uuid
-                WHERE 
-                    (LOWER(brand) = LOWER($1) OR $1 = '') AND
-                    (LOWER(type) = LOWER($2) OR $2 = '') AND
-                	(max_speed > $3 OR $3 = 0) AND
-                	(seats = $4 OR $4 = 0) AND
-                	(LOWER(category) = LOWER($5) OR $5 = '') AND
-                	(price_per_day < $6 OR $6 = 0)
-                    `
-
-	rows, err := r.db.Queryx(query, params.Brand, params.Type, params.MaxSpeed, params.Seats, params.Category, params.PricePerDay)
-	if errors.Is(err, sql.ErrNoRows) {
-		return nil, status.Error(codes.NotFound, ERR_NO_ROWS)
+	_, err = tx.Exec(query, req.RentUUID, req.CarUUID, req.UserUUID, req.PhoneNumber, req.PassportNumber, req.Email, req.RentStart, req.RentEnd,
+		req.PickupStation, req.DropOffStation)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == EXCLUSION_VIOLATION {
+		return &models.Error{
+			Status: http.StatusConflict,
+			Msg:    ERR_CAR_NOT_AVAILABLE,
+		}
 	}
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
-
-	var cars []models.CarMainInfo
-	for rows.Next() {
-		var car models.CarMainInfo
-		if err = rows.StructScan(&car); err != nil {
-			return nil, &models.Error{
-				Msg:    fmt.Sprintf("failed to scan: %v", err),
-				Status: http.StatusInternalServerError,
-			}
+		return &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to create rent: %v", err),
 		}
-		cars = append(cars, car)
 	}
 
-	return cars, nil
+	return nil
 }
 
 func (r *repository) GetCarByUUID(ctx context.Context, uuid string) (models.Car, error) {
-	query := `SELECT * FROM cars WHERE uuid = $1`
+	query := `SELECT uuid, brand, type, max_speed, seats, category, price_per_day AS "price_per_day.amount", currency AS "price_per_day.currency",
+       			image_uuid AS main_image, COALESCE(station_uuid, '') AS station_uuid
+				FROM cars WHERE uuid = $1 AND deleted_at IS NULL`
 
 	var car models.Car
 	err := r.db.Get(&car, query, uuid)
@@ -259,12 +466,9 @@ func (r *repository) GetCarByUUID(ctx context.Context, uuid string) (models.Car,
 		}
 	}
 
-	query = `SELECT uuid FROM images WHERE car_uuid = $1`
+	query = `SELECT uuid FROM images WHERE car_uuid = $1 ORDER BY position`
 
-	err = r.db.Get(&car.Images, query, uuid)
-	if errors.Is(err, sql.ErrNoRows) {
-		return models.Car{}, status.Error(codes.NotFound, ERR_NO_ROWS)
-	}
+	err = r.db.Select(&car.Images, query, uuid)
 	if err != nil {
 		return models.Car{}, status.Error(codes.Internal, err.Error())
 	}
@@ -272,37 +476,39 @@ func (r *repository) GetCarByUUID(ctx context.Context, uuid string) (models.Car,
 	return car, nil
 }
 
-func (r *repository) GetAvailableCars(ctx context.Context, period models.Period) ([]models.CarMainInfo, error) {
-	query := `SELECT uuid,brand, type, max_speed, seats, category, price_per_day, images FROM cars 
-            	WHERE uuid NOT IN (SELECT car_uuid FROM rents WHERE rent_start > $1 OR rent_end < $2)`
+func (r *repository) GetCarPricing(_ context.Context, uuid string) (models.CarPricing, error) {
+	query := `SELECT price_per_day AS "price_per_day.amount", currency AS "price_per_day.currency", category,
+       			COALESCE(station_uuid, '') AS station_uuid
+				FROM cars WHERE uuid = $1 AND deleted_at IS NULL`
 
-	rows, err := r.db.Queryx(query, period.Start, period.End)
+	var pricing models.CarPricing
+	err := r.db.Get(&pricing, query, uuid)
 	if errors.Is(err, sql.ErrNoRows) {
-		return nil, status.Error(codes.NotFound, ERR_NO_ROWS)
+		return models.CarPricing{}, &models.Error{
+			Msg:    fmt.Sprintf("car not found: %s", uuid),
+			Status: http.StatusNotFound,
+		}
 	}
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
-
-	var cars []models.CarMainInfo
-	for rows.Next() {
-		var car models.CarMainInfo
-		if err = rows.StructScan(&car); err != nil {
-			return nil, status.Error(codes.Internal, err.Error())
+		return models.CarPricing{}, &models.Error{
+			Msg:    fmt.Sprintf("failed to get car pricing: %v", err),
+			Status: http.StatusInternalServerError,
 		}
-		cars = append(cars, car)
 	}
 
-	return cars, nil
+	return pricing, nil
 }
 
 func (r *repository) CheckRent(_ context.Context, rentUUID string) (models.Rent, error) {
-	query := `SELECT car_uuid, rent_start, rent_end, charges.charge_amount, charges.status FROM rents 
+	// the legacy rents have no charge, so their price is zero
+	query := `SELECT car_uuid, rent_start, rent_end, COALESCE(charges.charge_amount, 0) AS "rent_price.amount",
+       			COALESCE(charges.currency, $2) AS "rent_price.currency", rents.status,
+       			COALESCE(pickup_station_uuid, '') AS pickup_station_uuid, COALESCE(drop_off_station_uuid, '') AS drop_off_station_uuid FROM rents 
     			LEFT JOIN charges ON charges.rent_uuid = rents.uuid 
                 WHERE rents.uuid = $1`
 
 	var rent models.Rent
-	err := r.db.Get(&rent, query, rentUUID)
+	err := r.db.Get(&rent, query, rentUUID, models.DEFAULT_CURRENCY)
 	if errors.Is(err, sql.ErrNoRows) {
 		return models.Rent{}, &models.Error{
 			Status: http.StatusNotFound,
@@ -0,0 +1,102 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"net/http"
+	"strings"
+	"unicode"
+)
+
+const (
+	FACET_BRAND    = "brand"
+	FACET_CATEGORY = "category"
+	FACET_SEATS    = "seats"
+)
+
+const ERR_EMPTY_SEARCH_QUERY = "search query should contain letters or digits"
+
+// SearchCars finds the cars matching every word of the query and counts the found cars per brand, category and seats
+func (r *repository) SearchCars(_ context.Context, req models.SearchCarsReq, page models.Page) (models.SearchCarsRes, error) {
+	search := searchQuery(req.Query)
+	if search == "" {
+		return models.SearchCarsRes{}, &models.Error{
+			Msg:    ERR_EMPTY_SEARCH_QUERY,
+			Status: http.StatusBadRequest,
+		}
+	}
+
+	q := carsQuery{search: search}
+	q.where("cars.search @@ query")
+	q.filter(models.CarParams{
+		Brand:    req.Brand,
+		Category: req.Category,
+		Seats:    req.Seats,
+	})
+
+	cars, err := r.selectCarsPage(q, page)
+	if err != nil {
+		return models.SearchCarsRes{}, err
+	}
+
+	facets, err := r.selectCarFacets(q)
+	if err != nil {
+		return models.SearchCarsRes{}, err
+	}
+
+	return models.SearchCarsRes{
+		CarsPage: cars,
+		Facets:   facets,
+	}, nil
+}
+
+type facetRow struct {
+	Name string `db:"facet"`
+	models.Facet
+}
+
+// selectCarFacets counts all the cars of the query in a single scan using grouping sets
+func (r *repository) selectCarFacets(q carsQuery) (models.CarFacets, error) {
+	from, args := q.from()
+	query := fmt.Sprintf(`SELECT CASE WHEN GROUPING(brand) = 0 THEN '%s' WHEN GROUPING(category) = 0 THEN '%s' ELSE '%s' END AS facet,
+				COALESCE(brand, category, seats::text) AS value, COUNT(*) AS count %s
+				GROUP BY GROUPING SETS ((brand), (category), (seats))
+				ORDER BY count DESC, value`, FACET_BRAND, FACET_CATEGORY, FACET_SEATS, from)
+
+	var rows []facetRow
+	if err := r.db.Select(&rows, r.db.Rebind(query), args...); err != nil {
+		return models.CarFacets{}, &models.Error{
+			Msg:    fmt.Sprintf("failed to count cars: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	var facets models.CarFacets
+	for _, row := range rows {
+		switch row.Name {
+		case FACET_BRAND:
+			facets.Brands = append(facets.Brands, row.Facet)
+		case FACET_CATEGORY:
+			facets.Categories = append(facets.Categories, row.Facet)
+		case FACET_SEATS:
+			facets.Seats = append(facets.Seats, row.Facet)
+		}
+	}
+
+	return facets, nil
+}
+
+// searchQuery converts the phrase to the tsquery, where every word is a prefix,
+// so "bmw conv" matches "BMW convertible", the other characters are dropped
+func searchQuery(phrase string) string {
+	words := strings.FieldsFunc(strings.ToLower(phrase), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	for i := range words {
+		words[i] += ":*"
+	}
+
+	return strings.Join(words, " & ")
+}
@@ -0,0 +1,73 @@
+package postgres
+
+import (
+	"context"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"os"
+	"testing"
+)
+
+func TestSearchQuery(t *testing.T) {
+	require.Equal(t, "bmw:* & convertible:*", searchQuery("BMW convertible"))
+	require.Equal(t, "bmw:* & x5:*", searchQuery("  bmw, x5!"))
+	require.Equal(t, "bmw:*", searchQuery("bmw:* | !"))
+	require.Empty(t, searchQuery("&|!"))
+}
+
+func TestRepository_SearchCars(t *testing.T) {
+	dsn := os.Getenv(testDSN)
+	if dsn == "" {
+		t.Skipf("%s is not set", testDSN)
+	}
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir("../../.."))
+	defer func() {
+		require.NoError(t, os.Chdir(wd))
+	}()
+
+	conn := MustConnect(dsn)
+	defer conn.Close()
+
+	ctx := context.Background()
+	repo := NewRepo(conn)
+
+	// the type isolates the test cars from the others
+	carType := "test" + uuid.New().String()[:8]
+	cars := []struct {
+		brand    string
+		category string
+		seats    int
+	}{
+		{brand: "bmw", category: "premium", seats: 2},
+		{brand: "bmw", category: "premium", seats: 4},
+		{brand: "bmw", category: "comfort", seats: 4},
+		{brand: "audi", category: "premium", seats: 4},
+	}
+	for _, car := range cars {
+		_, err = conn.Exec(`INSERT INTO cars (uuid, brand, type, max_speed, seats, category, price_per_day, image_uuid)
+				VALUES ($1, $2, $3, 200, $4, $5, 10000, $6)`, uuid.New().String(), car.brand, carType, car.seats, car.category, uuid.New().String())
+		require.NoError(t, err)
+	}
+	defer func() {
+		_, err = conn.Exec(`DELETE FROM cars WHERE type = $1`, carType)
+		require.NoError(t, err)
+	}()
+
+	res, err := repo.SearchCars(ctx, models.SearchCarsReq{Query: carType[:8] + " BM"}, models.Page{SortBy: models.SORT_BY_RELEVANCE, Descending: true})
+	require.NoError(t, err)
+	require.Len(t, res.Cars, 3)
+	require.Equal(t, []models.Facet{{Value: "bmw", Count: 3}}, res.Facets.Brands)
+	require.Equal(t, []models.Facet{{Value: "premium", Count: 2}, {Value: "comfort", Count: 1}}, res.Facets.Categories)
+	require.Equal(t, []models.Facet{{Value: "4", Count: 2}, {Value: "2", Count: 1}}, res.Facets.Seats)
+
+	// facets are counted with the filters applied
+	res, err = repo.SearchCars(ctx, models.SearchCarsReq{Query: carType, Category: "premium"}, models.Page{Size: 1})
+	require.NoError(t, err)
+	require.Len(t, res.Cars, 1)
+	require.NotEmpty(t, res.NextPageToken)
+	require.Equal(t, []models.Facet{{Value: "bmw", Count: 2}, {Value: "audi", Count: 1}}, res.Facets.Brands)
+}
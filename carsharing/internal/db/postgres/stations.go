@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"github.com/jmoiron/sqlx"
+	"net/http"
+)
+
+const (
+	ERR_STATION_NOT_FOUND = "station not found"
+	ERR_STATION_IS_FULL   = "the station has no free places"
+)
+
+func (r *repository) CreateStation(ctx context.Context, station models.Station) error {
+	query := `INSERT INTO stations (uuid, name, address, latitude, longitude, opens_at, closes_at, time_zone, capacity)
+				VALUES (:uuid, :name, :address, :latitude, :longitude, :opens_at, :closes_at, :time_zone, :capacity)`
+
+	if _, err := r.db.NamedExecContext(ctx, query, station); err != nil {
+		return &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to create station: %v", err),
+		}
+	}
+
+	return nil
+}
+
+func (r *repository) GetStation(ctx context.Context, uuid string) (models.Station, error) {
+	query := `SELECT uuid, name, address, latitude, longitude, opens_at, closes_at, time_zone, capacity FROM stations WHERE uuid = $1`
+
+	var station models.Station
+	err := r.db.GetContext(ctx, &station, query, uuid)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.Station{}, &models.Error{
+			Status: http.StatusNotFound,
+			Msg:    fmt.Sprintf("%s: %s", ERR_STATION_NOT_FOUND, uuid),
+		}
+	}
+	if err != nil {
+		return models.Station{}, &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to get station: %v", err),
+		}
+	}
+
+	return station, nil
+}
+
+// GetStations the stations within the area are sorted by the distance, the others by the name
+func (r *repository) GetStations(ctx context.Context, near models.Area) ([]models.Station, error) {
+	query := `SELECT uuid, name, address, latitude, longitude, opens_at, closes_at, time_zone, capacity FROM stations ORDER BY name, uuid`
+	args := []any{}
+
+	if near.RadiusKm > 0 {
+		query = `SELECT uuid, name, address, latitude, longitude, opens_at, closes_at, time_zone, capacity FROM stations
+					WHERE distance_km(latitude, longitude, $1, $2) <= $3
+					ORDER BY distance_km(latitude, longitude, $1, $2), uuid`
+		args = append(args, near.Latitude, near.Longitude, near.RadiusKm)
+	}
+
+	var stations []models.Station
+	if err := r.db.SelectContext(ctx, &stations, query, args...); err != nil {
+		return nil, &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to get stations: %v", err),
+		}
+	}
+
+	return stations, nil
+}
+
+// takeStationPlace checks, that the station has a free place for one more car, the station is locked till the end of the tx,
+// so the concurrent assignments can not exceed the capacity
+func takeStationPlace(ctx context.Context, tx *sqlx.Tx, stationUUID string) error {
+	var capacity int
+	err := tx.GetContext(ctx, &capacity, `SELECT capacity FROM stations WHERE uuid = $1 FOR UPDATE`, stationUUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &models.Error{
+			Status: http.StatusNotFound,
+			Msg:    fmt.Sprintf("%s: %s", ERR_STATION_NOT_FOUND, stationUUID),
+		}
+	}
+	if err != nil {
+		return &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to get station: %v", err),
+		}
+	}
+
+	var cars int
+	err = tx.GetContext(ctx, &cars, `SELECT count(*) FROM cars WHERE station_uuid = $1 AND deleted_at IS NULL`, stationUUID)
+	if err != nil {
+		return &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to count cars of the station: %v", err),
+		}
+	}
+
+	if cars >= capacity {
+		return &models.Error{
+			Status: http.StatusConflict,
+			Msg:    ERR_STATION_IS_FULL,
+		}
+	}
+
+	return nil
+}
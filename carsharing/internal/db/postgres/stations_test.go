@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestRepository_Stations(t *testing.T) {
+	dsn := os.Getenv(testDSN)
+	if dsn == "" {
+		t.Skipf("%s is not set", testDSN)
+	}
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir("../../.."))
+	defer func() {
+		require.NoError(t, os.Chdir(wd))
+	}()
+
+	conn := MustConnect(dsn)
+	defer conn.Close()
+
+	ctx := context.Background()
+	repo := NewRepo(conn)
+
+	// the stations are about 3.4 km apart
+	center := models.Station{UUID: uuid.New().String(), Name: "center", Address: "test", Latitude: 52.5200, Longitude: 13.4050, TimeZone: "UTC", Capacity: 1}
+	west := models.Station{UUID: uuid.New().String(), Name: "west", Address: "test", Latitude: 52.5200, Longitude: 13.3550, TimeZone: "UTC", Capacity: 5}
+	require.NoError(t, repo.CreateStation(ctx, center))
+	require.NoError(t, repo.CreateStation(ctx, west))
+
+	cars := []models.Car{
+		{UUID: uuid.New().String(), StationUUID: center.UUID},
+		{UUID: uuid.New().String(), StationUUID: west.UUID},
+	}
+	for i := range cars {
+		cars[i].Brand, cars[i].Type, cars[i].Category, cars[i].Seats = "test", "test", "test", 4
+		cars[i].PricePerDay = models.NewMoney(100_00, models.DEFAULT_CURRENCY)
+		cars[i].MainImage = uuid.New().String()
+		require.NoError(t, repo.CreateCar(ctx, cars[i], "test"))
+	}
+	defer func() {
+		for _, car := range cars {
+			_, err = conn.Exec(`DELETE FROM car_changes WHERE car_uuid = $1`, car.UUID)
+			require.NoError(t, err)
+			_, err = conn.Exec(`DELETE FROM images WHERE car_uuid = $1`, car.UUID)
+			require.NoError(t, err)
+			_, err = conn.Exec(`DELETE FROM cars WHERE uuid = $1`, car.UUID)
+			require.NoError(t, err)
+		}
+		_, err = conn.Exec(`DELETE FROM stations WHERE uuid IN ($1, $2)`, center.UUID, west.UUID)
+		require.NoError(t, err)
+	}()
+
+	// the center station is full
+	var e *models.Error
+	err = repo.UpdateCar(ctx, models.UpdateCarReq{
+		CarUUID: cars[1].UUID,
+		Car:     models.Car{StationUUID: center.UUID},
+		Fields:  []string{models.CAR_FIELD_STATION},
+	}, "test")
+	require.True(t, errors.As(err, &e))
+	require.Equal(t, http.StatusConflict, e.Status)
+
+	near := models.Area{Latitude: 52.5200, Longitude: 13.4000, RadiusKm: 1}
+	stations, err := repo.GetStations(ctx, near)
+	require.NoError(t, err)
+	require.Contains(t, stations, center)
+	require.NotContains(t, stations, west)
+
+	start, end := time.Now().Add(time.Hour), time.Now().Add(time.Hour*2)
+	period := models.Period{Start: &start, End: &end}
+	page, err := repo.GetAvailableCars(ctx, period, models.CarParams{Brand: "test", Near: near}, models.Page{Size: models.MAX_PAGE_SIZE})
+	require.NoError(t, err)
+	require.Contains(t, carUUIDs(page.Cars), cars[0].UUID)
+	require.NotContains(t, carUUIDs(page.Cars), cars[1].UUID)
+
+	page, err = repo.GetAvailableCars(ctx, period, models.CarParams{StationUUID: west.UUID}, models.Page{})
+	require.NoError(t, err)
+	require.Equal(t, []string{cars[1].UUID}, carUUIDs(page.Cars))
+}
+
+func carUUIDs(cars []models.CarMainInfo) []string {
+	uuids := make([]string, 0, len(cars))
+	for _, car := range cars {
+		uuids = append(uuids, car.UUID)
+	}
+	return uuids
+}
@@ -11,18 +11,77 @@ type Repository interface {
 	RentRepository
 	CarRepository
 	AdminRepository
+	ImageRepository
+	StationRepository
+	MaintenanceRepository
+	IdempotencyRepository
+	PaymentRepository
+	PromoRepository
+}
+
+type PromoRepository interface {
+	CreatePromoCode(ctx context.Context, promo models.PromoCode) error
+	ExpirePromoCode(ctx context.Context, code string) error
+}
+
+type PaymentRepository interface {
+	// GetExistingCharges returns the ids of the charges, which are stored
+	GetExistingCharges(ctx context.Context, chargeUUIDs []string) ([]string, error)
+	GetRentsWithoutCharge(ctx context.Context) (rentUUIDs []string, err error)
+	// GetStuckCharges returns the charges, which are still authorized after the end of their rents, and the refunding ones
+	GetStuckCharges(ctx context.Context, rentEndedBefore time.Time) ([]models.Charge, error)
+	// RequeueChargeEvent resets the attempts of the charge event, which has exhausted them
+	RequeueChargeEvent(ctx context.Context, eventType string, chargeUUID string, maxAttempts int) (requeued bool, err error)
+}
+
+type IdempotencyRepository interface {
+	GetIdempotencyKey(ctx context.Context, key string, operation string, userUUID string) (stored models.IdempotencyKey, found bool, err error)
 }
 
 type AdminRepository interface {
-	CreateCar(ctx context.Context, car models.Car) error
-	DeleteCar(ctx context.Context, uuid string) error
-	UpdateCarPrice(ctx context.Context, req models.UpdateCarPriceReq) error
+	// the changes of the cars are recorded with the actor, who made them
+	CreateCar(ctx context.Context, car models.Car, actor string) error
+	// DeleteCar fails, if the car has active or upcoming rents
+	DeleteCar(ctx context.Context, uuid string, actor string) error
+	UpdateCar(ctx context.Context, req models.UpdateCarReq, actor string) error
+	GetCarChanges(ctx context.Context, carUUID string) ([]models.CarChange, error)
+}
+
+type ImageRepository interface {
+	// AddCarImage appends the image to the gallery, the main image replaces the current one
+	AddCarImage(ctx context.Context, carUUID string, image models.CarImage) error
+	GetCarImages(ctx context.Context, carUUID string) ([]models.CarImage, error)
+	DeleteCarImage(ctx context.Context, carUUID string, imageId string) (models.CarImage, error)
+	// ReorderCarImages ids have to contain all the images of the car
+	ReorderCarImages(ctx context.Context, carUUID string, ids []string) error
+	SetMainCarImage(ctx context.Context, carUUID string, imageId string) error
+	// GetExistingImages returns the ids, which are linked to the cars, from the passed ones
+	GetExistingImages(ctx context.Context, ids []string) ([]string, error)
+	// LinkLegacyImages appends the stored images, which were uploaded before the gallery had the rows, to the gallery of the car,
+	// the linked ids are returned, nothing is linked if there is no such car
+	LinkLegacyImages(ctx context.Context, carUUID string, ids []string) ([]string, error)
+}
+
+type StationRepository interface {
+	CreateStation(ctx context.Context, station models.Station) error
+	GetStation(ctx context.Context, uuid string) (models.Station, error)
+	// GetStations zero radius of the area means all the stations
+	GetStations(ctx context.Context, near models.Area) ([]models.Station, error)
+}
+
+type MaintenanceRepository interface {
+	// ScheduleMaintenance returns the reserved and active rents of the car, which intersect with the maintenance
+	ScheduleMaintenance(ctx context.Context, m models.Maintenance) ([]models.RentConflict, error)
+	CloseMaintenance(ctx context.Context, req models.CloseMaintenanceReq) error
+	GetMaintenances(ctx context.Context, carUUID string) ([]models.Maintenance, error)
 }
 
 type CarRepository interface {
-	GetCarsByParams(ctx context.Context, params models.CarParams) ([]models.CarMainInfo, error)
+	GetCarsByParams(ctx context.Context, params models.CarParams, page models.Page) (models.CarsPage, error)
 	GetCarByUUID(ctx context.Context, uuid string) (models.Car, error)
-	GetAvailableCars(ctx context.Context, period models.Period) ([]models.CarMainInfo, error)
+	GetAvailableCars(ctx context.Context, period models.Period, params models.CarParams, page models.Page) (models.CarsPage, error)
+	SearchCars(ctx context.Context, req models.SearchCarsReq, page models.Page) (models.SearchCarsRes, error)
+	GetCarPricing(ctx context.Context, uuid string) (models.CarPricing, error)
 }
 
 type RentRepository interface {
@@ -35,10 +94,23 @@ type RentRepository interface {
 type Tx interface {
 	StartTx(ctx context.Context) (SqlTx, error)
 
-	CreateRentTx(ctx context.Context, tx SqlTx, req models.CreateRentReq) (price float32, err error)
+	CreateRentTx(ctx context.Context, tx SqlTx, req models.CreateRentReq) error
 	CancelRentTx(ctx context.Context, tx SqlTx, rentUUID string) (rentInfo models.CancelRentInfo, err error)
+	GetRentStatusTx(ctx context.Context, tx SqlTx, rentUUID string) (status string, err error)
+	UpdateRentStatusTx(ctx context.Context, tx SqlTx, rentUUID string, status string) error
 	CreateChargeTx(ctx context.Context, tx SqlTx, req models.Charge) error
 	RefundChargeTx(ctx context.Context, tx SqlTx, chargeUUID string) error
+	CreateIdempotencyKeyTx(ctx context.Context, tx SqlTx, key models.IdempotencyKey) (created bool, err error)
+	UpdateChargeStatusTx(ctx context.Context, tx SqlTx, chargeUUID string, status string) error
+
+	GetPromoCodeTx(ctx context.Context, tx SqlTx, code string) (promo models.PromoCode, err error)
+	GetPromoUsageTx(ctx context.Context, tx SqlTx, code string, userUUID string) (usage models.PromoUsage, err error)
+	CreatePromoRedemptionTx(ctx context.Context, tx SqlTx, redemption models.PromoRedemption) error
+
+	CreateOutboxEventTx(ctx context.Context, tx SqlTx, event models.OutboxEvent) error
+	GetPendingOutboxEventTx(ctx context.Context, tx SqlTx, maxAttempts int) (event models.OutboxEvent, found bool, err error)
+	MarkOutboxEventProcessedTx(ctx context.Context, tx SqlTx, id int64) error
+	MarkOutboxEventFailedTx(ctx context.Context, tx SqlTx, id int64, reason string) error
 }
 
 type SqlTx struct {
@@ -0,0 +1,207 @@
+package imaging
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"net/http"
+)
+
+// Processor validates the uploaded images and prepares their variants
+type Processor interface {
+	Process(b []byte) (models.ProcessedImage, error)
+	// ProcessReader reads the image up to the max size, so the oversized upload is not read till the end
+	ProcessReader(r io.Reader) (models.ProcessedImage, error)
+}
+
+const (
+	DEFAULT_MAX_SIZE       = 10 << 20
+	DEFAULT_MAX_PIXELS     = 50_000_000
+	DEFAULT_THUMBNAIL_SIZE = 320
+	DEFAULT_MEDIUM_SIZE    = 1280
+	DEFAULT_JPEG_QUALITY   = 85
+)
+
+const (
+	ERR_UNSUPPORTED_IMAGE = "unsupported image type, jpeg, png or webp is expected"
+	ERR_INVALID_IMAGE     = "invalid image"
+	ERR_IMAGE_TOO_LARGE   = "image is too large"
+)
+
+type Params struct {
+	// MaxSize is the max size of the file in bytes
+	MaxSize int64
+	// MaxPixels protects from the small files, which are decoded into huge images
+	MaxPixels int
+	// ThumbnailSize and MediumSize are the max sizes of the longer side of the variants
+	ThumbnailSize int
+	MediumSize    int
+	JPEGQuality   int
+}
+
+// NewProcessor returns the processor, zero params are replaced by the defaults
+func NewProcessor(p Params) Processor {
+	if p.MaxSize == 0 {
+		p.MaxSize = DEFAULT_MAX_SIZE
+	}
+	if p.MaxPixels == 0 {
+		p.MaxPixels = DEFAULT_MAX_PIXELS
+	}
+	if p.ThumbnailSize == 0 {
+		p.ThumbnailSize = DEFAULT_THUMBNAIL_SIZE
+	}
+	if p.MediumSize == 0 {
+		p.MediumSize = DEFAULT_MEDIUM_SIZE
+	}
+	if p.JPEGQuality == 0 {
+		p.JPEGQuality = DEFAULT_JPEG_QUALITY
+	}
+
+	return &processor{p: p}
+}
+
+type processor struct {
+	p Params
+}
+
+const (
+	CONTENT_TYPE_JPEG = "image/jpeg"
+	CONTENT_TYPE_PNG  = "image/png"
+	CONTENT_TYPE_WEBP = "image/webp"
+)
+
+// Process checks the content of the file instead of trusting the client and re-encodes the pixels,
+// so the metadata, e.g. EXIF with the location of the photo, is not stored
+func (pr *processor) Process(b []byte) (models.ProcessedImage, error) {
+	if int64(len(b)) > pr.p.MaxSize {
+		return models.ProcessedImage{}, &models.Error{
+			Msg:    fmt.Sprintf("%s: max size is %d bytes", ERR_IMAGE_TOO_LARGE, pr.p.MaxSize),
+			Status: http.StatusRequestEntityTooLarge,
+		}
+	}
+
+	contentType := http.DetectContentType(b)
+	switch contentType {
+	case CONTENT_TYPE_JPEG, CONTENT_TYPE_PNG, CONTENT_TYPE_WEBP:
+	default:
+		return models.ProcessedImage{}, &models.Error{
+			Msg:    ERR_UNSUPPORTED_IMAGE,
+			Status: http.StatusBadRequest,
+		}
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(b))
+	if err != nil {
+		return models.ProcessedImage{}, &models.Error{
+			Msg:    fmt.Sprintf("%s: %v", ERR_INVALID_IMAGE, err),
+			Status: http.StatusBadRequest,
+		}
+	}
+
+	if cfg.Width*cfg.Height > pr.p.MaxPixels {
+		return models.ProcessedImage{}, &models.Error{
+			Msg:    fmt.Sprintf("%s: max resolution is %d pixels", ERR_IMAGE_TOO_LARGE, pr.p.MaxPixels),
+			Status: http.StatusRequestEntityTooLarge,
+		}
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(b))
+	if err != nil {
+		return models.ProcessedImage{}, &models.Error{
+			Msg:    fmt.Sprintf("%s: %v", ERR_INVALID_IMAGE, err),
+			Status: http.StatusBadRequest,
+		}
+	}
+
+	// the orientation is applied to the pixels, because it is lost with the rest of EXIF
+	if contentType == CONTENT_TYPE_JPEG {
+		img = orient(img, jpegOrientation(b))
+	}
+
+	// there is no webp encoder, so webp is stored as png or jpeg depending on the transparency
+	if contentType == CONTENT_TYPE_WEBP {
+		contentType = CONTENT_TYPE_PNG
+		if isOpaque(img) {
+			contentType = CONTENT_TYPE_JPEG
+		}
+	}
+
+	res := models.ProcessedImage{
+		ContentType: contentType,
+		Variants:    make(map[string][]byte, 3),
+	}
+
+	sizes := map[string]int{
+		models.IMAGE_VARIANT_THUMBNAIL: pr.p.ThumbnailSize,
+		models.IMAGE_VARIANT_MEDIUM:    pr.p.MediumSize,
+		models.IMAGE_VARIANT_ORIGINAL:  0,
+	}
+	for variant, size := range sizes {
+		if res.Variants[variant], err = pr.encode(fit(img, size), contentType); err != nil {
+			return models.ProcessedImage{}, &models.Error{
+				Msg:    fmt.Sprintf("failed to encode %s image: %v", variant, err),
+				Status: http.StatusInternalServerError,
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func (pr *processor) ProcessReader(r io.Reader) (models.ProcessedImage, error) {
+	b, err := io.ReadAll(io.LimitReader(r, pr.p.MaxSize+1))
+	if err != nil {
+		return models.ProcessedImage{}, &models.Error{
+			Msg:    fmt.Sprintf("failed to read image: %v", err),
+			Status: http.StatusBadRequest,
+		}
+	}
+
+	return pr.Process(b)
+}
+
+func (pr *processor) encode(img image.Image, contentType string) ([]byte, error) {
+	buf := new(bytes.Buffer)
+
+	var err error
+	switch contentType {
+	case CONTENT_TYPE_JPEG:
+		err = jpeg.Encode(buf, img, &jpeg.Options{Quality: pr.p.JPEGQuality})
+	default:
+		err = png.Encode(buf, img)
+	}
+
+	return buf.Bytes(), err
+}
+
+// fit scales the image down, so its longer side is not bigger than the size, zero size keeps the image as is
+func fit(img image.Image, size int) image.Image {
+	b := img.Bounds()
+	if size == 0 || (b.Dx() <= size && b.Dy() <= size) {
+		return img
+	}
+
+	w, h := size, b.Dy()*size/b.Dx()
+	if b.Dy() > b.Dx() {
+		w, h = b.Dx()*size/b.Dy(), size
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, max(w, 1), max(h, 1)))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, b, draw.Src, nil)
+
+	return dst
+}
+
+func isOpaque(img image.Image) bool {
+	if o, ok := img.(interface{ Opaque() bool }); ok {
+		return o.Opaque()
+	}
+
+	return false
+}
@@ -0,0 +1,198 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"github.com/stretchr/testify/require"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"testing"
+)
+
+func TestProcessor_Process(t *testing.T) {
+	p := NewProcessor(Params{ThumbnailSize: 50, MediumSize: 100})
+
+	tests := []struct {
+		name        string
+		file        []byte
+		contentType string
+	}{
+		{
+			name:        "jpeg",
+			file:        encodeJPEG(t, newImage(400, 200)),
+			contentType: CONTENT_TYPE_JPEG,
+		},
+		{
+			name:        "png",
+			file:        encodePNG(t, newImage(400, 200)),
+			contentType: CONTENT_TYPE_PNG,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := p.Process(tc.file)
+			require.NoError(t, err)
+			require.Equal(t, tc.contentType, res.ContentType)
+
+			require.Equal(t, image.Pt(50, 25), decodeSize(t, res.Variants[models.IMAGE_VARIANT_THUMBNAIL]))
+			require.Equal(t, image.Pt(100, 50), decodeSize(t, res.Variants[models.IMAGE_VARIANT_MEDIUM]))
+			require.Equal(t, image.Pt(400, 200), decodeSize(t, res.Variants[models.IMAGE_VARIANT_ORIGINAL]))
+
+			for _, v := range res.Variants {
+				require.Equal(t, tc.contentType, http.DetectContentType(v))
+			}
+		})
+	}
+}
+
+func TestProcessor_ProcessSmallImage(t *testing.T) {
+	p := NewProcessor(Params{ThumbnailSize: 50, MediumSize: 100})
+
+	res, err := p.Process(encodePNG(t, newImage(30, 20)))
+	require.NoError(t, err)
+
+	for _, v := range res.Variants {
+		require.Equal(t, image.Pt(30, 20), decodeSize(t, v))
+	}
+}
+
+func TestProcessor_ProcessInvalid(t *testing.T) {
+	p := NewProcessor(Params{MaxSize: 1 << 10, MaxPixels: 100 * 100})
+
+	tests := []struct {
+		name   string
+		file   []byte
+		status int
+	}{
+		{
+			name:   "not an image",
+			file:   []byte("definitely not an image"),
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "broken image",
+			file:   encodePNG(t, newImage(10, 10))[:40],
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "too large file",
+			file:   append(encodePNG(t, newImage(10, 10)), make([]byte, 1<<10)...),
+			status: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:   "too many pixels",
+			file:   encodePNG(t, image.NewGray(image.Rect(0, 0, 101, 100))),
+			status: http.StatusRequestEntityTooLarge,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := p.Process(tc.file)
+
+			var e *models.Error
+			require.True(t, errors.As(err, &e))
+			require.Equal(t, tc.status, e.Status)
+		})
+	}
+}
+
+func TestProcessor_ProcessStripsExif(t *testing.T) {
+	p := NewProcessor(Params{})
+
+	// orientation 6 means that the camera was rotated, the image has to be turned clockwise
+	img := newImage(40, 20)
+	file := withExif(encodeJPEG(t, img), 6)
+	require.Equal(t, 6, jpegOrientation(file))
+	require.True(t, bytes.Contains(file, []byte("Exif")))
+
+	res, err := p.Process(file)
+	require.NoError(t, err)
+
+	original := res.Variants[models.IMAGE_VARIANT_ORIGINAL]
+	require.False(t, bytes.Contains(original, []byte("Exif")))
+	require.Equal(t, 1, jpegOrientation(original))
+	require.Equal(t, image.Pt(20, 40), decodeSize(t, original))
+}
+
+func TestOrient(t *testing.T) {
+	// 2x1 image: red, blue
+	img := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	img.Set(0, 0, color.RGBA{R: 255, A: 255})
+	img.Set(1, 0, color.RGBA{B: 255, A: 255})
+
+	red, blue := color.RGBA{R: 255, A: 255}, color.RGBA{B: 255, A: 255}
+
+	tests := []struct {
+		orientation int
+		size        image.Point
+		topLeft     color.RGBA
+	}{
+		{orientation: 1, size: image.Pt(2, 1), topLeft: red},
+		{orientation: 2, size: image.Pt(2, 1), topLeft: blue},
+		{orientation: 3, size: image.Pt(2, 1), topLeft: blue},
+		{orientation: 4, size: image.Pt(2, 1), topLeft: red},
+		{orientation: 5, size: image.Pt(1, 2), topLeft: red},
+		{orientation: 6, size: image.Pt(1, 2), topLeft: red},
+		{orientation: 7, size: image.Pt(1, 2), topLeft: blue},
+		{orientation: 8, size: image.Pt(1, 2), topLeft: blue},
+	}
+
+	for _, tc := range tests {
+		res := orient(img, tc.orientation)
+		require.Equal(t, tc.size, res.Bounds().Size(), tc.orientation)
+		require.Equal(t, tc.topLeft, color.RGBAModel.Convert(res.At(0, 0)), tc.orientation)
+	}
+}
+
+func newImage(w, h int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+	return img
+}
+
+func encodeJPEG(t *testing.T, img image.Image) []byte {
+	buf := new(bytes.Buffer)
+	require.NoError(t, jpeg.Encode(buf, img, nil))
+	return buf.Bytes()
+}
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	buf := new(bytes.Buffer)
+	require.NoError(t, png.Encode(buf, img))
+	return buf.Bytes()
+}
+
+func decodeSize(t *testing.T, b []byte) image.Point {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(b))
+	require.NoError(t, err)
+	return image.Pt(cfg.Width, cfg.Height)
+}
+
+// withExif inserts APP1 segment with the orientation tag right after SOI marker
+func withExif(file []byte, orientation uint16) []byte {
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08")
+	tiff = binary.BigEndian.AppendUint16(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, EXIF_ORIENTATION)
+	tiff = binary.BigEndian.AppendUint16(tiff, 3) // SHORT
+	tiff = binary.BigEndian.AppendUint32(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, orientation)
+	tiff = append(tiff, 0, 0, 0, 0, 0, 0)
+
+	segment := append([]byte("Exif\x00\x00"), tiff...)
+
+	res := []byte{0xFF, JPEG_SOI, 0xFF, JPEG_APP1}
+	res = binary.BigEndian.AppendUint16(res, uint16(len(segment)+2))
+	res = append(res, segment...)
+	return append(res, file[2:]...)
+}
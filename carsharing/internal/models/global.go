@@ -11,4 +11,9 @@ func (e *Error) Error() string {
 
 type CtxID string
 
-const ID CtxID = "id"
+const (
+	ID              CtxID = "id"
+	IDEMPOTENCY_KEY CtxID = "idempotency_key"
+	// ACTOR is the uuid of the admin, who makes the change
+	ACTOR CtxID = "actor"
+)
@@ -0,0 +1,47 @@
+package models
+
+import "time"
+
+const (
+	MAINTENANCE_PLANNED   = "planned"
+	MAINTENANCE_UNPLANNED = "unplanned"
+)
+
+// Maintenance takes the car off the market from Start till End, nil End means till the maintenance is closed
+type Maintenance struct {
+	UUID    string     `db:"uuid"`
+	CarUUID string     `db:"car_uuid"`
+	Kind    string     `db:"kind"`
+	Reason  string     `db:"reason"`
+	Start   time.Time  `db:"starts_at"`
+	End     *time.Time `db:"ends_at"`
+	// IntervalKm is the mileage, after which the next maintenance is due, Mileage is the odometer reading on close
+	IntervalKm int64      `db:"interval_km"`
+	Mileage    int64      `db:"mileage"`
+	ClosedAt   *time.Time `db:"closed_at"`
+	Actor      string     `db:"created_by"`
+}
+
+// RentConflict is the reserved or active rent, which intersects with the maintenance
+type RentConflict struct {
+	RentUUID  string    `db:"uuid"`
+	Status    string    `db:"status"`
+	RentStart time.Time `db:"rent_start"`
+	RentEnd   time.Time `db:"rent_end"`
+}
+
+type ScheduleMaintenanceRes struct {
+	UUID      string
+	Conflicts []RentConflict
+}
+
+type CloseMaintenanceReq struct {
+	UUID    string
+	Mileage int64
+}
+
+type CarMaintenances struct {
+	Maintenances []Maintenance
+	// NextServiceMileage is zero, if no closed maintenance has the interval
+	NextServiceMileage int64
+}
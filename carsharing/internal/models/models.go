@@ -1,16 +1,66 @@
 package models
 
 import (
+	"io"
 	"time"
 )
 
 type Rent struct {
-	CarUUID   string  `db:"car_uuid"`
-	RentPrice float32 `db:"charge_amount"`
-	Status    string  `db:"status"`
+	CarUUID   string `db:"car_uuid"`
+	RentPrice Money  `db:"rent_price"`
+	Status    string `db:"status"`
 
 	RentStart time.Time `db:"rent_start"`
 	RentEnd   time.Time `db:"rent_end"`
+
+	PickupStation  string `db:"pickup_station_uuid"`
+	DropOffStation string `db:"drop_off_station_uuid"`
+}
+
+const (
+	RENT_STATUS_RESERVED  = "reserved"
+	RENT_STATUS_ACTIVE    = "active"
+	RENT_STATUS_COMPLETED = "completed"
+	RENT_STATUS_CANCELED  = "canceled"
+	RENT_STATUS_NO_SHOW   = "no-show"
+)
+
+type IdempotencyKey struct {
+	Key       string `db:"key"`
+	Operation string `db:"operation"`
+	// UserUUID scopes the key, it is empty for the requests without the user
+	UserUUID string `db:"user_uuid"`
+	// RequestHash binds the key to the request, it was made with
+	RequestHash string `db:"request_hash"`
+	Response    []byte `db:"response"`
+}
+
+type QuotePriceReq struct {
+	CarUUID   string
+	RentStart time.Time
+	RentEnd   time.Time
+
+	// empty stations are resolved to the station of the car
+	PickupStation  string
+	DropOffStation string
+}
+
+type PriceQuote struct {
+	Hours       int
+	BasePrice   Money
+	Price       Money
+	Adjustments []PriceAdjustment
+}
+
+type PriceAdjustment struct {
+	Rule   string
+	Amount Money
+}
+
+type CarPricing struct {
+	PricePerDay Money  `db:"price_per_day"`
+	Category    string `db:"category"`
+	StationUUID string `db:"station_uuid"`
 }
 
 type RentStartData struct {
@@ -31,21 +81,107 @@ type CreateRentReq struct {
 	PhoneNumber    string
 	PassportNumber string
 	PaymentSource  string
+	Email          string
+	PromoCode      string
+
+	PickupStation  string
+	DropOffStation string
 
 	RentStart time.Time
 	RentEnd   time.Time
-	Days      int
 }
 
 type CancelRentInfo struct {
-	ChargeID  string  `db:"uuid"`
-	RentPrice float32 `db:"rent_price"`
+	ChargeID  string `db:"uuid"`
+	RentPrice Money  `db:"rent_price"`
+
+	CarUUID  string `db:"car_uuid"`
+	UserUUID string `db:"user_uuid"`
+	Email    string `db:"email"`
+
+	RentStart time.Time `db:"rent_start"`
+	RentEnd   time.Time `db:"rent_end"`
+}
+
+type RentNotification struct {
+	RentUUID string `json:"rentUUID"`
+	CarUUID  string `json:"carUUID"`
+	UserUUID string `json:"userUUID"`
+	Email    string `json:"email"`
+	// Amount is the price in major units, kept for the consumers, which don't read Price yet
+	Amount float32 `json:"amount"`
+	Price  Money   `json:"price"`
+
+	RentStart time.Time `json:"rentStart"`
+	RentEnd   time.Time `json:"rentEnd"`
 }
 
 type Charge struct {
-	RentUUID     string  `db:"rent_uuid"`
-	ChargeUUID   string  `db:"charge_uuid"`
-	ChargeAmount float32 `db:"charge_amount"`
+	RentUUID     string `db:"rent_uuid"`
+	ChargeUUID   string `db:"charge_uuid"`
+	ChargeAmount Money  `db:"charge_amount"`
+	Status       string `db:"status"`
+}
+
+const (
+	// CHARGE_STATUS_AUTHORIZED means that the amount is held and waits to be captured by outbox relay
+	CHARGE_STATUS_AUTHORIZED = "authorized"
+	CHARGE_STATUS_SUCCEEDED  = "succeeded"
+	// CHARGE_STATUS_REFUNDING means that the refund waits to be performed by outbox relay
+	CHARGE_STATUS_REFUNDING = "refunding"
+	CHARGE_STATUS_REFUNDED  = "refunded"
+)
+
+type OutboxEvent struct {
+	ID       int64  `db:"id"`
+	Type     string `db:"event_type"`
+	Payload  []byte `db:"payload"`
+	Attempts int    `db:"attempts"`
+}
+
+const (
+	EVENT_CHARGE_CAPTURE = "charge.capture"
+	EVENT_CHARGE_REFUND  = "charge.refund"
+	EVENT_RENT_CREATED   = "rent.created"
+	EVENT_RENT_CANCELED  = "rent.canceled"
+)
+
+// MAX_PROMO_PERCENT the whole rent price can not be discounted, something has to be charged
+const MAX_PROMO_PERCENT = 99
+
+type PromoCode struct {
+	Code string
+	// Percent is the discount in percents of the rent price, if it is zero, the fixed Amount is discounted
+	Percent int
+	Amount  Money
+	// MaxUses and MaxUsesPerUser limit redemptions of the code, zero means no limit
+	MaxUses        int
+	MaxUsesPerUser int
+	// Categories restrict the code to the cars of these categories, empty means any category
+	Categories []string
+
+	ValidFrom  time.Time
+	ValidUntil *time.Time
+	ExpiredAt  *time.Time
+}
+
+type PromoRedemption struct {
+	Code       string
+	ChargeUUID string
+	RentUUID   string
+	UserUUID   string
+	Discount   Money
+}
+
+// PromoUsage is the amount of active redemptions, the ones which charges are refunded are not counted
+type PromoUsage struct {
+	Total  int `db:"total"`
+	ByUser int `db:"by_user"`
+}
+
+type ChargeEvent struct {
+	ChargeUUID string `json:"chargeUUID"`
+	RentUUID   string `json:"rentUUID"`
 }
 
 func (c *CreateRentReq) Period() time.Duration {
@@ -60,24 +196,56 @@ type CreateRentRes struct {
 // ============================================
 
 type CarMainInfo struct {
-	UUID        string  `db:"uuid"`
-	Brand       string  `db:"brand"`
-	Type        string  `db:"type"`
-	Category    string  `db:"category"`
-	PricePerDay float32 `db:"price_per_day"`
-	Image       string  `db:"image"`
+	UUID        string `db:"uuid"`
+	Brand       string `db:"brand"`
+	Type        string `db:"type"`
+	Category    string `db:"category"`
+	PricePerDay Money  `db:"price_per_day"`
+	Image       string `db:"image"`
+	StationUUID string `db:"station_uuid"`
 }
 
 type Car struct {
 	UUID        string `db:"uuid"`
-	MainImage   string
+	MainImage   string `db:"main_image"`
 	Images      []string
-	Brand       string  `db:"brand"`
-	Type        string  `db:"type"`
-	MaxSpeed    int32   `db:"max_speed"`
-	Seats       int32   `db:"seats"`
-	Category    string  `db:"category"`
-	PricePerDay float32 `db:"price_per_day"`
+	Brand       string `db:"brand"`
+	Type        string `db:"type"`
+	MaxSpeed    int32  `db:"max_speed"`
+	Seats       int32  `db:"seats"`
+	Category    string `db:"category"`
+	PricePerDay Money  `db:"price_per_day"`
+	// StationUUID is the home station of the car, empty for the cars created before the stations
+	StationUUID string `db:"station_uuid"`
+}
+
+// image variants, the original is stripped of the metadata, but keeps the size
+const (
+	IMAGE_VARIANT_THUMBNAIL = "thumbnail"
+	IMAGE_VARIANT_MEDIUM    = "medium"
+	IMAGE_VARIANT_ORIGINAL  = "original"
+)
+
+// CarImage is the image of the car gallery, the gallery includes the main image
+type CarImage struct {
+	ID       string   `db:"uuid"`
+	Position int32    `db:"position"`
+	Main     bool     `db:"main"`
+	Variants []string `db:"variants"`
+}
+
+// ProcessedImage is the validated upload, Variants are encoded in the same ContentType
+type ProcessedImage struct {
+	ContentType string
+	Variants    map[string][]byte
+}
+
+// ImageRange is the part of the stored image, Size is the size of the whole image
+type ImageRange struct {
+	ContentType string
+	Size        int64
+	Offset      int64
+	File        io.ReadCloser
 }
 
 type Period struct {
@@ -86,21 +254,119 @@ type Period struct {
 }
 
 type CarParams struct {
-	Brand       string
-	Type        string
-	MaxSpeed    int32
-	Seats       int32
-	Category    string
-	PricePerDay float32
+	Brand    string
+	Type     string
+	MaxSpeed int32
+	Seats    int32
+	Category string
+	// MinPrice and MaxPrice are inclusive, zero amount means no limit
+	MinPrice Money
+	MaxPrice Money
+
+	StationUUID string
+	// Near selects the cars, which home stations are within the area
+	Near Area
+}
+
+type Page struct {
+	Size       int
+	Token      string
+	SortBy     string
+	Descending bool
+}
+
+const (
+	DEFAULT_PAGE_SIZE = 20
+	MAX_PAGE_SIZE     = 100
+)
+
+const (
+	SORT_BY_PRICE     = "price"
+	SORT_BY_MAX_SPEED = "maxSpeed"
+	SORT_BY_SEATS     = "seats"
+	SORT_BY_BRAND     = "brand"
+	// SORT_BY_RELEVANCE is only available for the search
+	SORT_BY_RELEVANCE = "relevance"
+)
+
+type CarsPage struct {
+	Cars []CarMainInfo
+	// NextPageToken is empty if it is the last page
+	NextPageToken string
+}
+
+// SearchCarsReq Query words are matched as prefixes of the car brand, type and category
+type SearchCarsReq struct {
+	Query    string
+	Brand    string
+	Category string
+	Seats    int32
+}
+
+type Facet struct {
+	Value string `db:"value"`
+	Count int64  `db:"count"`
+}
+
+// CarFacets are the amounts of the found cars per value
+type CarFacets struct {
+	Brands     []Facet
+	Categories []Facet
+	Seats      []Facet
+}
+
+type SearchCarsRes struct {
+	CarsPage
+	Facets CarFacets
 }
 
 type UpdateCarPriceReq struct {
 	CarUUID string
-	Price   float32
+	Price   Money
+}
+
+// the fields of the car, which can be updated, the names match the proto fields, so they are used as the mask paths
+const (
+	CAR_FIELD_BRAND     = "Brand"
+	CAR_FIELD_TYPE      = "Type"
+	CAR_FIELD_MAX_SPEED = "MaxSpeed"
+	CAR_FIELD_SEATS     = "Seats"
+	CAR_FIELD_CATEGORY  = "Category"
+	CAR_FIELD_PRICE     = "Price"
+	CAR_FIELD_STATION   = "StationUUID"
+)
+
+// UpdateCarReq only the Fields of the Car are updated
+type UpdateCarReq struct {
+	CarUUID string
+	Car     Car
+	Fields  []string
+}
+
+const (
+	CAR_CHANGE_CREATE = "create"
+	CAR_CHANGE_UPDATE = "update"
+	CAR_CHANGE_DELETE = "delete"
+)
+
+// CarChange is the audit record of the car, Changes are empty for the deletion
+type CarChange struct {
+	ID        int64                  `db:"id"`
+	CarUUID   string                 `db:"car_uuid"`
+	Actor     string                 `db:"actor"`
+	Action    string                 `db:"action"`
+	Changes   map[string]FieldChange `db:"-"`
+	ChangedAt time.Time              `db:"changed_at"`
+}
+
+type FieldChange struct {
+	Old any `json:"old,omitempty"`
+	New any `json:"new,omitempty"`
 }
 
 type UserInfo struct {
 	PhoneNumber    string
 	PassportNumber string
+	Email          string
 	UUID           string
 }
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+)
+
+// Money is an amount in minor units of the currency, e.g. cents for USD
+type Money struct {
+	Amount   int64  `db:"amount" json:"amount"`
+	Currency string `db:"currency" json:"currency"`
+}
+
+// DEFAULT_CURRENCY is used for the amounts, which come from the deprecated float fields
+const DEFAULT_CURRENCY = "USD"
+
+// MINOR_UNITS is the amount of minor units in the major one, all the supported currencies have 2 decimals
+const MINOR_UNITS = 100
+
+var currencyCode = regexp.MustCompile(`^[A-Z]{3}$`)
+
+func NewMoney(amount int64, currency string) Money {
+	return Money{
+		Amount:   amount,
+		Currency: currency,
+	}
+}
+
+// MoneyFromUnits converts the amount in major units, it is only used for the deprecated float fields
+func MoneyFromUnits(units float64, currency string) Money {
+	return NewMoney(int64(math.Round(units*MINOR_UNITS)), currency)
+}
+
+// Units returns the amount in major units, it is only used for the deprecated float fields
+func (m Money) Units() float32 {
+	return float32(m.Amount) / MINOR_UNITS
+}
+
+func (m Money) IsPositive() bool {
+	return m.Amount > 0
+}
+
+// Validate checks that the currency is an ISO 4217 code
+func (m Money) Validate() error {
+	if !currencyCode.MatchString(m.Currency) {
+		return fmt.Errorf("invalid currency: %s", m.Currency)
+	}
+
+	return nil
+}
+
+func (m Money) String() string {
+	sign := ""
+	amount := m.Amount
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+
+	return fmt.Sprintf("%s%d.%02d %s", sign, amount/MINOR_UNITS, amount%MINOR_UNITS, m.Currency)
+}
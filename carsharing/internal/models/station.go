@@ -0,0 +1,46 @@
+package models
+
+import "time"
+
+type Station struct {
+	UUID      string  `db:"uuid"`
+	Name      string  `db:"name"`
+	Address   string  `db:"address"`
+	Latitude  float64 `db:"latitude"`
+	Longitude float64 `db:"longitude"`
+	// OpensAt and ClosesAt are the minutes from the midnight in the TimeZone, equal ones mean that the station is always open
+	OpensAt  int    `db:"opens_at"`
+	ClosesAt int    `db:"closes_at"`
+	TimeZone string `db:"time_zone"`
+	// Capacity is the max amount of the cars, which have the station as the home one
+	Capacity int `db:"capacity"`
+}
+
+// IsOpenAt the station, which closes before it opens, works over the midnight
+func (s Station) IsOpenAt(t time.Time) bool {
+	if s.OpensAt == s.ClosesAt {
+		return true
+	}
+
+	if loc, err := time.LoadLocation(s.TimeZone); err == nil {
+		t = t.In(loc)
+	}
+	minute := t.Hour()*60 + t.Minute()
+
+	if s.OpensAt < s.ClosesAt {
+		return minute >= s.OpensAt && minute < s.ClosesAt
+	}
+	return minute >= s.OpensAt || minute < s.ClosesAt
+}
+
+// Area is the circle around the point, zero radius means that the area is not set
+type Area struct {
+	Latitude  float64
+	Longitude float64
+	RadiusKm  float64
+}
+
+const MAX_AREA_RADIUS_KM = 500
+
+// DAY_TIME_FORMAT is the format of the opening hours in the requests
+const DAY_TIME_FORMAT = "15:04"
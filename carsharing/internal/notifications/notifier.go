@@ -1,23 +1,37 @@
 package notifications
 
+import (
+	"context"
+	"github.com/alserov/rently/carsharing/internal/config"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"github.com/alserov/rently/carsharing/internal/utils/broker"
+)
+
 type Notifier interface {
-	RentCreated()
-	RentCanceled()
+	RentCreated(ctx context.Context, rent models.RentNotification) error
+	RentCanceled(ctx context.Context, rent models.RentNotification) error
 }
 
-func NewNotifier() Notifier {
-	return &notifier{}
+func NewNotifier(producer broker.Producer, topics config.Notification) Notifier {
+	return &notifier{producer: producer, topics: topics}
 }
 
+// ids have to match message types of the notification service
+const (
+	RENT_CREATED_ID  = "2"
+	RENT_CANCELED_ID = "3"
+)
+
 type notifier struct {
+	producer broker.Producer
+
+	topics config.Notification
 }
 
-func (n notifier) RentCreated() {
-	//TODO implement me
-	panic("implement me")
+func (n notifier) RentCreated(ctx context.Context, rent models.RentNotification) error {
+	return n.producer.Produce(ctx, rent, RENT_CREATED_ID, n.topics.RentCreated)
 }
 
-func (n notifier) RentCanceled() {
-	//TODO implement me
-	panic("implement me")
+func (n notifier) RentCanceled(ctx context.Context, rent models.RentNotification) error {
+	return n.producer.Produce(ctx, rent, RENT_CANCELED_ID, n.topics.RentCanceled)
 }
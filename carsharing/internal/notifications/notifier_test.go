@@ -0,0 +1,68 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/alserov/rently/carsharing/internal/config"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type testMessage struct {
+	id    string
+	queue string
+	body  []byte
+}
+
+type testProducer struct {
+	messages []testMessage
+}
+
+func (p *testProducer) Produce(_ context.Context, value any, id string, q string) error {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	p.messages = append(p.messages, testMessage{id: id, queue: q, body: b})
+	return nil
+}
+
+func TestNotifier(t *testing.T) {
+	p := &testProducer{}
+	topics := config.Notification{
+		RentCreated:  "rent_created",
+		RentCanceled: "rent_canceled",
+	}
+
+	n := NewNotifier(p, topics)
+
+	rentStart := time.Now().UTC().Truncate(time.Second)
+	rent := models.RentNotification{
+		RentUUID:  "rent_uuid",
+		CarUUID:   "car_uuid",
+		UserUUID:  "user_uuid",
+		Email:     "email@mail.com",
+		Amount:    300,
+		RentStart: rentStart,
+		RentEnd:   rentStart.Add(time.Hour * 24 * 3),
+	}
+
+	require.NoError(t, n.RentCreated(context.Background(), rent))
+	require.NoError(t, n.RentCanceled(context.Background(), rent))
+
+	require.Len(t, p.messages, 2)
+
+	require.Equal(t, RENT_CREATED_ID, p.messages[0].id)
+	require.Equal(t, topics.RentCreated, p.messages[0].queue)
+	require.Equal(t, RENT_CANCELED_ID, p.messages[1].id)
+	require.Equal(t, topics.RentCanceled, p.messages[1].queue)
+
+	for _, msg := range p.messages {
+		var received models.RentNotification
+		require.NoError(t, json.Unmarshal(msg.body, &received))
+		require.Equal(t, rent, received)
+	}
+}
@@ -0,0 +1,192 @@
+package payment
+
+import (
+	"fmt"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"github.com/google/uuid"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// FakeGateway is an in-process Payer, that keeps all the charges in memory,
+// used for local runs and tests without access to the real provider
+type FakeGateway interface {
+	Payer
+	// Decline makes all the next authorizations on the source fail
+	Decline(source string)
+	Charge(chargeID string) (FakeCharge, bool)
+	Charges() []FakeCharge
+}
+
+type FakeParams struct {
+	Latency         time.Duration
+	DeclinedSources []string
+	// IDPrefix is the prefix of the charge ids, the random one is generated if it is empty,
+	// so the ids are not reused after the restart, while the charges are kept in the db
+	IDPrefix string
+}
+
+type FakeCharge struct {
+	ID       string
+	Source   string
+	Amount   models.Money
+	Captured bool
+	Refunded bool
+}
+
+const FAKE_CHARGE_ID_PREFIX = "ch_fake_"
+
+func NewFakeGateway(p FakeParams) FakeGateway {
+	declined := make(map[string]struct{}, len(p.DeclinedSources))
+	for _, source := range p.DeclinedSources {
+		declined[source] = struct{}{}
+	}
+
+	if p.IDPrefix == "" {
+		p.IDPrefix = FAKE_CHARGE_ID_PREFIX + uuid.NewString()[:8] + "_"
+	}
+
+	return &fakeGateway{
+		idPrefix: p.IDPrefix,
+		latency:  p.Latency,
+		declined: declined,
+		charges:  make(map[string]*FakeCharge),
+		created:  make(map[string]time.Time),
+	}
+}
+
+type fakeGateway struct {
+	idPrefix string
+	latency  time.Duration
+
+	mu       sync.Mutex
+	declined map[string]struct{}
+	charges  map[string]*FakeCharge
+	// order keeps charge ids in the order they were created
+	order   []string
+	created map[string]time.Time
+}
+
+func (f *fakeGateway) Authorize(source string, amount models.Money) (string, error) {
+	time.Sleep(f.latency)
+
+	if !amount.IsPositive() {
+		return "", &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("invalid amount: %v", amount),
+		}
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.declined[source]; ok {
+		return "", &models.Error{
+			Status: http.StatusBadRequest,
+			Msg:    ERR_PAYMENT_DECLINED,
+		}
+	}
+
+	id := fmt.Sprintf("%s%d", f.idPrefix, len(f.order)+1)
+	f.charges[id] = &FakeCharge{
+		ID:     id,
+		Source: source,
+		Amount: amount,
+	}
+	f.order = append(f.order, id)
+	f.created[id] = time.Now()
+
+	return id, nil
+}
+
+func (f *fakeGateway) Capture(chargeID string) error {
+	time.Sleep(f.latency)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	ch, ok := f.charges[chargeID]
+	if !ok {
+		return &models.Error{
+			Msg:    fmt.Sprintf("failed to capture: charge %s not found", chargeID),
+			Status: http.StatusInternalServerError,
+		}
+	}
+	if ch.Refunded {
+		return &models.Error{
+			Msg:    fmt.Sprintf("failed to capture: charge %s is already refunded", chargeID),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	ch.Captured = true
+
+	return nil
+}
+
+func (f *fakeGateway) Refund(chargeID string) error {
+	time.Sleep(f.latency)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	ch, ok := f.charges[chargeID]
+	if !ok {
+		return &models.Error{
+			Msg:    fmt.Sprintf("failed to refund: charge %s not found", chargeID),
+			Status: http.StatusInternalServerError,
+		}
+	}
+	ch.Refunded = true
+
+	return nil
+}
+
+func (f *fakeGateway) GetAuthorizations(since time.Time) ([]Authorization, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var auths []Authorization
+	for _, id := range f.order {
+		ch := f.charges[id]
+		if ch.Captured || ch.Refunded || f.created[id].Before(since) {
+			continue
+		}
+
+		auths = append(auths, Authorization{ChargeID: id, CreatedAt: f.created[id]})
+	}
+
+	return auths, nil
+}
+
+func (f *fakeGateway) Decline(source string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.declined[source] = struct{}{}
+}
+
+func (f *fakeGateway) Charge(chargeID string) (FakeCharge, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	ch, ok := f.charges[chargeID]
+	if !ok {
+		return FakeCharge{}, false
+	}
+
+	return *ch, true
+}
+
+func (f *fakeGateway) Charges() []FakeCharge {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	charges := make([]FakeCharge, 0, len(f.order))
+	for _, id := range f.order {
+		charges = append(charges, *f.charges[id])
+	}
+
+	return charges
+}
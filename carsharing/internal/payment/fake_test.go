@@ -0,0 +1,116 @@
+package payment
+
+import (
+	"errors"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFakeGateway(t *testing.T) {
+	const (
+		source   = "tok_visa"
+		declined = "tok_chargeDeclined"
+	)
+
+	var (
+		amount = models.NewMoney(100_00, models.DEFAULT_CURRENCY)
+		double = models.NewMoney(200_00, models.DEFAULT_CURRENCY)
+	)
+
+	g := NewFakeGateway(FakeParams{
+		DeclinedSources: []string{declined},
+		IDPrefix:        FAKE_CHARGE_ID_PREFIX,
+	})
+
+	// invalid price
+	chargeID, err := g.Authorize(source, models.NewMoney(0, models.DEFAULT_CURRENCY))
+	require.Error(t, err)
+	require.Empty(t, chargeID)
+
+	// deterministic ids
+	first, err := g.Authorize(source, amount)
+	require.NoError(t, err)
+	require.Equal(t, FAKE_CHARGE_ID_PREFIX+"1", first)
+
+	second, err := g.Authorize(source, double)
+	require.NoError(t, err)
+	require.Equal(t, FAKE_CHARGE_ID_PREFIX+"2", second)
+
+	// declined source
+	chargeID, err = g.Authorize(declined, amount)
+	requireStatus(t, err, http.StatusBadRequest)
+	require.Empty(t, chargeID)
+
+	g.Decline(source)
+	_, err = g.Authorize(source, amount)
+	requireStatus(t, err, http.StatusBadRequest)
+
+	// capture, the retried one succeeds
+	require.NoError(t, g.Capture(first))
+	require.NoError(t, g.Capture(first))
+	require.Error(t, g.Capture("unknown"))
+
+	// refund, the retried one succeeds
+	require.NoError(t, g.Refund(first))
+	require.NoError(t, g.Refund(first))
+	require.Error(t, g.Refund("unknown"))
+
+	ch, ok := g.Charge(first)
+	require.True(t, ok)
+	require.True(t, ch.Captured)
+	require.True(t, ch.Refunded)
+
+	// refunded authorization can not be captured
+	require.NoError(t, g.Refund(second))
+	require.Error(t, g.Capture(second))
+
+	require.Equal(t, []FakeCharge{
+		{ID: first, Source: source, Amount: amount, Captured: true, Refunded: true},
+		{ID: second, Source: source, Amount: double, Refunded: true},
+	}, g.Charges())
+}
+
+func TestFakeGateway_Latency(t *testing.T) {
+	const latency = time.Millisecond * 50
+
+	g := NewFakeGateway(FakeParams{
+		Latency: latency,
+	})
+
+	start := time.Now()
+	chargeID, err := g.Authorize("tok_visa", models.NewMoney(100, models.DEFAULT_CURRENCY))
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, time.Since(start), latency)
+
+	start = time.Now()
+	require.NoError(t, g.Capture(chargeID))
+	require.GreaterOrEqual(t, time.Since(start), latency)
+
+	start = time.Now()
+	require.NoError(t, g.Refund(chargeID))
+	require.GreaterOrEqual(t, time.Since(start), latency)
+}
+
+func TestFakeGateway_IDsAfterRestart(t *testing.T) {
+	amount := models.NewMoney(100_00, models.DEFAULT_CURRENCY)
+
+	first, err := NewFakeGateway(FakeParams{}).Authorize("tok_visa", amount)
+	require.NoError(t, err)
+
+	// the restarted gateway starts counting again, but with the other prefix
+	restarted, err := NewFakeGateway(FakeParams{}).Authorize("tok_visa", amount)
+	require.NoError(t, err)
+
+	require.NotEqual(t, first, restarted)
+	require.True(t, strings.HasPrefix(restarted, FAKE_CHARGE_ID_PREFIX))
+}
+
+func requireStatus(t *testing.T, err error, status int) {
+	var e *models.Error
+	require.True(t, errors.As(err, &e))
+	require.Equal(t, status, e.Status)
+}
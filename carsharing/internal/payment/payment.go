@@ -1,80 +1,52 @@
 package payment
 
 import (
-	"fmt"
+	"github.com/alserov/rently/carsharing/internal/config"
 	"github.com/alserov/rently/carsharing/internal/models"
-	"github.com/stripe/stripe-go"
-	"github.com/stripe/stripe-go/charge"
-	"github.com/stripe/stripe-go/refund"
-	"net/http"
 	"time"
 )
 
 type Payer interface {
+	// Authorize holds the amount on the source, the charge has to be captured or refunded afterward
+	Authorize(source string, amount models.Money) (string, error)
+	// Capture succeeds for the already captured charge, so the interrupted capture can be retried
+	Capture(chargeID string) error
+	// Refund returns captured amount or releases the held one, it succeeds for the already refunded charge too
 	Refund(chargeID string) error
-	Debit(source string, amount float32) (string, error)
+	// GetAuthorizations returns the held amounts, which are neither captured nor released, authorized since the time
+	GetAuthorizations(since time.Time) ([]Authorization, error)
 }
 
-func NewPayer(apiKey string) Payer {
-	stripe.Key = apiKey
-	return &payer{
-		key: apiKey,
-	}
-}
-
-type payer struct {
-	key string
+// Authorization is the amount held at the provider
+type Authorization struct {
+	ChargeID  string
+	CreatedAt time.Time
 }
 
 type Service interface {
 	Period() time.Duration
 }
 
-func (p payer) Refund(chargeID string) error {
-	params := &stripe.RefundParams{
-		Charge:               stripe.String(chargeID),
-		RefundApplicationFee: stripe.Bool(false),
-	}
-
-	_, err := refund.New(params)
-	if err != nil {
-		return &models.Error{
-			Msg:    fmt.Sprintf("failed to refund: %v", err),
-			Status: http.StatusInternalServerError,
-		}
-	}
-
-	return nil
-}
-
-func (p payer) Debit(source string, amount float32) (string, error) {
-	if amount < 1 {
-		return "", &models.Error{
-			Status: http.StatusInternalServerError,
-			Msg:    fmt.Sprintf("invalid amount: %v", amount),
-		}
-	}
-
-	params := &stripe.ChargeParams{
-		Amount:      stripe.Int64(int64(amount)),
-		Currency:    stripe.String(string(stripe.CurrencyUSD)),
-		Description: stripe.String("debit card balance"),
-	}
+const (
+	PROVIDER_STRIPE = "stripe"
+	PROVIDER_FAKE   = "fake"
+)
 
-	if err := params.SetSource(source); err != nil {
-		return "", &models.Error{
-			Status: http.StatusInternalServerError,
-			Msg:    fmt.Sprintf("failed to set source: %v", err),
-		}
-	}
+const ERR_PAYMENT_DECLINED = "payment declined"
 
-	ch, err := charge.New(params)
-	if err != nil {
-		return "", &models.Error{
-			Status: http.StatusInternalServerError,
-			Msg:    fmt.Sprintf("failed to init charge: %v", err),
+func MustNewPayer(cfg config.Payment) Payer {
+	switch cfg.Provider {
+	case PROVIDER_STRIPE:
+		if cfg.ApiKey == "" {
+			panic("payment api key is not provided")
 		}
+		return NewStripePayer(cfg.ApiKey)
+	case PROVIDER_FAKE:
+		return NewFakeGateway(FakeParams{
+			Latency:         cfg.Fake.Latency,
+			DeclinedSources: cfg.Fake.DeclinedSources,
+		})
+	default:
+		panic("unknown payment provider: " + cfg.Provider)
 	}
-
-	return ch.ID, nil
 }
@@ -1,30 +1,40 @@
 package payment
 
 import (
+	"github.com/alserov/rently/carsharing/internal/config"
+	"github.com/alserov/rently/carsharing/internal/models"
 	"github.com/stretchr/testify/require"
+	"os"
 	"testing"
 )
 
-const api_key = "sk_test_51OU56CDOnc0MdcTNBwddO2cn8NrEebjfuAGjBjj9xSyKmiUO4ajJ1vZ0yBoOsAMq0HjHqCmis2niwoj2EZYCDLOA00lcCUlWxh"
-
+// TestPayer calls the stripe test mode, so it runs only with the test key in the env
 func TestPayer(t *testing.T) {
-	p := NewPayer(api_key)
+	apiKey := os.Getenv(config.PAYMENT_API_KEY_ENV)
+	if apiKey == "" {
+		t.Skipf("%s is not set", config.PAYMENT_API_KEY_ENV)
+	}
+
+	p := NewStripePayer(apiKey)
+
+	const source = "tok_visa"
 
-	const (
-		amount = 100_00
-		source = "tok_visa"
-	)
+	amount := models.NewMoney(100_00, models.DEFAULT_CURRENCY)
 
 	// invalid price
-	chargeID, err := p.Debit(source, 0)
+	chargeID, err := p.Authorize(source, models.NewMoney(0, models.DEFAULT_CURRENCY))
 	require.Error(t, err)
 	require.Empty(t, chargeID)
 
 	// valid price
-	chargeID, err = p.Debit(source, amount)
+	chargeID, err = p.Authorize(source, amount)
 	require.NoError(t, err)
 	require.NotEmpty(t, chargeID)
 
+	// capture
+	err = p.Capture(chargeID)
+	require.NoError(t, err)
+
 	// refund
 	err = p.Refund(chargeID)
 	require.NoError(t, err)
@@ -0,0 +1,134 @@
+package payment
+
+import (
+	"errors"
+	"fmt"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"github.com/stripe/stripe-go"
+	"github.com/stripe/stripe-go/client"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// STRIPE_CHARGE_SUCCEEDED is the status of the authorized charges too, the failed ones hold nothing
+const STRIPE_CHARGE_SUCCEEDED = "succeeded"
+
+func NewStripePayer(apiKey string) Payer {
+	return &stripePayer{
+		api: client.New(apiKey, nil),
+	}
+}
+
+type stripePayer struct {
+	api *client.API
+}
+
+func (p stripePayer) Refund(chargeID string) error {
+	params := &stripe.RefundParams{
+		Charge:               stripe.String(chargeID),
+		RefundApplicationFee: stripe.Bool(false),
+	}
+
+	_, err := p.api.Refunds.New(params)
+	if isStripeErrorCode(err, stripe.ErrorCodeChargeAlreadyRefunded) {
+		return nil
+	}
+	if err != nil {
+		return &models.Error{
+			Msg:    fmt.Sprintf("failed to refund: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return nil
+}
+
+func (p stripePayer) Capture(chargeID string) error {
+	_, err := p.api.Charges.Capture(chargeID, &stripe.CaptureParams{})
+	if isStripeErrorCode(err, stripe.ErrorCodeChargeAlreadyCaptured) {
+		return nil
+	}
+	if err != nil {
+		return &models.Error{
+			Msg:    fmt.Sprintf("failed to capture: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return nil
+}
+
+func (p stripePayer) Authorize(source string, amount models.Money) (string, error) {
+	if !amount.IsPositive() {
+		return "", &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("invalid amount: %v", amount),
+		}
+	}
+
+	params := &stripe.ChargeParams{
+		Amount:      stripe.Int64(amount.Amount),
+		Currency:    stripe.String(strings.ToLower(amount.Currency)),
+		Description: stripe.String("debit card balance"),
+		Capture:     stripe.Bool(false),
+	}
+
+	if err := params.SetSource(source); err != nil {
+		return "", &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to set source: %v", err),
+		}
+	}
+
+	ch, err := p.api.Charges.New(params)
+	var stripeErr *stripe.Error
+	if errors.As(err, &stripeErr) && stripeErr.Type == stripe.ErrorTypeCard {
+		return "", &models.Error{
+			Status: http.StatusBadRequest,
+			Msg:    fmt.Sprintf("%s: %s", ERR_PAYMENT_DECLINED, stripeErr.Msg),
+		}
+	}
+	if err != nil {
+		return "", &models.Error{
+			Status: http.StatusInternalServerError,
+			Msg:    fmt.Sprintf("failed to init charge: %v", err),
+		}
+	}
+
+	return ch.ID, nil
+}
+
+func (p stripePayer) GetAuthorizations(since time.Time) ([]Authorization, error) {
+	params := &stripe.ChargeListParams{
+		CreatedRange: &stripe.RangeQueryParams{GreaterThanOrEqual: since.Unix()},
+	}
+
+	var auths []Authorization
+	i := p.api.Charges.List(params)
+	for i.Next() {
+		ch := i.Charge()
+		// the held charges are succeeded, but not captured
+		if ch.Status != STRIPE_CHARGE_SUCCEEDED || ch.Captured || ch.Refunded {
+			continue
+		}
+
+		auths = append(auths, Authorization{
+			ChargeID:  ch.ID,
+			CreatedAt: time.Unix(ch.Created, 0),
+		})
+	}
+	if err := i.Err(); err != nil {
+		return nil, &models.Error{
+			Msg:    fmt.Sprintf("failed to list charges: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return auths, nil
+}
+
+func isStripeErrorCode(err error, code stripe.ErrorCode) bool {
+	var stripeErr *stripe.Error
+	return errors.As(err, &stripeErr) && stripeErr.Code == code
+}
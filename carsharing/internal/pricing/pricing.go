@@ -0,0 +1,84 @@
+package pricing
+
+import (
+	"github.com/alserov/rently/carsharing/internal/models"
+	"math"
+	"time"
+)
+
+type Request struct {
+	PricePerDay models.Money
+	Category    string
+
+	RentStart time.Time
+	RentEnd   time.Time
+
+	// OneWay is set, when the car is dropped off at the other station, than picked up at
+	OneWay bool
+}
+
+// Hours returns the amount of billed hours, every started hour is billed
+func (r Request) Hours() int {
+	return int(math.Ceil(r.RentEnd.Sub(r.RentStart).Hours()))
+}
+
+// hourRate returns the price of a single rent hour in minor units
+func (r Request) hourRate() float64 {
+	return float64(r.PricePerDay.Amount) / 24
+}
+
+// Rule adjusts rent price, all the amounts are in minor units
+type Rule interface {
+	Name() string
+	// Adjust returns the amount added to the price, negative amount is a discount
+	Adjust(req Request, price int64) int64
+}
+
+type Engine interface {
+	Quote(req Request) models.PriceQuote
+}
+
+// NewEngine returns the engine, that bills every rent hour by the car hour rate and applies the rules in the provided order
+func NewEngine(rules ...Rule) Engine {
+	return &engine{
+		rules: rules,
+	}
+}
+
+type engine struct {
+	rules []Rule
+}
+
+func (e *engine) Quote(req Request) models.PriceQuote {
+	hours := req.Hours()
+
+	base := int64(math.Round(req.hourRate() * float64(hours)))
+
+	quote := models.PriceQuote{
+		Hours:     hours,
+		BasePrice: e.money(req, base),
+	}
+
+	price := base
+	for _, rule := range e.rules {
+		adjustment := rule.Adjust(req, price)
+		if adjustment == 0 {
+			continue
+		}
+
+		price += adjustment
+		quote.Adjustments = append(quote.Adjustments, models.PriceAdjustment{
+			Rule:   rule.Name(),
+			Amount: e.money(req, adjustment),
+		})
+	}
+
+	quote.Price = e.money(req, price)
+
+	return quote
+}
+
+// money returns the amount in the currency of the car price
+func (e *engine) money(req Request, amount int64) models.Money {
+	return models.NewMoney(amount, req.PricePerDay.Currency)
+}
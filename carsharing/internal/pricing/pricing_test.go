@@ -0,0 +1,136 @@
+package pricing
+
+import (
+	"github.com/alserov/rently/carsharing/internal/config"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+// wednesday is a winter weekday, so none of the calendar rules match it
+var wednesday = time.Date(2025, time.January, 8, 0, 0, 0, 0, time.UTC)
+
+func TestEngine_Quote(t *testing.T) {
+	e := NewEngine()
+
+	// every started hour is billed, so 36.5 hours are billed as 37
+	quote := e.Quote(Request{
+		PricePerDay: models.NewMoney(240_00, models.DEFAULT_CURRENCY),
+		RentStart:   wednesday,
+		RentEnd:     wednesday.Add(time.Hour*36 + time.Minute*30),
+	})
+	require.Equal(t, models.PriceQuote{
+		Hours:     37,
+		BasePrice: models.NewMoney(370_00, models.DEFAULT_CURRENCY),
+		Price:     models.NewMoney(370_00, models.DEFAULT_CURRENCY),
+	}, quote)
+
+	quote = e.Quote(Request{
+		PricePerDay: models.NewMoney(100_00, "EUR"),
+		RentStart:   wednesday,
+		RentEnd:     wednesday.Add(time.Hour * 36),
+	})
+	require.Equal(t, models.NewMoney(150_00, "EUR"), quote.Price)
+}
+
+func TestEngine_QuoteWithRules(t *testing.T) {
+	e := NewEngine(
+		CategorySurcharge{Surcharges: map[string]float64{"premium": 0.5}},
+		LongRentDiscount{Tiers: []DiscountTier{{MinDays: 1, Discount: 0.1}}},
+	)
+
+	quote := e.Quote(Request{
+		PricePerDay: models.NewMoney(100_00, models.DEFAULT_CURRENCY),
+		Category:    "Premium",
+		RentStart:   wednesday,
+		RentEnd:     wednesday.Add(time.Hour * 48),
+	})
+	require.Equal(t, models.PriceQuote{
+		Hours:     48,
+		BasePrice: models.NewMoney(200_00, models.DEFAULT_CURRENCY),
+		Price:     models.NewMoney(270_00, models.DEFAULT_CURRENCY),
+		Adjustments: []models.PriceAdjustment{
+			{Rule: "category_surcharge", Amount: models.NewMoney(100_00, models.DEFAULT_CURRENCY)},
+			// the discount is applied to the price with the surcharge
+			{Rule: "long_rent_discount", Amount: models.NewMoney(-30_00, models.DEFAULT_CURRENCY)},
+		},
+	}, quote)
+}
+
+func TestRules(t *testing.T) {
+	saturday := time.Date(2025, time.January, 11, 0, 0, 0, 0, time.UTC)
+	summer := time.Date(2025, time.July, 2, 0, 0, 0, 0, time.UTC)
+
+	// 10 cents per hour
+	pricePerDay := models.NewMoney(240, models.DEFAULT_CURRENCY)
+
+	tests := []struct {
+		name     string
+		rule     Rule
+		start    time.Time
+		hours    int
+		expected int64
+	}{
+		{name: "short rent", rule: ShortRentMarkup{MaxHours: 24, Markup: 0.2}, start: wednesday, hours: 10, expected: 20},
+		{name: "short rent, long enough", rule: ShortRentMarkup{MaxHours: 24, Markup: 0.2}, start: wednesday, hours: 24},
+		{name: "weekend", rule: WeekendMultiplier{Multiplier: 1.5}, start: saturday, hours: 48, expected: 240},
+		{name: "weekend, partially", rule: WeekendMultiplier{Multiplier: 1.5}, start: saturday.Add(time.Hour * 40), hours: 16, expected: 40},
+		{name: "weekday", rule: WeekendMultiplier{Multiplier: 1.5}, start: wednesday, hours: 48},
+		{name: "season", rule: SeasonalMultiplier{From: time.June, To: time.August, Multiplier: 2}, start: summer, hours: 24, expected: 240},
+		{name: "season over new year", rule: SeasonalMultiplier{From: time.December, To: time.February, Multiplier: 2}, start: wednesday, hours: 24, expected: 240},
+		{name: "out of season", rule: SeasonalMultiplier{From: time.June, To: time.August, Multiplier: 2}, start: wednesday, hours: 24},
+		{name: "category", rule: CategorySurcharge{Surcharges: map[string]float64{"premium": 0.5}}, start: wednesday, hours: 24, expected: 120},
+		{name: "long rent", rule: LongRentDiscount{Tiers: []DiscountTier{{MinDays: 7, Discount: 0.1}, {MinDays: 30, Discount: 0.2}}}, start: wednesday, hours: 24 * 7, expected: -168},
+		{name: "longest tier", rule: LongRentDiscount{Tiers: []DiscountTier{{MinDays: 30, Discount: 0.2}, {MinDays: 7, Discount: 0.1}}}, start: wednesday, hours: 24 * 30, expected: -1440},
+		{name: "short for discount", rule: LongRentDiscount{Tiers: []DiscountTier{{MinDays: 7, Discount: 0.1}}}, start: wednesday, hours: 24 * 6},
+	}
+
+	for _, tc := range tests {
+		req := Request{
+			PricePerDay: pricePerDay,
+			Category:    "premium",
+			RentStart:   tc.start,
+			RentEnd:     tc.start.Add(time.Hour * time.Duration(tc.hours)),
+		}
+
+		require.Equal(t, tc.expected, tc.rule.Adjust(req, int64(tc.hours)*10), tc.name)
+	}
+}
+
+func TestRulesFromConfig(t *testing.T) {
+	var cfg config.Pricing
+	require.Empty(t, RulesFromConfig(cfg))
+
+	cfg.ShortRent.MaxHours = 24
+	cfg.ShortRent.Markup = 0.2
+	cfg.WeekendMultiplier = 1.2
+	cfg.CategorySurcharges = map[string]float64{"premium": 0.1}
+
+	rules := RulesFromConfig(cfg)
+	require.Len(t, rules, 3)
+	require.Equal(t, "short_rent_markup", rules[0].Name())
+	require.Equal(t, "weekend", rules[1].Name())
+	require.Equal(t, "category_surcharge", rules[2].Name())
+}
+
+func TestOneWayFee(t *testing.T) {
+	e := NewEngine(
+		LongRentDiscount{Tiers: []DiscountTier{{MinDays: 1, Discount: 0.1}}},
+		OneWayFee{DayShare: 0.5},
+	)
+
+	req := Request{
+		PricePerDay: models.NewMoney(100_00, models.DEFAULT_CURRENCY),
+		RentStart:   wednesday,
+		RentEnd:     wednesday.Add(time.Hour * 48),
+	}
+
+	// the car is returned to the station it was picked up at
+	require.Equal(t, models.NewMoney(180_00, models.DEFAULT_CURRENCY), e.Quote(req).Price)
+
+	req.OneWay = true
+	quote := e.Quote(req)
+	require.Equal(t, models.NewMoney(230_00, models.DEFAULT_CURRENCY), quote.Price)
+	require.Equal(t, models.PriceAdjustment{Rule: "one_way_fee", Amount: models.NewMoney(50_00, models.DEFAULT_CURRENCY)}, quote.Adjustments[1])
+}
@@ -0,0 +1,189 @@
+package pricing
+
+import (
+	"github.com/alserov/rently/carsharing/internal/config"
+	"math"
+	"strings"
+	"time"
+)
+
+// RulesFromConfig builds the rules, which are set in config, in the order:
+// short rent markup, weekend, seasons, category surcharge, long rent discount, one way fee
+func RulesFromConfig(cfg config.Pricing) []Rule {
+	var rules []Rule
+
+	if cfg.ShortRent.MaxHours > 0 && cfg.ShortRent.Markup != 0 {
+		rules = append(rules, ShortRentMarkup{MaxHours: cfg.ShortRent.MaxHours, Markup: cfg.ShortRent.Markup})
+	}
+
+	if cfg.WeekendMultiplier != 0 {
+		rules = append(rules, WeekendMultiplier{Multiplier: cfg.WeekendMultiplier})
+	}
+
+	for _, s := range cfg.Seasons {
+		rules = append(rules, SeasonalMultiplier{
+			Season:     s.Name,
+			From:       time.Month(s.From),
+			To:         time.Month(s.To),
+			Multiplier: s.Multiplier,
+		})
+	}
+
+	if len(cfg.CategorySurcharges) > 0 {
+		rules = append(rules, CategorySurcharge{Surcharges: cfg.CategorySurcharges})
+	}
+
+	if len(cfg.LongRentDiscounts) > 0 {
+		var tiers []DiscountTier
+		for _, d := range cfg.LongRentDiscounts {
+			tiers = append(tiers, DiscountTier{MinDays: d.MinDays, Discount: d.Discount})
+		}
+		rules = append(rules, LongRentDiscount{Tiers: tiers})
+	}
+
+	// the fee is applied after the discounts, so it does not depend on the rent length
+	if cfg.OneWayFee != 0 {
+		rules = append(rules, OneWayFee{DayShare: cfg.OneWayFee})
+	}
+
+	return rules
+}
+
+// ShortRentMarkup makes hour rate of rents shorter than MaxHours more expensive
+type ShortRentMarkup struct {
+	MaxHours int
+	Markup   float64
+}
+
+func (r ShortRentMarkup) Name() string {
+	return "short_rent_markup"
+}
+
+func (r ShortRentMarkup) Adjust(req Request, price int64) int64 {
+	if req.Hours() >= r.MaxHours {
+		return 0
+	}
+
+	return round(float64(price) * r.Markup)
+}
+
+// WeekendMultiplier applies the multiplier to the rent hours, which are on Saturday or Sunday
+type WeekendMultiplier struct {
+	Multiplier float64
+}
+
+func (r WeekendMultiplier) Name() string {
+	return "weekend"
+}
+
+func (r WeekendMultiplier) Adjust(req Request, _ int64) int64 {
+	hours := countHours(req, func(t time.Time) bool {
+		return t.Weekday() == time.Saturday || t.Weekday() == time.Sunday
+	})
+
+	return round(req.hourRate() * float64(hours) * (r.Multiplier - 1))
+}
+
+// SeasonalMultiplier applies the multiplier to the rent hours from the beginning of From month
+// till the end of To month, the season may pass over the new year
+type SeasonalMultiplier struct {
+	Season     string
+	From       time.Month
+	To         time.Month
+	Multiplier float64
+}
+
+func (r SeasonalMultiplier) Name() string {
+	return "season:" + r.Season
+}
+
+func (r SeasonalMultiplier) Adjust(req Request, _ int64) int64 {
+	hours := countHours(req, func(t time.Time) bool {
+		if r.From <= r.To {
+			return t.Month() >= r.From && t.Month() <= r.To
+		}
+		return t.Month() >= r.From || t.Month() <= r.To
+	})
+
+	return round(req.hourRate() * float64(hours) * (r.Multiplier - 1))
+}
+
+type DiscountTier struct {
+	MinDays  int
+	Discount float64
+}
+
+// LongRentDiscount applies the discount of the longest tier the rent fits in
+type LongRentDiscount struct {
+	Tiers []DiscountTier
+}
+
+func (r LongRentDiscount) Name() string {
+	return "long_rent_discount"
+}
+
+func (r LongRentDiscount) Adjust(req Request, price int64) int64 {
+	var (
+		discount float64
+		minDays  int
+	)
+	for _, tier := range r.Tiers {
+		if req.Hours() >= tier.MinDays*24 && tier.MinDays >= minDays {
+			discount, minDays = tier.Discount, tier.MinDays
+		}
+	}
+
+	return -round(float64(price) * discount)
+}
+
+// CategorySurcharge applies the surcharge of the car category, categories are case-insensitive
+type CategorySurcharge struct {
+	Surcharges map[string]float64
+}
+
+func (r CategorySurcharge) Name() string {
+	return "category_surcharge"
+}
+
+func (r CategorySurcharge) Adjust(req Request, price int64) int64 {
+	for category, surcharge := range r.Surcharges {
+		if strings.EqualFold(category, req.Category) {
+			return round(float64(price) * surcharge)
+		}
+	}
+
+	return 0
+}
+
+// OneWayFee charges the share of the day price for returning the car to its station
+type OneWayFee struct {
+	DayShare float64
+}
+
+func (r OneWayFee) Name() string {
+	return "one_way_fee"
+}
+
+func (r OneWayFee) Adjust(req Request, _ int64) int64 {
+	if !req.OneWay {
+		return 0
+	}
+
+	return round(float64(req.PricePerDay.Amount) * r.DayShare)
+}
+
+// countHours returns the amount of billed rent hours, which start time matches the condition
+func countHours(req Request, match func(t time.Time) bool) int {
+	var count int
+	for i := 0; i < req.Hours(); i++ {
+		if match(req.RentStart.Add(time.Hour * time.Duration(i))) {
+			count++
+		}
+	}
+
+	return count
+}
+
+func round(amount float64) int64 {
+	return int64(math.Round(amount))
+}
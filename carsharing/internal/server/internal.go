@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"github.com/alserov/rently/carsharing/internal/models"
+	"github.com/alserov/rently/proto/gen/carsharing"
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"log/slog"
 	"net/http"
@@ -13,6 +15,15 @@ import (
 
 const internalError = "internal error"
 
+// IDEMPOTENCY_KEY_MD is a metadata key, the gateway passes client's Idempotency-Key header with
+const IDEMPOTENCY_KEY_MD = "idempotency-key"
+
+// ACTOR_MD is a metadata key, the gateway passes the uuid of the authorized admin with
+const ACTOR_MD = "actor"
+
+// IMAGE_SIZE_MD is a trailer key, the size of the image is sent with, when the requested range is not satisfiable
+const IMAGE_SIZE_MD = "image-size"
+
 func (s *server) handleError(err error) error {
 	e := &models.Error{}
 	ok := errors.As(err, &e)
@@ -26,6 +37,16 @@ func (s *server) handleError(err error) error {
 			return status.Error(codes.InvalidArgument, e.Msg)
 		case http.StatusNotFound:
 			return status.Error(codes.NotFound, e.Msg)
+		case http.StatusUnauthorized:
+			return status.Error(codes.Unauthenticated, e.Msg)
+		case http.StatusForbidden:
+			return status.Error(codes.PermissionDenied, e.Msg)
+		case http.StatusConflict:
+			return status.Error(codes.FailedPrecondition, e.Msg)
+		case http.StatusRequestEntityTooLarge:
+			return status.Error(codes.ResourceExhausted, e.Msg)
+		case http.StatusRequestedRangeNotSatisfiable:
+			return status.Error(codes.OutOfRange, e.Msg)
 		}
 	}
 
@@ -33,6 +54,56 @@ func (s *server) handleError(err error) error {
 	return e
 }
 
+// chunkReader reads the file from the chunks of the upload stream
+type chunkReader struct {
+	stream carsharing.Cars_UploadCarImageServer
+	chunk  []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+
+		if req.GetInfo() != nil {
+			return 0, status.Error(codes.InvalidArgument, "image info is expected in the first message only")
+		}
+		r.chunk = req.GetChunk()
+	}
+
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	return n, nil
+}
+
 func (s *server) ctxWithID(ctx context.Context) context.Context {
 	return context.WithValue(ctx, models.ID, uuid.New().String())
 }
+
+func (s *server) ctxWithIdempotencyKey(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	if keys := md.Get(IDEMPOTENCY_KEY_MD); len(keys) > 0 && keys[0] != "" {
+		return context.WithValue(ctx, models.IDEMPOTENCY_KEY, keys[0])
+	}
+
+	return ctx
+}
+
+func (s *server) ctxWithActor(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	if actors := md.Get(ACTOR_MD); len(actors) > 0 && actors[0] != "" {
+		return context.WithValue(ctx, models.ACTOR, actors[0])
+	}
+
+	return ctx
+}
@@ -2,20 +2,22 @@ package server
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
-	"github.com/alserov/rently/carsharing/internal/cache"
 	"github.com/alserov/rently/carsharing/internal/log"
 	"github.com/alserov/rently/carsharing/internal/metrics"
 	"github.com/alserov/rently/carsharing/internal/models"
 	"github.com/alserov/rently/carsharing/internal/service"
 	"github.com/alserov/rently/carsharing/internal/utils/convertation"
 	"github.com/alserov/rently/carsharing/internal/utils/validation"
+	"io"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/alserov/rently/proto/gen/carsharing"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"log/slog"
 )
@@ -24,8 +26,6 @@ type Params struct {
 	Service service.Service
 
 	Metrics metrics.Metrics
-
-	Cache cache.Cache
 }
 
 func RegisterGRPCServer(gRPCServer *grpc.Server, server Params) {
@@ -36,7 +36,6 @@ func newServer(p Params) carsharing.CarsServer {
 	return &server{
 		log:     log.GetLogger(),
 		service: p.Service,
-		cache:   p.Cache,
 		metrics: p.Metrics,
 		valid:   validation.NewValidator(),
 		convert: convertation.NewServerConverter(),
@@ -48,8 +47,6 @@ type server struct {
 
 	log log.Logger
 
-	cache cache.Cache
-
 	metrics metrics.Metrics
 
 	service service.Service
@@ -62,8 +59,13 @@ type server struct {
 const (
 	OP_CREATE_CAR         = "CREATE CAR"
 	OP_GET_CARS_BY_PARAMS = "GET CARS BY PARAMS"
+	OP_SEARCH_CARS        = "SEARCH CARS"
+	OP_UPLOAD_CAR_IMAGE   = "UPLOAD CAR IMAGE"
 )
 
+// IMAGE_CHUNK_SIZE keeps the messages of the image streams far below the default 4MB gRPC limit
+const IMAGE_CHUNK_SIZE = 64 << 10
+
 func (s *server) GetRentStartingOnDate(ctx context.Context, req *carsharing.GetRentStartingOnDateReq) (*carsharing.GetRentStartingOnDateRes, error) {
 	ctx = s.ctxWithID(ctx)
 
@@ -86,7 +88,7 @@ func (s *server) GetImage(ctx context.Context, req *carsharing.GetImageReq) (*ca
 		return nil, err
 	}
 
-	image, err := s.service.GetImage(ctx, fmt.Sprintf("%s/%s", req.Bucket, req.Id))
+	image, err := s.service.GetImage(ctx, fmt.Sprintf("%s/%s", req.Bucket, req.Id), req.Variant)
 	if err != nil {
 		return nil, s.handleError(err)
 	}
@@ -94,8 +96,162 @@ func (s *server) GetImage(ctx context.Context, req *carsharing.GetImageReq) (*ca
 	return s.convert.GetImageResToPb(image), nil
 }
 
-func (s *server) CreateCar(ctx context.Context, req *carsharing.CreateCarReq) (*emptypb.Empty, error) {
+func (s *server) GetImageStream(req *carsharing.GetImageStreamReq, stream carsharing.Cars_GetImageStreamServer) error {
+	ctx := s.ctxWithID(stream.Context())
+
+	if err := s.valid.ValidateGetImageStreamReq(req); err != nil {
+		return err
+	}
+
+	image, err := s.service.GetImageStream(ctx, fmt.Sprintf("%s/%s", req.Bucket, req.Id), req.Variant, req.Offset, req.Length)
+	if err != nil {
+		if image.Size > 0 {
+			stream.SetTrailer(metadata.Pairs(IMAGE_SIZE_MD, strconv.FormatInt(image.Size, 10)))
+		}
+		return s.handleError(err)
+	}
+	defer image.File.Close()
+
+	chunk := &carsharing.ImageChunk{
+		ContentType: image.ContentType,
+		Size:        image.Size,
+		Offset:      image.Offset,
+	}
+
+	buf := make([]byte, IMAGE_CHUNK_SIZE)
+	for {
+		n, err := io.ReadFull(image.File, buf)
+		if n > 0 || chunk.ContentType != "" {
+			chunk.Data = buf[:n]
+			if err := stream.Send(chunk); err != nil {
+				return err
+			}
+			chunk = &carsharing.ImageChunk{}
+		}
+
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil
+		}
+		if err != nil {
+			return s.handleError(&models.Error{
+				Msg:    fmt.Sprintf("failed to read image: %v", err),
+				Status: http.StatusInternalServerError,
+			})
+		}
+	}
+}
+
+func (s *server) UploadCarImage(stream carsharing.Cars_UploadCarImageServer) error {
+	ctx := s.ctxWithID(stream.Context())
+	start := time.Now()
+
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	info := first.GetInfo()
+	if err = s.valid.ValidateUploadCarImageInfo(info); err != nil {
+		return err
+	}
+
+	id, err := s.service.UploadCarImage(ctx, info.CarUUID, info.Main, &chunkReader{stream: stream})
+	if err != nil {
+		return s.handleError(err)
+	}
+
+	s.metrics.ResponseTime(time.Since(start), OP_UPLOAD_CAR_IMAGE, http.MethodPost)
+
+	return stream.SendAndClose(&carsharing.UploadCarImageRes{Id: id})
+}
+
+func (s *server) GetCarImages(ctx context.Context, req *carsharing.GetCarImagesReq) (*carsharing.GetCarImagesRes, error) {
+	ctx = s.ctxWithID(ctx)
+
+	if err := s.valid.ValidateGetCarImagesReq(req); err != nil {
+		return nil, err
+	}
+
+	images, err := s.service.GetCarImages(ctx, req.CarUUID)
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return s.convert.CarImagesToPb(images), nil
+}
+
+func (s *server) DeleteCarImage(ctx context.Context, req *carsharing.DeleteCarImageReq) (*emptypb.Empty, error) {
+	ctx = s.ctxWithID(ctx)
+
+	if err := s.valid.ValidateDeleteCarImageReq(req); err != nil {
+		return nil, err
+	}
+
+	if err := s.service.DeleteCarImage(ctx, req.CarUUID, req.Id); err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) ReorderCarImages(ctx context.Context, req *carsharing.ReorderCarImagesReq) (*emptypb.Empty, error) {
+	ctx = s.ctxWithID(ctx)
+
+	if err := s.valid.ValidateReorderCarImagesReq(req); err != nil {
+		return nil, err
+	}
+
+	if err := s.service.ReorderCarImages(ctx, req.CarUUID, req.Ids); err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) SetMainCarImage(ctx context.Context, req *carsharing.SetMainCarImageReq) (*emptypb.Empty, error) {
+	ctx = s.ctxWithID(ctx)
+
+	if err := s.valid.ValidateSetMainCarImageReq(req); err != nil {
+		return nil, err
+	}
+
+	if err := s.service.SetMainCarImage(ctx, req.CarUUID, req.Id); err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) CreateStation(ctx context.Context, req *carsharing.CreateStationReq) (*carsharing.CreateStationRes, error) {
+	ctx = s.ctxWithID(ctx)
+	if err := s.valid.ValidateCreateStationReq(req); err != nil {
+		return nil, err
+	}
+
+	id, err := s.service.CreateStation(ctx, s.convert.StationToService(req.Station))
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return &carsharing.CreateStationRes{UUID: id}, nil
+}
+
+func (s *server) GetStations(ctx context.Context, req *carsharing.GetStationsReq) (*carsharing.GetStationsRes, error) {
 	ctx = s.ctxWithID(ctx)
+	if err := s.valid.ValidateGetStationsReq(req); err != nil {
+		return nil, err
+	}
+
+	stations, err := s.service.GetStations(ctx, s.convert.AreaToService(req.Near))
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return s.convert.StationsToPb(stations), nil
+}
+
+func (s *server) CreateCar(ctx context.Context, req *carsharing.CreateCarReq) (*emptypb.Empty, error) {
+	ctx = s.ctxWithActor(s.ctxWithID(ctx))
 	start := time.Now()
 
 	if err := s.valid.ValidateCreateCarReq(req); err != nil {
@@ -111,8 +267,34 @@ func (s *server) CreateCar(ctx context.Context, req *carsharing.CreateCarReq) (*
 	return &emptypb.Empty{}, nil
 }
 
-func (s *server) DeleteCar(ctx context.Context, req *carsharing.DeleteCarReq) (*emptypb.Empty, error) {
+func (s *server) CreatePromoCode(ctx context.Context, req *carsharing.CreatePromoCodeReq) (*emptypb.Empty, error) {
 	ctx = s.ctxWithID(ctx)
+	if err := s.valid.ValidateCreatePromoCodeReq(req); err != nil {
+		return nil, err
+	}
+
+	if err := s.service.CreatePromoCode(ctx, s.convert.CreatePromoCodeReqToService(req)); err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) ExpirePromoCode(ctx context.Context, req *carsharing.ExpirePromoCodeReq) (*emptypb.Empty, error) {
+	ctx = s.ctxWithID(ctx)
+	if err := s.valid.ValidateExpirePromoCodeReq(req); err != nil {
+		return nil, err
+	}
+
+	if err := s.service.ExpirePromoCode(ctx, req.Code); err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) DeleteCar(ctx context.Context, req *carsharing.DeleteCarReq) (*emptypb.Empty, error) {
+	ctx = s.ctxWithActor(s.ctxWithID(ctx))
 	if err := s.valid.ValidateDeleteCarReq(req); err != nil {
 		return nil, err
 	}
@@ -124,8 +306,76 @@ func (s *server) DeleteCar(ctx context.Context, req *carsharing.DeleteCarReq) (*
 	return &emptypb.Empty{}, nil
 }
 
-func (s *server) UpdateCarPrice(ctx context.Context, req *carsharing.UpdateCarPriceReq) (*emptypb.Empty, error) {
+func (s *server) UpdateCar(ctx context.Context, req *carsharing.UpdateCarReq) (*emptypb.Empty, error) {
+	ctx = s.ctxWithActor(s.ctxWithID(ctx))
+	if err := s.valid.ValidateUpdateCarReq(req); err != nil {
+		return nil, err
+	}
+
+	if err := s.service.UpdateCar(ctx, s.convert.UpdateCarReqToService(req)); err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) GetCarChanges(ctx context.Context, req *carsharing.GetCarChangesReq) (*carsharing.GetCarChangesRes, error) {
+	ctx = s.ctxWithID(ctx)
+	if err := s.valid.ValidateGetCarChangesReq(req); err != nil {
+		return nil, err
+	}
+
+	changes, err := s.service.GetCarChanges(ctx, req.CarUUID)
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return s.convert.CarChangesToPb(changes), nil
+}
+
+func (s *server) ScheduleMaintenance(ctx context.Context, req *carsharing.ScheduleMaintenanceReq) (*carsharing.ScheduleMaintenanceRes, error) {
+	ctx = s.ctxWithActor(s.ctxWithID(ctx))
+	if err := s.valid.ValidateScheduleMaintenanceReq(req); err != nil {
+		return nil, err
+	}
+
+	res, err := s.service.ScheduleMaintenance(ctx, s.convert.ScheduleMaintenanceReqToService(req))
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return s.convert.ScheduleMaintenanceResToPb(res), nil
+}
+
+func (s *server) CloseMaintenance(ctx context.Context, req *carsharing.CloseMaintenanceReq) (*emptypb.Empty, error) {
 	ctx = s.ctxWithID(ctx)
+	if err := s.valid.ValidateCloseMaintenanceReq(req); err != nil {
+		return nil, err
+	}
+
+	if err := s.service.CloseMaintenance(ctx, s.convert.CloseMaintenanceReqToService(req)); err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) GetMaintenances(ctx context.Context, req *carsharing.GetMaintenancesReq) (*carsharing.GetMaintenancesRes, error) {
+	ctx = s.ctxWithID(ctx)
+	if err := s.valid.ValidateGetMaintenancesReq(req); err != nil {
+		return nil, err
+	}
+
+	res, err := s.service.GetMaintenances(ctx, req.CarUUID)
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return s.convert.MaintenancesToPb(res), nil
+}
+
+func (s *server) UpdateCarPrice(ctx context.Context, req *carsharing.UpdateCarPriceReq) (*emptypb.Empty, error) {
+	ctx = s.ctxWithActor(s.ctxWithID(ctx))
 	if err := s.valid.ValidateUpdateCarPriceReq(req); err != nil {
 		return nil, err
 	}
@@ -138,7 +388,7 @@ func (s *server) UpdateCarPrice(ctx context.Context, req *carsharing.UpdateCarPr
 }
 
 func (s *server) CreateRent(ctx context.Context, req *carsharing.CreateRentReq) (*carsharing.CreateRentRes, error) {
-	ctx = s.ctxWithID(ctx)
+	ctx = s.ctxWithIdempotencyKey(s.ctxWithID(ctx))
 
 	s.log.Debug("received create rent request", slog.String("id", ctx.Value(models.ID).(string)))
 
@@ -155,7 +405,7 @@ func (s *server) CreateRent(ctx context.Context, req *carsharing.CreateRentReq)
 }
 
 func (s *server) CancelRent(ctx context.Context, req *carsharing.CancelRentReq) (*emptypb.Empty, error) {
-	ctx = s.ctxWithID(ctx)
+	ctx = s.ctxWithIdempotencyKey(s.ctxWithID(ctx))
 	if err := s.valid.ValidateCancelRentReq(req); err != nil {
 		return nil, err
 	}
@@ -167,6 +417,45 @@ func (s *server) CancelRent(ctx context.Context, req *carsharing.CancelRentReq)
 	return &emptypb.Empty{}, nil
 }
 
+func (s *server) StartRent(ctx context.Context, req *carsharing.StartRentReq) (*emptypb.Empty, error) {
+	ctx = s.ctxWithID(ctx)
+	if err := s.valid.ValidateStartRentReq(req); err != nil {
+		return nil, err
+	}
+
+	if err := s.service.StartRent(ctx, req.RentUUID); err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) CompleteRent(ctx context.Context, req *carsharing.CompleteRentReq) (*emptypb.Empty, error) {
+	ctx = s.ctxWithID(ctx)
+	if err := s.valid.ValidateCompleteRentReq(req); err != nil {
+		return nil, err
+	}
+
+	if err := s.service.CompleteRent(ctx, req.RentUUID); err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) MarkNoShow(ctx context.Context, req *carsharing.MarkNoShowReq) (*emptypb.Empty, error) {
+	ctx = s.ctxWithID(ctx)
+	if err := s.valid.ValidateMarkNoShowReq(req); err != nil {
+		return nil, err
+	}
+
+	if err := s.service.MarkNoShow(ctx, req.RentUUID); err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 func (s *server) CheckRent(ctx context.Context, req *carsharing.CheckRentReq) (*carsharing.CheckRentRes, error) {
 	ctx = s.ctxWithID(ctx)
 	if err := s.valid.ValidateCheckRentReq(req); err != nil {
@@ -181,13 +470,27 @@ func (s *server) CheckRent(ctx context.Context, req *carsharing.CheckRentReq) (*
 	return s.convert.CheckRentToPb(rent), nil
 }
 
+func (s *server) QuotePrice(ctx context.Context, req *carsharing.QuotePriceReq) (*carsharing.QuotePriceRes, error) {
+	ctx = s.ctxWithID(ctx)
+	if err := s.valid.ValidateQuotePriceReq(req); err != nil {
+		return nil, err
+	}
+
+	quote, err := s.service.QuotePrice(ctx, s.convert.QuotePriceReqToService(req))
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return s.convert.PriceQuoteToPb(quote), nil
+}
+
 func (s *server) GetAvailableCars(ctx context.Context, req *carsharing.GetAvailableCarsReq) (*carsharing.GetCarsRes, error) {
 	ctx = s.ctxWithID(ctx)
 	if err := s.valid.ValidateGetAvailableCarsReq(req); err != nil {
 		return nil, err
 	}
 
-	cars, err := s.service.GetAvailableCars(ctx, s.convert.GetAvailableCarsReqToService(req))
+	cars, err := s.service.GetAvailableCars(ctx, s.convert.GetAvailableCarsReqToService(req), s.convert.AvailableCarsParamsToService(req), s.convert.PageToService(req.Page))
 	if err != nil {
 		return nil, s.handleError(err)
 	}
@@ -195,29 +498,35 @@ func (s *server) GetAvailableCars(ctx context.Context, req *carsharing.GetAvaila
 	return s.convert.CarsToPb(cars), nil
 }
 
-func (s *server) GetCarsByParams(ctx context.Context, req *carsharing.GetCarsByParamsReq) (*carsharing.GetCarsRes, error) {
+func (s *server) SearchCars(ctx context.Context, req *carsharing.SearchCarsReq) (*carsharing.SearchCarsRes, error) {
 	ctx = s.ctxWithID(ctx)
 	start := time.Now()
 
-	if err := s.valid.ValidateGetCarsByParamsReq(req); err != nil {
+	if err := s.valid.ValidateSearchCarsReq(req); err != nil {
 		return nil, err
 	}
 
-	marshaledValue, err := json.Marshal(req)
-	if err == nil {
-		var res []models.CarMainInfo
-		if err = s.cache.Get(ctx, string(marshaledValue), &res); err == nil {
-			return s.convert.CarsToPb(res), nil
-		}
-	}
-
-	cars, err := s.service.GetCarsByParams(ctx, s.convert.GetCarsByParamsReqToService(req))
+	res, err := s.service.SearchCars(ctx, s.convert.SearchCarsReqToService(req), s.convert.PageToService(req.Page))
 	if err != nil {
 		return nil, s.handleError(err)
 	}
 
-	if err = s.cache.Set(ctx, string(marshaledValue), cars, time.Hour*12); err != nil {
-		s.log.Error("failed to cache value", slog.String("error", err.Error()))
+	s.metrics.ResponseTime(time.Since(start), OP_SEARCH_CARS, http.MethodGet)
+
+	return s.convert.SearchCarsResToPb(res), nil
+}
+
+func (s *server) GetCarsByParams(ctx context.Context, req *carsharing.GetCarsByParamsReq) (*carsharing.GetCarsRes, error) {
+	ctx = s.ctxWithID(ctx)
+	start := time.Now()
+
+	if err := s.valid.ValidateGetCarsByParamsReq(req); err != nil {
+		return nil, err
+	}
+
+	cars, err := s.service.GetCarsByParams(ctx, s.convert.GetCarsByParamsReqToService(req), s.convert.PageToService(req.Page))
+	if err != nil {
+		return nil, s.handleError(err)
 	}
 
 	s.metrics.ResponseTime(time.Since(start), OP_GET_CARS_BY_PARAMS, http.MethodGet)
@@ -231,19 +540,10 @@ func (s *server) GetCarByUUID(ctx context.Context, req *carsharing.GetCarByUUIDR
 		return nil, err
 	}
 
-	var car models.Car
-	if err := s.cache.Get(ctx, req.UUID, car); err != nil {
-		return s.convert.CarToPb(car), nil
-	}
-
 	car, err := s.service.GetCarByUUID(ctx, req.UUID)
 	if err != nil {
 		return nil, s.handleError(err)
 	}
 
-	if err = s.cache.Set(ctx, req.UUID, car, time.Hour*12); err != nil {
-		s.log.Error("failed to set cache", slog.String("error", err.Error()))
-	}
-
 	return s.convert.CarToPb(car), nil
 }
@@ -0,0 +1,253 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/alserov/rently/carsharing/internal/cache"
+	"github.com/alserov/rently/carsharing/internal/log"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"golang.org/x/sync/singleflight"
+	"io"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// CACHE_KEY_VERSION should be changed, when the cached models are changed incompatibly
+const CACHE_KEY_VERSION = "v1"
+
+const (
+	DEFAULT_CAR_CACHE_TTL   = time.Minute * 10
+	DEFAULT_CARS_CACHE_TTL  = time.Minute
+	DEFAULT_IMAGE_CACHE_TTL = time.Hour
+)
+
+// CARS_GENERATION_KEY is the counter, which is incremented on every car change,
+// so the cached car lists become unreachable without scanning the keys
+const CARS_GENERATION_KEY = "cars:generation"
+
+type CacheParams struct {
+	Service Service
+	Cache   cache.Cache
+
+	// zero ttl means the default one
+	CarTTL   time.Duration
+	CarsTTL  time.Duration
+	ImageTTL time.Duration
+}
+
+// NewCachedService caches the car reads of the service and invalidates them on the admin writes
+func NewCachedService(p CacheParams) Service {
+	s := &cachedService{
+		Service:  p.Service,
+		log:      log.GetLogger(),
+		cache:    p.Cache,
+		carTTL:   p.CarTTL,
+		carsTTL:  p.CarsTTL,
+		imageTTL: p.ImageTTL,
+	}
+
+	if s.carTTL == 0 {
+		s.carTTL = DEFAULT_CAR_CACHE_TTL
+	}
+	if s.carsTTL == 0 {
+		s.carsTTL = DEFAULT_CARS_CACHE_TTL
+	}
+	if s.imageTTL == 0 {
+		s.imageTTL = DEFAULT_IMAGE_CACHE_TTL
+	}
+
+	return s
+}
+
+type cachedService struct {
+	Service
+
+	log log.Logger
+
+	cache cache.Cache
+	// group makes the concurrent misses of the same key load the value once
+	group singleflight.Group
+
+	carTTL   time.Duration
+	carsTTL  time.Duration
+	imageTTL time.Duration
+}
+
+func (s *cachedService) GetCarByUUID(ctx context.Context, uuid string) (models.Car, error) {
+	key, err := s.key(ctx, carGenerationKey(uuid), "car", uuid)
+	if err != nil {
+		s.log.Error("failed to get cache key", slog.String("error", err.Error()))
+	}
+
+	return cached(ctx, s, key, s.carTTL, func() (models.Car, error) {
+		return s.Service.GetCarByUUID(ctx, uuid)
+	})
+}
+
+func (s *cachedService) GetCarsByParams(ctx context.Context, params models.CarParams, page models.Page) (models.CarsPage, error) {
+	key, err := s.key(ctx, CARS_GENERATION_KEY, "cars", params, page)
+	if err != nil {
+		s.log.Error("failed to get cache key", slog.String("error", err.Error()))
+	}
+
+	return cached(ctx, s, key, s.carsTTL, func() (models.CarsPage, error) {
+		return s.Service.GetCarsByParams(ctx, params, page)
+	})
+}
+
+// GetImage the bucket of the image is the car uuid, so the images are invalidated with the car
+func (s *cachedService) GetImage(ctx context.Context, imageId string, variant string) ([]byte, error) {
+	bucket, _, _ := strings.Cut(imageId, "/")
+
+	key, err := s.key(ctx, carGenerationKey(bucket), "image", imageId, variant)
+	if err != nil {
+		s.log.Error("failed to get cache key", slog.String("error", err.Error()))
+	}
+
+	return cached(ctx, s, key, s.imageTTL, func() ([]byte, error) {
+		return s.Service.GetImage(ctx, imageId, variant)
+	})
+}
+
+func (s *cachedService) UploadCarImage(ctx context.Context, carUUID string, main bool, f io.Reader) (string, error) {
+	id, err := s.Service.UploadCarImage(ctx, carUUID, main, f)
+	if err != nil {
+		return "", err
+	}
+
+	s.invalidate(ctx, carGenerationKey(carUUID), CARS_GENERATION_KEY)
+	return id, nil
+}
+
+func (s *cachedService) DeleteCarImage(ctx context.Context, carUUID string, imageId string) error {
+	if err := s.Service.DeleteCarImage(ctx, carUUID, imageId); err != nil {
+		return err
+	}
+
+	s.invalidate(ctx, carGenerationKey(carUUID), CARS_GENERATION_KEY)
+	return nil
+}
+
+func (s *cachedService) ReorderCarImages(ctx context.Context, carUUID string, ids []string) error {
+	if err := s.Service.ReorderCarImages(ctx, carUUID, ids); err != nil {
+		return err
+	}
+
+	s.invalidate(ctx, carGenerationKey(carUUID))
+	return nil
+}
+
+func (s *cachedService) SetMainCarImage(ctx context.Context, carUUID string, imageId string) error {
+	if err := s.Service.SetMainCarImage(ctx, carUUID, imageId); err != nil {
+		return err
+	}
+
+	s.invalidate(ctx, carGenerationKey(carUUID), CARS_GENERATION_KEY)
+	return nil
+}
+
+func (s *cachedService) CreateCar(ctx context.Context, car models.Car, imageFiles [][]byte, mainImage []byte) error {
+	if err := s.Service.CreateCar(ctx, car, imageFiles, mainImage); err != nil {
+		return err
+	}
+
+	s.invalidate(ctx, CARS_GENERATION_KEY)
+	return nil
+}
+
+func (s *cachedService) DeleteCar(ctx context.Context, uuid string) error {
+	if err := s.Service.DeleteCar(ctx, uuid); err != nil {
+		return err
+	}
+
+	s.invalidate(ctx, carGenerationKey(uuid), CARS_GENERATION_KEY)
+	return nil
+}
+
+func (s *cachedService) UpdateCar(ctx context.Context, req models.UpdateCarReq) error {
+	if err := s.Service.UpdateCar(ctx, req); err != nil {
+		return err
+	}
+
+	s.invalidate(ctx, carGenerationKey(req.CarUUID), CARS_GENERATION_KEY)
+	return nil
+}
+
+func (s *cachedService) UpdateCarPrice(ctx context.Context, req models.UpdateCarPriceReq) error {
+	if err := s.Service.UpdateCarPrice(ctx, req); err != nil {
+		return err
+	}
+
+	s.invalidate(ctx, carGenerationKey(req.CarUUID), CARS_GENERATION_KEY)
+	return nil
+}
+
+// cached returns the value by the key or loads and caches it, empty key means that the cache is unavailable
+func cached[T any](ctx context.Context, s *cachedService, key string, ttl time.Duration, load func() (T, error)) (T, error) {
+	if key == "" {
+		return load()
+	}
+
+	var val T
+	err := s.cache.Get(ctx, key, &val)
+	if err == nil {
+		return val, nil
+	}
+	if !errors.Is(err, cache.ErrNotFound) {
+		s.log.Error("failed to get from cache", slog.String("error", err.Error()))
+	}
+
+	res, err, _ := s.group.Do(key, func() (any, error) {
+		val, err := load()
+		if err != nil {
+			return nil, err
+		}
+
+		if err = s.cache.Set(ctx, key, val, ttl); err != nil {
+			s.log.Error("failed to set cache", slog.String("error", err.Error()))
+		}
+
+		return val, nil
+	})
+	if err != nil {
+		var empty T
+		return empty, err
+	}
+
+	return res.(T), nil
+}
+
+// key builds the key of the current generation, so the value, loaded before the change,
+// can only be cached under the outdated key
+func (s *cachedService) key(ctx context.Context, generationKey string, kind string, args ...any) (string, error) {
+	var generation int64
+	if err := s.cache.Get(ctx, generationKey, &generation); err != nil && !errors.Is(err, cache.ErrNotFound) {
+		return "", err
+	}
+
+	b, err := json.Marshal(args)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal key args: %v", err)
+	}
+	hash := sha256.Sum256(b)
+
+	return fmt.Sprintf("%s:%s:%d:%s", CACHE_KEY_VERSION, kind, generation, hex.EncodeToString(hash[:])), nil
+}
+
+func (s *cachedService) invalidate(ctx context.Context, generationKeys ...string) {
+	for _, key := range generationKeys {
+		// the values of the old generation stay in cache till their ttl expires
+		if _, err := s.cache.Incr(ctx, key); err != nil {
+			s.log.Error("failed to invalidate cache", slog.String("key", key), slog.String("error", err.Error()))
+		}
+	}
+}
+
+func carGenerationKey(uuid string) string {
+	return fmt.Sprintf("car:%s:generation", uuid)
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"github.com/alserov/rently/carsharing/internal/cache/memory"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"testing"
+	"time"
+)
+
+type carServiceStub struct {
+	Service
+
+	mu    sync.Mutex
+	loads int
+	car   models.Car
+
+	// loading blocks the loads till it is closed
+	loading chan struct{}
+}
+
+func (s *carServiceStub) GetCarByUUID(_ context.Context, _ string) (models.Car, error) {
+	<-s.loading
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.loads++
+	return s.car, nil
+}
+
+func (s *carServiceStub) UpdateCarPrice(_ context.Context, req models.UpdateCarPriceReq) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.car.PricePerDay = req.Price
+	return nil
+}
+
+func TestCachedService_GetCarByUUID(t *testing.T) {
+	ctx := context.Background()
+
+	stub := &carServiceStub{
+		car:     models.Car{UUID: "uuid", PricePerDay: models.NewMoney(100_00, models.DEFAULT_CURRENCY)},
+		loading: make(chan struct{}),
+	}
+	close(stub.loading)
+
+	s := NewCachedService(CacheParams{
+		Service: stub,
+		Cache:   memory.NewCache(),
+	})
+
+	for i := 0; i < 3; i++ {
+		car, err := s.GetCarByUUID(ctx, "uuid")
+		require.NoError(t, err)
+		require.Equal(t, stub.car, car)
+	}
+	require.Equal(t, 1, stub.loads)
+
+	price := models.NewMoney(150_00, models.DEFAULT_CURRENCY)
+	require.NoError(t, s.UpdateCarPrice(ctx, models.UpdateCarPriceReq{CarUUID: "uuid", Price: price}))
+
+	car, err := s.GetCarByUUID(ctx, "uuid")
+	require.NoError(t, err)
+	require.Equal(t, price, car.PricePerDay)
+	require.Equal(t, 2, stub.loads)
+}
+
+func TestCachedService_Stampede(t *testing.T) {
+	const requests = 10
+
+	stub := &carServiceStub{
+		car:     models.Car{UUID: "uuid"},
+		loading: make(chan struct{}),
+	}
+
+	s := NewCachedService(CacheParams{
+		Service: stub,
+		Cache:   memory.NewCache(),
+	})
+
+	wg := sync.WaitGroup{}
+	wg.Add(requests)
+	for i := 0; i < requests; i++ {
+		go func() {
+			defer wg.Done()
+
+			_, err := s.GetCarByUUID(context.Background(), "uuid")
+			require.NoError(t, err)
+		}()
+	}
+
+	// lets all the requests miss the cache before the first load finishes
+	time.Sleep(time.Millisecond * 100)
+	close(stub.loading)
+	wg.Wait()
+
+	require.Equal(t, 1, stub.loads)
+}
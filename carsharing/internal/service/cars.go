@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"github.com/alserov/rently/carsharing/internal/models"
+)
+
+// UNKNOWN_ACTOR is recorded for the changes, made without the admin uuid, e.g. by the internal calls
+const UNKNOWN_ACTOR = "unknown"
+
+func (s *service) UpdateCar(ctx context.Context, req models.UpdateCarReq) error {
+	if err := s.repo.UpdateCar(ctx, req, actor(ctx)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) GetCarChanges(ctx context.Context, carUUID string) ([]models.CarChange, error) {
+	changes, err := s.repo.GetCarChanges(ctx, carUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+func actor(ctx context.Context) string {
+	if actor, _ := ctx.Value(models.ACTOR).(string); actor != "" {
+		return actor
+	}
+
+	return UNKNOWN_ACTOR
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/alserov/rently/carsharing/internal/db"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"net/http"
+)
+
+const (
+	OP_CREATE_RENT = "create_rent"
+	OP_CANCEL_RENT = "cancel_rent"
+
+	ERR_IDEMPOTENCY_KEY_REUSED = "idempotency key is already used with the other request"
+)
+
+func idempotencyKey(ctx context.Context) string {
+	key, _ := ctx.Value(models.IDEMPOTENCY_KEY).(string)
+	return key
+}
+
+// newIdempotencyKey binds the key of the context to the user and the request, the key is empty without the header
+func newIdempotencyKey(ctx context.Context, operation string, userUUID string, req any) (models.IdempotencyKey, error) {
+	key := idempotencyKey(ctx)
+	if key == "" {
+		return models.IdempotencyKey{}, nil
+	}
+
+	b, err := json.Marshal(req)
+	if err != nil {
+		return models.IdempotencyKey{}, &models.Error{
+			Msg:    fmt.Sprintf("failed to marshal request: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+	hash := sha256.Sum256(b)
+
+	return models.IdempotencyKey{
+		Key:         key,
+		Operation:   operation,
+		UserUUID:    userUUID,
+		RequestHash: hex.EncodeToString(hash[:]),
+	}, nil
+}
+
+// replay fills target with the stored response of the operation, performed with the same idempotency key,
+// the key reused with the other request is a conflict
+func (s *service) replay(ctx context.Context, key models.IdempotencyKey, target any) (bool, error) {
+	stored, found, err := s.repo.GetIdempotencyKey(ctx, key.Key, key.Operation, key.UserUUID)
+	if err != nil || !found {
+		return false, err
+	}
+
+	if stored.RequestHash != key.RequestHash {
+		return false, &models.Error{
+			Msg:    ERR_IDEMPOTENCY_KEY_REUSED,
+			Status: http.StatusConflict,
+		}
+	}
+
+	if err = json.Unmarshal(stored.Response, target); err != nil {
+		return false, &models.Error{
+			Msg:    fmt.Sprintf("failed to unmarshal stored response: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return true, nil
+}
+
+// saveIdempotencyKeyTx stores the key with the operation response, false is returned
+// if the key has already been stored by a concurrent request
+func (s *service) saveIdempotencyKeyTx(ctx context.Context, tx db.SqlTx, key models.IdempotencyKey, res any) (bool, error) {
+	b, err := json.Marshal(res)
+	if err != nil {
+		return false, &models.Error{
+			Msg:    fmt.Sprintf("failed to marshal response: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+	key.Response = b
+
+	return s.repo.CreateIdempotencyKeyTx(ctx, tx, key)
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"github.com/alserov/rently/carsharing/internal/storage"
+	"log/slog"
+)
+
+func (s *service) GetCarImages(ctx context.Context, carUUID string) ([]models.CarImage, error) {
+	return s.repo.GetCarImages(ctx, carUUID)
+}
+
+func (s *service) DeleteCarImage(ctx context.Context, carUUID string, imageId string) error {
+	image, err := s.repo.DeleteCarImage(ctx, carUUID, imageId)
+	if err != nil {
+		return err
+	}
+
+	// the files, which failed to be deleted, are left to the image collector
+	for _, variant := range image.Variants {
+		path := storage.VariantPath(fmt.Sprintf("%s/%s", carUUID, imageId), variant)
+		if err = s.imageStorage.Delete(ctx, path); err != nil {
+			s.log.Error("failed to delete image from storage", slog.String("error", err.Error()))
+		}
+	}
+
+	return nil
+}
+
+func (s *service) ReorderCarImages(ctx context.Context, carUUID string, ids []string) error {
+	return s.repo.ReorderCarImages(ctx, carUUID, ids)
+}
+
+func (s *service) SetMainCarImage(ctx context.Context, carUUID string, imageId string) error {
+	return s.repo.SetMainCarImage(ctx, carUUID, imageId)
+}
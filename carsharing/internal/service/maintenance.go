@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"github.com/google/uuid"
+	"log/slog"
+	"time"
+)
+
+// ScheduleMaintenance the conflicting rents are kept, so the admin decides, whether to cancel them or to move the maintenance
+func (s *service) ScheduleMaintenance(ctx context.Context, m models.Maintenance) (models.ScheduleMaintenanceRes, error) {
+	m.UUID = uuid.New().String()
+	m.Actor = actor(ctx)
+	if m.Start.IsZero() {
+		m.Start = time.Now()
+	}
+
+	conflicts, err := s.repo.ScheduleMaintenance(ctx, m)
+	if err != nil {
+		return models.ScheduleMaintenanceRes{}, err
+	}
+
+	if len(conflicts) > 0 {
+		s.log.Warn("maintenance conflicts with rents",
+			slog.String("car", m.CarUUID), slog.String("maintenance", m.UUID), slog.Int("rents", len(conflicts)))
+	}
+
+	return models.ScheduleMaintenanceRes{
+		UUID:      m.UUID,
+		Conflicts: conflicts,
+	}, nil
+}
+
+func (s *service) CloseMaintenance(ctx context.Context, req models.CloseMaintenanceReq) error {
+	if err := s.repo.CloseMaintenance(ctx, req); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) GetMaintenances(ctx context.Context, carUUID string) (models.CarMaintenances, error) {
+	maintenances, err := s.repo.GetMaintenances(ctx, carUUID)
+	if err != nil {
+		return models.CarMaintenances{}, err
+	}
+
+	return models.CarMaintenances{
+		Maintenances:       maintenances,
+		NextServiceMileage: nextServiceMileage(maintenances),
+	}, nil
+}
+
+// nextServiceMileage the interval of the last closed maintenance is counted from its mileage
+func nextServiceMileage(maintenances []models.Maintenance) int64 {
+	var last *models.Maintenance
+	for i, m := range maintenances {
+		if m.ClosedAt == nil || m.IntervalKm == 0 {
+			continue
+		}
+		if last == nil || m.ClosedAt.After(*last.ClosedAt) {
+			last = &maintenances[i]
+		}
+	}
+
+	if last == nil {
+		return 0
+	}
+	return last.Mileage + last.IntervalKm
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	repomock "github.com/alserov/rently/carsharing/internal/db/mocks"
+	"github.com/alserov/rently/carsharing/internal/log"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestNextServiceMileage(t *testing.T) {
+	day := time.Date(2025, time.January, 8, 0, 0, 0, 0, time.UTC)
+	closed := func(days int) *time.Time {
+		at := day.AddDate(0, 0, days)
+		return &at
+	}
+
+	require.Zero(t, nextServiceMileage(nil))
+
+	maintenances := []models.Maintenance{
+		{Mileage: 10_000, IntervalKm: 15_000, ClosedAt: closed(0)},
+		// the unplanned repair without the interval does not reset the schedule
+		{Mileage: 12_000, ClosedAt: closed(10)},
+		{Mileage: 25_000, IntervalKm: 15_000, ClosedAt: closed(20)},
+		// the open maintenance has no mileage yet
+		{IntervalKm: 15_000},
+	}
+	require.EqualValues(t, 40_000, nextServiceMileage(maintenances))
+}
+
+func TestService_ScheduleMaintenance(t *testing.T) {
+	log.MustSetup(log.ENV_LOCAL)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.WithValue(context.Background(), models.ACTOR, "admin")
+
+	conflicts := []models.RentConflict{{RentUUID: "rent", Status: models.RENT_STATUS_RESERVED}}
+
+	repo := repomock.NewMockRepository(ctrl)
+	repo.EXPECT().ScheduleMaintenance(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, m models.Maintenance) ([]models.RentConflict, error) {
+			require.NotEmpty(t, m.UUID)
+			require.Equal(t, "admin", m.Actor)
+			// the unplanned maintenance starts now
+			require.False(t, m.Start.IsZero())
+			return conflicts, nil
+		})
+
+	s := NewService(Params{Repo: repo})
+
+	res, err := s.ScheduleMaintenance(ctx, models.Maintenance{CarUUID: "car", Kind: models.MAINTENANCE_UNPLANNED, Reason: "flat tyre"})
+	require.NoError(t, err)
+	require.NotEmpty(t, res.UUID)
+	require.Equal(t, conflicts, res.Conflicts)
+}
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/alserov/rently/carsharing/internal/db"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"log/slog"
+	"net/http"
+)
+
+// createOutboxEventTx stores the event, that will be handled by outbox relay after tx is committed
+func (s *service) createOutboxEventTx(ctx context.Context, tx db.SqlTx, eventType string, payload any) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return &models.Error{
+			Msg:    fmt.Sprintf("failed to marshal outbox event: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return s.repo.CreateOutboxEventTx(ctx, tx, models.OutboxEvent{
+		Type:    eventType,
+		Payload: b,
+	})
+}
+
+func (s *service) createChargeTx(ctx context.Context, tx db.SqlTx, req models.CreateRentReq, chargeID string, rentPrice models.Money) error {
+	err := s.repo.CreateChargeTx(ctx, tx, models.Charge{ChargeUUID: chargeID, RentUUID: req.RentUUID, ChargeAmount: rentPrice})
+	if err != nil {
+		return err
+	}
+
+	err = s.createOutboxEventTx(ctx, tx, models.EVENT_CHARGE_CAPTURE, models.ChargeEvent{ChargeUUID: chargeID, RentUUID: req.RentUUID})
+	if err != nil {
+		return err
+	}
+
+	return s.createOutboxEventTx(ctx, tx, models.EVENT_RENT_CREATED, models.RentNotification{
+		RentUUID:  req.RentUUID,
+		CarUUID:   req.CarUUID,
+		UserUUID:  req.UserUUID,
+		Email:     req.Email,
+		Amount:    rentPrice.Units(),
+		Price:     rentPrice,
+		RentStart: req.RentStart,
+		RentEnd:   req.RentEnd,
+	})
+}
+
+// releaseAuthorization is called when the rent was not stored, so the held amount is not going to be captured
+func (s *service) releaseAuthorization(chargeID string) {
+	if err := s.payment.Refund(chargeID); err != nil {
+		s.log.Error("failed to release authorization", slog.String("charge", chargeID), slog.String("error", err.Error()))
+	}
+}
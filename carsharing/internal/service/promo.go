@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/alserov/rently/carsharing/internal/db"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	ERR_PROMO_CODE_NOT_ACTIVE       = "promo code is not active"
+	ERR_PROMO_CODE_CATEGORY         = "promo code is not applicable to the car category"
+	ERR_PROMO_CODE_CURRENCY         = "promo code currency does not match the rent price currency"
+	ERR_PROMO_CODE_LIMIT            = "promo code usage limit is reached"
+	ERR_PROMO_CODE_UNAUTHORIZED     = "promo code is only available for authorized users"
+	ERR_PROMO_CODE_EXCEEDS_PRICE    = "promo code discount exceeds the rent price"
+	ERR_PROMO_CODE_INVALID_DISCOUNT = "promo code should have either percent or amount discount"
+)
+
+func (s *service) CreatePromoCode(ctx context.Context, promo models.PromoCode) error {
+	promo.Code = normalizePromoCode(promo.Code)
+
+	if (promo.Percent == 0) == (promo.Amount.Amount == 0) || promo.Percent < 0 || promo.Percent > models.MAX_PROMO_PERCENT {
+		return &models.Error{
+			Msg:    ERR_PROMO_CODE_INVALID_DISCOUNT,
+			Status: http.StatusBadRequest,
+		}
+	}
+
+	if promo.ValidFrom.IsZero() {
+		promo.ValidFrom = time.Now()
+	}
+
+	if err := s.repo.CreatePromoCode(ctx, promo); err != nil {
+		return fmt.Errorf("repository error: %w", err)
+	}
+
+	return nil
+}
+
+func (s *service) ExpirePromoCode(ctx context.Context, code string) error {
+	if err := s.repo.ExpirePromoCode(ctx, normalizePromoCode(code)); err != nil {
+		return fmt.Errorf("repository error: %w", err)
+	}
+
+	return nil
+}
+
+// applyPromoCodeTx returns the discount of the rent promo code, the code stays locked till the end of tx
+func (s *service) applyPromoCodeTx(ctx context.Context, tx db.SqlTx, req models.CreateRentReq, category string, price models.Money) (models.Money, error) {
+	promo, err := s.repo.GetPromoCodeTx(ctx, tx, normalizePromoCode(req.PromoCode))
+	if err != nil {
+		return models.Money{}, fmt.Errorf("repository error: %w", err)
+	}
+
+	if promo.MaxUsesPerUser > 0 && req.Token == "" {
+		return models.Money{}, &models.Error{
+			Msg:    ERR_PROMO_CODE_UNAUTHORIZED,
+			Status: http.StatusBadRequest,
+		}
+	}
+
+	usage, err := s.repo.GetPromoUsageTx(ctx, tx, promo.Code, req.UserUUID)
+	if err != nil {
+		return models.Money{}, fmt.Errorf("repository error: %w", err)
+	}
+
+	if err = checkPromoUsage(promo, usage); err != nil {
+		return models.Money{}, err
+	}
+
+	return promoDiscount(promo, category, price, time.Now())
+}
+
+func checkPromoUsage(promo models.PromoCode, usage models.PromoUsage) error {
+	if (promo.MaxUses > 0 && usage.Total >= promo.MaxUses) || (promo.MaxUsesPerUser > 0 && usage.ByUser >= promo.MaxUsesPerUser) {
+		return &models.Error{
+			Msg:    ERR_PROMO_CODE_LIMIT,
+			Status: http.StatusConflict,
+		}
+	}
+
+	return nil
+}
+
+// promoDiscount checks that the code is applicable to the rent and returns the discount of the price
+func promoDiscount(promo models.PromoCode, category string, price models.Money, now time.Time) (models.Money, error) {
+	if promo.ExpiredAt != nil || now.Before(promo.ValidFrom) || (promo.ValidUntil != nil && !now.Before(*promo.ValidUntil)) {
+		return models.Money{}, &models.Error{
+			Msg:    ERR_PROMO_CODE_NOT_ACTIVE,
+			Status: http.StatusBadRequest,
+		}
+	}
+
+	if len(promo.Categories) > 0 && !containsFold(promo.Categories, category) {
+		return models.Money{}, &models.Error{
+			Msg:    ERR_PROMO_CODE_CATEGORY,
+			Status: http.StatusBadRequest,
+		}
+	}
+
+	discount := models.NewMoney(promo.Amount.Amount, price.Currency)
+	if promo.Percent > 0 {
+		discount.Amount = int64(math.Round(float64(price.Amount) * float64(promo.Percent) / 100))
+	} else if promo.Amount.Currency != price.Currency {
+		return models.Money{}, &models.Error{
+			Msg:    ERR_PROMO_CODE_CURRENCY,
+			Status: http.StatusBadRequest,
+		}
+	}
+
+	// the rent can not be free, because the charge is required to track the redemption
+	if discount.Amount >= price.Amount {
+		return models.Money{}, &models.Error{
+			Msg:    ERR_PROMO_CODE_EXCEEDS_PRICE,
+			Status: http.StatusBadRequest,
+		}
+	}
+
+	return discount, nil
+}
+
+func normalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+
+	return false
+}
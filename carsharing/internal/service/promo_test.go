@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestPromoDiscount(t *testing.T) {
+	var (
+		now       = time.Now()
+		yesterday = now.Add(-time.Hour * 24)
+		tomorrow  = now.Add(time.Hour * 24)
+		price     = models.NewMoney(100_00, models.DEFAULT_CURRENCY)
+	)
+
+	tests := []struct {
+		name     string
+		promo    models.PromoCode
+		category string
+		expected int64
+		status   int
+	}{
+		{name: "percent", promo: models.PromoCode{Percent: 15, ValidFrom: yesterday}, expected: 15_00},
+		{name: "fixed", promo: models.PromoCode{Amount: models.NewMoney(20_00, models.DEFAULT_CURRENCY), ValidFrom: yesterday}, expected: 20_00},
+		{name: "category", promo: models.PromoCode{Percent: 10, ValidFrom: yesterday, Categories: []string{"premium"}}, category: "Premium", expected: 10_00},
+		{name: "wrong category", promo: models.PromoCode{Percent: 10, ValidFrom: yesterday, Categories: []string{"premium"}}, category: "economy", status: http.StatusBadRequest},
+		{name: "not started", promo: models.PromoCode{Percent: 10, ValidFrom: tomorrow}, status: http.StatusBadRequest},
+		{name: "ended", promo: models.PromoCode{Percent: 10, ValidFrom: yesterday.Add(-time.Hour), ValidUntil: &yesterday}, status: http.StatusBadRequest},
+		{name: "expired", promo: models.PromoCode{Percent: 10, ValidFrom: yesterday, ExpiredAt: &now}, status: http.StatusBadRequest},
+		{name: "other currency", promo: models.PromoCode{Amount: models.NewMoney(20_00, "EUR"), ValidFrom: yesterday}, status: http.StatusBadRequest},
+		{name: "free rent", promo: models.PromoCode{Percent: 100, ValidFrom: yesterday}, status: http.StatusBadRequest},
+	}
+
+	for _, tc := range tests {
+		discount, err := promoDiscount(tc.promo, tc.category, price, now)
+		if tc.status != 0 {
+			var e *models.Error
+			require.True(t, errors.As(err, &e), tc.name)
+			require.Equal(t, tc.status, e.Status, tc.name)
+			continue
+		}
+
+		require.NoError(t, err, tc.name)
+		require.Equal(t, models.NewMoney(tc.expected, price.Currency), discount, tc.name)
+	}
+}
+
+func TestCheckPromoUsage(t *testing.T) {
+	require.NoError(t, checkPromoUsage(models.PromoCode{}, models.PromoUsage{Total: 1000, ByUser: 1000}))
+	require.NoError(t, checkPromoUsage(models.PromoCode{MaxUses: 10, MaxUsesPerUser: 2}, models.PromoUsage{Total: 9, ByUser: 1}))
+	require.Error(t, checkPromoUsage(models.PromoCode{MaxUses: 10}, models.PromoUsage{Total: 10}))
+	require.Error(t, checkPromoUsage(models.PromoCode{MaxUsesPerUser: 2}, models.PromoUsage{Total: 2, ByUser: 2}))
+}
+
+func TestService_CreatePromoCodeFreeRent(t *testing.T) {
+	// the code is rejected before it reaches the repository
+	s := &service{}
+
+	err := s.CreatePromoCode(context.Background(), models.PromoCode{Code: "free", Percent: 100})
+	var e *models.Error
+	require.True(t, errors.As(err, &e))
+	require.Equal(t, http.StatusBadRequest, e.Status)
+}
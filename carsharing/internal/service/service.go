@@ -1,20 +1,24 @@
 package service
 
 import (
-	"bytes"
+	"bufio"
 	"context"
+	"errors"
 	"fmt"
 	"github.com/alserov/rently/carsharing/internal/clients"
 	"github.com/alserov/rently/carsharing/internal/db"
+	"github.com/alserov/rently/carsharing/internal/imaging"
 	"github.com/alserov/rently/carsharing/internal/log"
 	"github.com/alserov/rently/carsharing/internal/models"
-	"github.com/alserov/rently/carsharing/internal/notifications"
 	"github.com/alserov/rently/carsharing/internal/payment"
+	"github.com/alserov/rently/carsharing/internal/pricing"
 	"github.com/alserov/rently/carsharing/internal/storage"
 	"github.com/google/uuid"
 	"io"
 	"log/slog"
 	"net/http"
+	"os"
+	"sort"
 	"sync"
 	"time"
 )
@@ -27,30 +31,54 @@ type Service interface {
 
 type AdminActions interface {
 	CreateCar(ctx context.Context, car models.Car, imageFiles [][]byte, mainImage []byte) error
+	UploadCarImage(ctx context.Context, carUUID string, main bool, f io.Reader) (string, error)
+	GetCarImages(ctx context.Context, carUUID string) ([]models.CarImage, error)
+	DeleteCarImage(ctx context.Context, carUUID string, imageId string) error
+	ReorderCarImages(ctx context.Context, carUUID string, ids []string) error
+	SetMainCarImage(ctx context.Context, carUUID string, imageId string) error
+	// DeleteCar hides the car from the catalogue and blocks the new rents, the images are kept for the rents history
 	DeleteCar(ctx context.Context, uuid string) error
+	UpdateCar(ctx context.Context, req models.UpdateCarReq) error
 	UpdateCarPrice(ctx context.Context, req models.UpdateCarPriceReq) error
+	GetCarChanges(ctx context.Context, carUUID string) ([]models.CarChange, error)
+	// ScheduleMaintenance takes the car off the market for the period and returns the rents, which collide with it
+	ScheduleMaintenance(ctx context.Context, m models.Maintenance) (models.ScheduleMaintenanceRes, error)
+	CloseMaintenance(ctx context.Context, req models.CloseMaintenanceReq) error
+	GetMaintenances(ctx context.Context, carUUID string) (models.CarMaintenances, error)
+	CreateStation(ctx context.Context, station models.Station) (string, error)
+	CreatePromoCode(ctx context.Context, promo models.PromoCode) error
+	ExpirePromoCode(ctx context.Context, code string) error
 }
 
 type CarActions interface {
 	GetCarByUUID(ctx context.Context, uuid string) (car models.Car, err error)
-	GetCarsByParams(ctx context.Context, params models.CarParams) (cars []models.CarMainInfo, err error)
-	GetAvailableCars(ctx context.Context, period models.Period) (cars []models.CarMainInfo, err error)
-	GetImage(ctx context.Context, imageId string) ([]byte, error)
+	GetCarsByParams(ctx context.Context, params models.CarParams, page models.Page) (cars models.CarsPage, err error)
+	GetAvailableCars(ctx context.Context, period models.Period, params models.CarParams, page models.Page) (cars models.CarsPage, err error)
+	SearchCars(ctx context.Context, req models.SearchCarsReq, page models.Page) (res models.SearchCarsRes, err error)
+	GetImage(ctx context.Context, imageId string, variant string) ([]byte, error)
+	// GetImageStream the caller has to close the file of the range
+	GetImageStream(ctx context.Context, imageId string, variant string, offset, length int64) (models.ImageRange, error)
+	QuotePrice(ctx context.Context, req models.QuotePriceReq) (models.PriceQuote, error)
+	GetStations(ctx context.Context, near models.Area) ([]models.Station, error)
 }
 
 type RentActions interface {
 	CreateRent(ctx context.Context, req models.CreateRentReq) (res models.CreateRentRes, err error)
 	CancelRent(ctx context.Context, rentUUID string) error
+	StartRent(ctx context.Context, rentUUID string) error
+	CompleteRent(ctx context.Context, rentUUID string) error
+	MarkNoShow(ctx context.Context, rentUUID string) error
 	CheckRent(ctx context.Context, rentUUID string) (res models.Rent, err error)
 	GetRentsWhatStartsOnDate(ctx context.Context, startingOn time.Time) ([]models.RentStartData, error)
 }
 
 type Params struct {
-	Payment       payment.Payer
-	ImageStorage  storage.ImageStorage
-	Notifications notifications.Notifier
-	UserClient    clients.UserClient
-	Repo          db.Repository
+	Payment      payment.Payer
+	Pricing      pricing.Engine
+	ImageStorage storage.ImageStorage
+	Images       imaging.Processor
+	UserClient   clients.UserClient
+	Repo         db.Repository
 }
 
 func NewService(p Params) Service {
@@ -58,12 +86,19 @@ func NewService(p Params) Service {
 		log:          log.GetLogger(),
 		repo:         p.Repo,
 		imageStorage: p.ImageStorage,
+		images:       p.Images,
 		payment:      p.Payment,
-		notification: p.Notifications,
+		pricing:      p.Pricing,
 		userClient:   p.UserClient,
 	}
 }
 
+// requestID the id is set by the server, the calls without it are logged with the empty one
+func requestID(ctx context.Context) string {
+	id, _ := ctx.Value(models.ID).(string)
+	return id
+}
+
 type service struct {
 	log log.Logger
 
@@ -71,11 +106,12 @@ type service struct {
 
 	payment payment.Payer
 
+	pricing pricing.Engine
+
 	userClient clients.UserClient
 
 	imageStorage storage.ImageStorage
-
-	notification notifications.Notifier
+	images       imaging.Processor
 }
 
 func (s *service) GetRentsWhatStartsOnDate(ctx context.Context, startingOn time.Time) ([]models.RentStartData, error) {
@@ -87,8 +123,12 @@ func (s *service) GetRentsWhatStartsOnDate(ctx context.Context, startingOn time.
 	return rents, nil
 }
 
-func (s *service) GetImage(ctx context.Context, imageId string) ([]byte, error) {
-	file, err := s.imageStorage.Get(ctx, imageId)
+func (s *service) GetImage(ctx context.Context, imageId string, variant string) ([]byte, error) {
+	file, err := s.imageStorage.Get(ctx, storage.VariantPath(imageId, variant))
+	if errors.Is(err, os.ErrNotExist) && variant != models.IMAGE_VARIANT_ORIGINAL {
+		// images, uploaded before the processing was added, have only the original
+		file, err = s.imageStorage.Get(ctx, imageId)
+	}
 	if err != nil {
 		return nil, &models.Error{
 			Msg:    fmt.Sprintf("image not found: %v", err),
@@ -96,6 +136,8 @@ func (s *service) GetImage(ctx context.Context, imageId string) ([]byte, error)
 		}
 	}
 
+	defer file.Close()
+
 	b, err := io.ReadAll(file)
 	if err != nil {
 		return nil, &models.Error{
@@ -107,21 +149,144 @@ func (s *service) GetImage(ctx context.Context, imageId string) ([]byte, error)
 	return b, err
 }
 
+// SNIFF_LEN is the number of bytes used by http.DetectContentType
+const SNIFF_LEN = 512
+
+func (s *service) GetImageStream(ctx context.Context, imageId string, variant string, offset, length int64) (models.ImageRange, error) {
+	path := storage.VariantPath(imageId, variant)
+
+	file, size, err := s.imageStorage.GetRange(ctx, path, offset, length)
+	if errors.Is(err, os.ErrNotExist) && variant != models.IMAGE_VARIANT_ORIGINAL {
+		path = imageId
+		file, size, err = s.imageStorage.GetRange(ctx, path, offset, length)
+	}
+	if errors.Is(err, storage.ErrInvalidRange) {
+		// the size is returned, so the client can be told the valid range
+		return models.ImageRange{Size: size}, &models.Error{
+			Msg:    fmt.Sprintf("invalid range: %v", err),
+			Status: http.StatusRequestedRangeNotSatisfiable,
+		}
+	}
+	if err != nil {
+		return models.ImageRange{}, &models.Error{
+			Msg:    fmt.Sprintf("image not found: %v", err),
+			Status: http.StatusNotFound,
+		}
+	}
+
+	contentType, file, err := s.detectContentType(ctx, path, offset, file)
+	if err != nil {
+		return models.ImageRange{}, &models.Error{
+			Msg:    fmt.Sprintf("failed to read file: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return models.ImageRange{
+		ContentType: contentType,
+		Size:        size,
+		Offset:      offset,
+		File:        file,
+	}, nil
+}
+
+// detectContentType sniffs the beginning of the image, the range, which starts later, does not have it,
+// so the beginning is read separately, the returned file has to be used instead of the passed one
+func (s *service) detectContentType(ctx context.Context, path string, offset int64, file io.ReadCloser) (string, io.ReadCloser, error) {
+	if offset == 0 {
+		r := bufio.NewReaderSize(file, SNIFF_LEN)
+		head, err := r.Peek(SNIFF_LEN)
+		if err != nil && !errors.Is(err, io.EOF) {
+			_ = file.Close()
+			return "", nil, err
+		}
+
+		return http.DetectContentType(head), struct {
+			io.Reader
+			io.Closer
+		}{r, file}, nil
+	}
+
+	head, _, err := s.imageStorage.GetRange(ctx, path, 0, SNIFF_LEN)
+	if err != nil {
+		_ = file.Close()
+		return "", nil, err
+	}
+	defer head.Close()
+
+	b, err := io.ReadAll(head)
+	if err != nil {
+		_ = file.Close()
+		return "", nil, err
+	}
+
+	return http.DetectContentType(b), file, nil
+}
+
+func (s *service) UploadCarImage(ctx context.Context, carUUID string, main bool, f io.Reader) (string, error) {
+	// the image is decoded to make the variants, so it is read into memory once, up to the max size
+	img, err := s.images.ProcessReader(f)
+	if err != nil {
+		return "", err
+	}
+
+	id, err := s.imageStorage.SaveVariants(ctx, carUUID, img.Variants)
+	if err != nil {
+		return "", &models.Error{
+			Msg:    fmt.Sprintf("failed to save image: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	variants := make([]string, 0, len(img.Variants))
+	for variant := range img.Variants {
+		variants = append(variants, variant)
+	}
+	sort.Strings(variants)
+
+	if err = s.repo.AddCarImage(ctx, carUUID, models.CarImage{ID: id, Main: main, Variants: variants}); err != nil {
+		for variant := range img.Variants {
+			path := storage.VariantPath(fmt.Sprintf("%s/%s", carUUID, id), variant)
+			if err := s.imageStorage.Delete(ctx, path); err != nil {
+				s.log.Error("failed to delete image from storage", slog.String("error", err.Error()))
+			}
+		}
+		return "", err
+	}
+
+	return id, nil
+}
+
 func (s *service) CreateCar(ctx context.Context, car models.Car, imageFiles [][]byte, mainImage []byte) error {
 	car.UUID = uuid.New().String()
 
+	// all the images are checked before the upload, so the invalid file does not leave the car half saved
+	main, err := s.images.Process(mainImage)
+	if err != nil {
+		return err
+	}
+
+	images := make([]models.ProcessedImage, 0, len(imageFiles))
+	for _, f := range imageFiles {
+		img, err := s.images.Process(f)
+		if err != nil {
+			return err
+		}
+		images = append(images, img)
+	}
+
 	var (
 		mu    = sync.Mutex{}
 		chErr = make(chan error, 3)
 		wg    = sync.WaitGroup{}
 	)
 
-	wg.Add(len(imageFiles) + 1)
+	wg.Add(len(images) + 1)
 
 	go func() {
 		defer wg.Done()
 
-		id, err := s.imageStorage.Save(ctx, car.UUID, bytes.NewReader(mainImage))
+		id, err := s.imageStorage.SaveVariants(ctx, car.UUID, main.Variants)
 		if err != nil {
 			chErr <- err
 		}
@@ -129,11 +294,11 @@ func (s *service) CreateCar(ctx context.Context, car models.Car, imageFiles [][]
 		car.MainImage = id
 	}()
 
-	for _, f := range imageFiles {
-		go func(f []byte, wg *sync.WaitGroup) {
+	for _, img := range images {
+		go func(img models.ProcessedImage, wg *sync.WaitGroup) {
 			defer wg.Done()
 
-			id, err := s.imageStorage.Save(ctx, car.UUID, bytes.NewReader(f))
+			id, err := s.imageStorage.SaveVariants(ctx, car.UUID, img.Variants)
 			if err != nil {
 				chErr <- err
 			}
@@ -141,7 +306,7 @@ func (s *service) CreateCar(ctx context.Context, car models.Car, imageFiles [][]
 			mu.Lock()
 			defer mu.Unlock()
 			car.Images = append(car.Images, id)
-		}(f, &wg)
+		}(img, &wg)
 	}
 
 	go func() {
@@ -158,9 +323,9 @@ func (s *service) CreateCar(ctx context.Context, car models.Car, imageFiles [][]
 		s.log.Error("failed to upload image to storage", slog.String("error", err.Error()))
 	}
 
-	s.log.Debug("saved images", slog.Int("failed to save images", errCounter), slog.String("id", ctx.Value(models.ID).(string)))
+	s.log.Debug("saved images", slog.Int("failed to save images", errCounter), slog.String("id", requestID(ctx)))
 
-	if err := s.repo.CreateCar(ctx, car); err != nil {
+	if err := s.repo.CreateCar(ctx, car, actor(ctx)); err != nil {
 		return fmt.Errorf("repository error: %w", err)
 	}
 
@@ -168,23 +333,19 @@ func (s *service) CreateCar(ctx context.Context, car models.Car, imageFiles [][]
 }
 
 func (s *service) DeleteCar(ctx context.Context, uuid string) error {
-	if err := s.repo.DeleteCar(ctx, uuid); err != nil {
+	if err := s.repo.DeleteCar(ctx, uuid, actor(ctx)); err != nil {
 		return err
 	}
 
-	if err := s.imageStorage.Delete(ctx, uuid); err != nil {
-		s.log.Error("failed to delete image from storage", slog.String("error", err.Error()))
-	}
-
 	return nil
 }
 
 func (s *service) UpdateCarPrice(ctx context.Context, req models.UpdateCarPriceReq) error {
-	if err := s.repo.UpdateCarPrice(ctx, req); err != nil {
-		return err
-	}
-
-	return nil
+	return s.UpdateCar(ctx, models.UpdateCarReq{
+		CarUUID: req.CarUUID,
+		Car:     models.Car{PricePerDay: req.Price},
+		Fields:  []string{models.CAR_FIELD_PRICE},
+	})
 }
 
 func (s *service) GetCarByUUID(ctx context.Context, uuid string) (models.Car, error) {
@@ -196,32 +357,76 @@ func (s *service) GetCarByUUID(ctx context.Context, uuid string) (models.Car, er
 	return car, nil
 }
 
-func (s *service) GetCarsByParams(ctx context.Context, params models.CarParams) ([]models.CarMainInfo, error) {
-	cars, err := s.repo.GetCarsByParams(ctx, params)
+func (s *service) GetCarsByParams(ctx context.Context, params models.CarParams, page models.Page) (models.CarsPage, error) {
+	cars, err := s.repo.GetCarsByParams(ctx, params, page)
 	if err != nil {
-		return nil, err
+		return models.CarsPage{}, err
 	}
 
 	return cars, nil
 }
 
-func (s *service) GetAvailableCars(ctx context.Context, period models.Period) ([]models.CarMainInfo, error) {
-	cars, err := s.repo.GetAvailableCars(ctx, period)
+func (s *service) GetAvailableCars(ctx context.Context, period models.Period, params models.CarParams, page models.Page) (models.CarsPage, error) {
+	cars, err := s.repo.GetAvailableCars(ctx, period, params, page)
 	if err != nil {
-		return nil, err
+		return models.CarsPage{}, err
 	}
 
 	return cars, nil
 }
 
+func (s *service) SearchCars(ctx context.Context, req models.SearchCarsReq, page models.Page) (models.SearchCarsRes, error) {
+	// the most relevant cars go first, unless the other order is requested
+	if page.SortBy == "" {
+		page.SortBy = models.SORT_BY_RELEVANCE
+		page.Descending = true
+	}
+
+	res, err := s.repo.SearchCars(ctx, req, page)
+	if err != nil {
+		return models.SearchCarsRes{}, err
+	}
+
+	return res, nil
+}
+
 func (s *service) CancelRent(ctx context.Context, rentUUID string) error {
+	// the cancellation has no user, so its key is bound to the rent by the request hash only
+	key, err := newIdempotencyKey(ctx, OP_CANCEL_RENT, "", rentUUID)
+	if err != nil {
+		return err
+	}
+	if key.Key != "" {
+		if replayed, err := s.replay(ctx, key, &struct{}{}); err != nil || replayed {
+			return err
+		}
+	}
+
 	tx, err := s.repo.StartTx(ctx)
+	if err != nil {
+		return &models.Error{
+			Msg:    fmt.Sprintf("failed to start tx: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
 	defer func() {
 		if err = tx.Rollback(); err != nil {
 			s.log.Warn("failed to rollback tx", slog.String("warn", err.Error()))
 		}
 	}()
 
+	if key.Key != "" {
+		saved, err := s.saveIdempotencyKeyTx(ctx, tx, key, struct{}{})
+		if err != nil || !saved {
+			// not saved key means that the rent was canceled by a concurrent request with the same key
+			return err
+		}
+	}
+
+	if err = s.checkRentStatusTx(ctx, tx, rentUUID, models.RENT_STATUS_CANCELED); err != nil {
+		return err
+	}
+
 	rent, err := s.repo.CancelRentTx(ctx, tx, rentUUID)
 	if err != nil {
 		return err
@@ -232,7 +437,22 @@ func (s *service) CancelRent(ctx context.Context, rentUUID string) error {
 		return fmt.Errorf("failed to update charge status: %w", err)
 	}
 
-	if err = s.payment.Refund(rent.ChargeID); err != nil {
+	err = s.createOutboxEventTx(ctx, tx, models.EVENT_CHARGE_REFUND, models.ChargeEvent{ChargeUUID: rent.ChargeID, RentUUID: rentUUID})
+	if err != nil {
+		return err
+	}
+
+	err = s.createOutboxEventTx(ctx, tx, models.EVENT_RENT_CANCELED, models.RentNotification{
+		RentUUID:  rentUUID,
+		CarUUID:   rent.CarUUID,
+		UserUUID:  rent.UserUUID,
+		Email:     rent.Email,
+		Amount:    rent.RentPrice.Units(),
+		Price:     rent.RentPrice,
+		RentStart: rent.RentStart,
+		RentEnd:   rent.RentEnd,
+	})
+	if err != nil {
 		return err
 	}
 
@@ -246,34 +466,72 @@ func (s *service) CancelRent(ctx context.Context, rentUUID string) error {
 	return nil
 }
 
+func (s *service) StartRent(ctx context.Context, rentUUID string) error {
+	return s.switchRentStatus(ctx, rentUUID, models.RENT_STATUS_ACTIVE)
+}
+
+func (s *service) CompleteRent(ctx context.Context, rentUUID string) error {
+	return s.switchRentStatus(ctx, rentUUID, models.RENT_STATUS_COMPLETED)
+}
+
+func (s *service) MarkNoShow(ctx context.Context, rentUUID string) error {
+	return s.switchRentStatus(ctx, rentUUID, models.RENT_STATUS_NO_SHOW)
+}
+
 func (s *service) CheckRent(ctx context.Context, rentUUID string) (res models.Rent, err error) {
 	rent, err := s.repo.CheckRent(ctx, rentUUID)
 	if err != nil {
 		return models.Rent{}, fmt.Errorf("repository error: %w", err)
 	}
 
-	rent.RentPrice = rent.RentPrice / 100
-
 	return rent, nil
 }
 
-func (s *service) CreateRent(ctx context.Context, req models.CreateRentReq) (models.CreateRentRes, error) {
-	req.RentUUID = uuid.New().String()
+func (s *service) QuotePrice(ctx context.Context, req models.QuotePriceReq) (models.PriceQuote, error) {
+	quote, err := s.quote(ctx, req)
+	return quote.PriceQuote, err
+}
 
-	s.log.Debug("creating new rent", slog.String(string(models.ID), ctx.Value(models.ID).(string)))
+// rentQuote is the price quote along with the car pricing info and the stations, it was calculated with
+type rentQuote struct {
+	models.PriceQuote
+	car models.CarPricing
 
-	available, err := s.repo.CheckIfCarAvailableInPeriod(ctx, req.CarUUID, req.RentStart, req.RentEnd)
+	pickupStation  string
+	dropOffStation string
+}
+
+func (s *service) quote(ctx context.Context, req models.QuotePriceReq) (rentQuote, error) {
+	car, err := s.repo.GetCarPricing(ctx, req.CarUUID)
 	if err != nil {
-		return models.CreateRentRes{}, err
+		return rentQuote{}, fmt.Errorf("repository error: %w", err)
 	}
-	if !available {
-		s.log.Debug("rent aborted because car is not available", slog.String(string(models.ID), ctx.Value(models.ID).(string)))
-		return models.CreateRentRes{}, &models.Error{
-			Msg:    "this car is already rented in this period",
-			Status: http.StatusBadRequest,
-		}
+
+	pickup, dropOff, err := s.rentStations(ctx, car, req)
+	if err != nil {
+		return rentQuote{}, err
 	}
 
+	return rentQuote{
+		PriceQuote: s.pricing.Quote(pricing.Request{
+			PricePerDay: car.PricePerDay,
+			Category:    car.Category,
+			RentStart:   req.RentStart,
+			RentEnd:     req.RentEnd,
+			OneWay:      pickup != dropOff,
+		}),
+		car:            car,
+		pickupStation:  pickup,
+		dropOffStation: dropOff,
+	}, nil
+}
+
+func (s *service) CreateRent(ctx context.Context, req models.CreateRentReq) (models.CreateRentRes, error) {
+	// the token is refreshed between the retries, so it is not a part of the request hash
+	fingerprint := req
+	fingerprint.Token = ""
+
+	// the user is resolved before the replay, the keys of the different users do not intersect
 	if req.Token != "" {
 		info, err := s.userClient.GetPassportAndPhone(ctx, req.Token)
 		if err != nil {
@@ -282,45 +540,126 @@ func (s *service) CreateRent(ctx context.Context, req models.CreateRentReq) (mod
 
 		req.PhoneNumber = info.PhoneNumber
 		req.PassportNumber = info.PassportNumber
+		req.Email = info.Email
 		req.UserUUID = info.UUID
 	}
 
-	req.Days = int(req.RentEnd.Sub(req.RentStart).Hours() / 24)
+	key, err := newIdempotencyKey(ctx, OP_CREATE_RENT, req.UserUUID, fingerprint)
+	if err != nil {
+		return models.CreateRentRes{}, err
+	}
+	if key.Key != "" {
+		var res models.CreateRentRes
+		if replayed, err := s.replay(ctx, key, &res); err != nil || replayed {
+			return res, err
+		}
+	}
+
+	req.RentUUID = uuid.New().String()
+
+	s.log.Debug("creating new rent", slog.String(string(models.ID), requestID(ctx)))
+
+	available, err := s.repo.CheckIfCarAvailableInPeriod(ctx, req.CarUUID, req.RentStart, req.RentEnd)
+	if err != nil {
+		return models.CreateRentRes{}, err
+	}
+	if !available {
+		s.log.Debug("rent aborted because car is not available", slog.String(string(models.ID), requestID(ctx)))
+		return models.CreateRentRes{}, &models.Error{
+			Msg:    "this car is not available in this period",
+			Status: http.StatusConflict,
+		}
+	}
+
+	quote, err := s.quote(ctx, models.QuotePriceReq{
+		CarUUID:        req.CarUUID,
+		RentStart:      req.RentStart,
+		RentEnd:        req.RentEnd,
+		PickupStation:  req.PickupStation,
+		DropOffStation: req.DropOffStation,
+	})
+	if err != nil {
+		return models.CreateRentRes{}, err
+	}
+	req.PickupStation, req.DropOffStation = quote.pickupStation, quote.dropOffStation
 
 	tx, err := s.repo.StartTx(ctx)
+	if err != nil {
+		return models.CreateRentRes{}, &models.Error{
+			Msg:    fmt.Sprintf("failed to start tx: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
 	defer func() {
 		if err = tx.Rollback(); err != nil {
 			s.log.Warn("failed to rollback tx", slog.String("warn", err.Error()))
 		}
 	}()
 
-	carPricePerDay, err := s.repo.CreateRentTx(ctx, tx, req)
-	if err != nil {
+	if key.Key != "" {
+		saved, err := s.saveIdempotencyKeyTx(ctx, tx, key, models.CreateRentRes{RentUUID: req.RentUUID})
+		if err != nil {
+			return models.CreateRentRes{}, err
+		}
+		if !saved {
+			// the rent was created by a concurrent request with the same key, so its response is returned
+			var res models.CreateRentRes
+			_, err = s.replay(ctx, key, &res)
+			return res, err
+		}
+	}
+
+	if err = s.repo.CreateRentTx(ctx, tx, req); err != nil {
 		return models.CreateRentRes{}, fmt.Errorf("repository error: %w", err)
 	}
 
-	s.log.Debug("started create rent tx", slog.String("id", ctx.Value(models.ID).(string)))
+	s.log.Debug("started create rent tx", slog.String("id", requestID(ctx)))
 
-	rentPrice := carPricePerDay * float32(req.Days) * 100
+	// exactly the quoted price is charged, unless the promo code is applied
+	rentPrice := quote.Price
 
-	chargeID, err := s.payment.Debit(req.PaymentSource, rentPrice)
+	var discount models.Money
+	if req.PromoCode != "" {
+		discount, err = s.applyPromoCodeTx(ctx, tx, req, quote.car.Category, rentPrice)
+		if err != nil {
+			return models.CreateRentRes{}, err
+		}
+
+		rentPrice.Amount -= discount.Amount
+	}
+
+	// the amount is only held, it is captured by outbox relay after the rent is committed,
+	// so if the process dies before commit the authorization just expires
+	chargeID, err := s.payment.Authorize(req.PaymentSource, rentPrice)
 	if err != nil {
 		return models.CreateRentRes{}, fmt.Errorf("payment error: %w", err)
 	}
 
-	s.log.Debug("debited rent price", slog.Int("price", int(rentPrice)), slog.String("id", ctx.Value(models.ID).(string)))
+	s.log.Debug("authorized rent price", slog.String("price", rentPrice.String()), slog.String("id", requestID(ctx)))
 
-	err = s.repo.CreateChargeTx(ctx, tx, models.Charge{ChargeUUID: chargeID, RentUUID: req.RentUUID, ChargeAmount: rentPrice})
-	if err != nil {
-		if err = s.payment.Refund(chargeID); err != nil {
-			return models.CreateRentRes{}, fmt.Errorf("payment error: %w", err)
-		}
+	if err = s.createChargeTx(ctx, tx, req, chargeID, rentPrice); err != nil {
+		s.releaseAuthorization(chargeID)
 		return models.CreateRentRes{}, fmt.Errorf("repository error: %w", err)
 	}
 
-	s.log.Debug("created charge", slog.String("id", ctx.Value(models.ID).(string)))
+	s.log.Debug("created charge", slog.String("id", requestID(ctx)))
+
+	if req.PromoCode != "" {
+		err = s.repo.CreatePromoRedemptionTx(ctx, tx, models.PromoRedemption{
+			Code:       normalizePromoCode(req.PromoCode),
+			ChargeUUID: chargeID,
+			RentUUID:   req.RentUUID,
+			UserUUID:   req.UserUUID,
+			Discount:   discount,
+		})
+		if err != nil {
+			s.releaseAuthorization(chargeID)
+			return models.CreateRentRes{}, fmt.Errorf("repository error: %w", err)
+		}
+	}
 
 	if err = tx.Commit(); err != nil {
+		s.releaseAuthorization(chargeID)
 		return models.CreateRentRes{}, &models.Error{
 			Msg:    fmt.Sprintf("failed to commit tx: %v", err),
 			Status: http.StatusInternalServerError,
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"github.com/google/uuid"
+	"net/http"
+	"time"
+)
+
+const (
+	ERR_CAR_WITHOUT_STATION = "the car is not assigned to a station"
+	ERR_INVALID_PICKUP      = "the car can only be picked up at its station"
+)
+
+func (s *service) CreateStation(ctx context.Context, station models.Station) (string, error) {
+	station.UUID = uuid.New().String()
+
+	if err := s.repo.CreateStation(ctx, station); err != nil {
+		return "", err
+	}
+
+	return station.UUID, nil
+}
+
+func (s *service) GetStations(ctx context.Context, near models.Area) ([]models.Station, error) {
+	stations, err := s.repo.GetStations(ctx, near)
+	if err != nil {
+		return nil, err
+	}
+
+	return stations, nil
+}
+
+// rentStations resolves the empty stations of the rent and checks, that they are open at the rent start and end,
+// the cars without a station are rented without them
+func (s *service) rentStations(ctx context.Context, car models.CarPricing, req models.QuotePriceReq) (pickup string, dropOff string, err error) {
+	if car.StationUUID == "" {
+		if req.PickupStation != "" || req.DropOffStation != "" {
+			return "", "", &models.Error{
+				Status: http.StatusBadRequest,
+				Msg:    ERR_CAR_WITHOUT_STATION,
+			}
+		}
+		return "", "", nil
+	}
+
+	pickup, dropOff = req.PickupStation, req.DropOffStation
+	if pickup == "" {
+		pickup = car.StationUUID
+	}
+	if dropOff == "" {
+		dropOff = pickup
+	}
+
+	if pickup != car.StationUUID {
+		return "", "", &models.Error{
+			Status: http.StatusBadRequest,
+			Msg:    ERR_INVALID_PICKUP,
+		}
+	}
+
+	if err = s.checkStationOpen(ctx, pickup, req.RentStart); err != nil {
+		return "", "", err
+	}
+	if err = s.checkStationOpen(ctx, dropOff, req.RentEnd); err != nil {
+		return "", "", err
+	}
+
+	return pickup, dropOff, nil
+}
+
+func (s *service) checkStationOpen(ctx context.Context, stationUUID string, at time.Time) error {
+	station, err := s.repo.GetStation(ctx, stationUUID)
+	if err != nil {
+		return err
+	}
+
+	if !station.IsOpenAt(at) {
+		return &models.Error{
+			Status: http.StatusBadRequest,
+			Msg:    fmt.Sprintf("the station %s is closed at %s", station.Name, at.Format(time.RFC3339)),
+		}
+	}
+
+	return nil
+}
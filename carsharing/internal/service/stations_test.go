@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"errors"
+	repomock "github.com/alserov/rently/carsharing/internal/db/mocks"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"github.com/alserov/rently/carsharing/internal/pricing"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestStation_IsOpenAt(t *testing.T) {
+	day := time.Date(2025, time.January, 8, 0, 0, 0, 0, time.UTC)
+
+	station := models.Station{OpensAt: 8 * 60, ClosesAt: 20 * 60, TimeZone: "UTC"}
+	require.True(t, station.IsOpenAt(day.Add(time.Hour*8)))
+	require.False(t, station.IsOpenAt(day.Add(time.Hour*20)))
+
+	// the station works over the midnight
+	station = models.Station{OpensAt: 20 * 60, ClosesAt: 2 * 60, TimeZone: "UTC"}
+	require.True(t, station.IsOpenAt(day.Add(time.Hour*1)))
+	require.False(t, station.IsOpenAt(day.Add(time.Hour*12)))
+
+	// the hours are in the time zone of the station, Tokyo is 9 hours ahead of UTC
+	station = models.Station{OpensAt: 8 * 60, ClosesAt: 20 * 60, TimeZone: "Asia/Tokyo"}
+	require.True(t, station.IsOpenAt(day))
+	require.False(t, station.IsOpenAt(day.Add(time.Hour*12)))
+
+	require.True(t, models.Station{}.IsOpenAt(day))
+}
+
+func TestService_QuotePriceStations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	start := time.Date(2025, time.January, 8, 10, 0, 0, 0, time.UTC)
+
+	repo := repomock.NewMockRepository(ctrl)
+	repo.EXPECT().GetCarPricing(gomock.Any(), "car").Return(models.CarPricing{
+		PricePerDay: models.NewMoney(100_00, models.DEFAULT_CURRENCY),
+		StationUUID: "home",
+	}, nil).AnyTimes()
+	repo.EXPECT().GetStation(gomock.Any(), "home").Return(models.Station{UUID: "home", OpensAt: 8 * 60, ClosesAt: 20 * 60}, nil).AnyTimes()
+	repo.EXPECT().GetStation(gomock.Any(), "airport").Return(models.Station{UUID: "airport"}, nil).AnyTimes()
+
+	s := NewService(Params{
+		Repo:    repo,
+		Pricing: pricing.NewEngine(pricing.OneWayFee{DayShare: 0.5}),
+	})
+
+	req := models.QuotePriceReq{CarUUID: "car", RentStart: start, RentEnd: start.Add(time.Hour * 24)}
+
+	quote, err := s.QuotePrice(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, models.NewMoney(100_00, models.DEFAULT_CURRENCY), quote.Price)
+
+	req.DropOffStation = "airport"
+	quote, err = s.QuotePrice(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, models.NewMoney(150_00, models.DEFAULT_CURRENCY), quote.Price)
+
+	// the car can not be picked up at the other station
+	var e *models.Error
+	_, err = s.QuotePrice(ctx, models.QuotePriceReq{CarUUID: "car", PickupStation: "airport", RentStart: start, RentEnd: start.Add(time.Hour)})
+	require.True(t, errors.As(err, &e))
+	require.Equal(t, http.StatusBadRequest, e.Status)
+
+	// the car is returned to the home station, when it is closed
+	_, err = s.QuotePrice(ctx, models.QuotePriceReq{CarUUID: "car", RentStart: start, RentEnd: start.Add(time.Hour * 12)})
+	require.True(t, errors.As(err, &e))
+	require.Equal(t, http.StatusBadRequest, e.Status)
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/alserov/rently/carsharing/internal/db"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"log/slog"
+	"net/http"
+)
+
+// rentTransitions describes statuses rent can be moved to from the current one,
+// completed, canceled and no-show rents are final
+var rentTransitions = map[string][]string{
+	models.RENT_STATUS_RESERVED: {models.RENT_STATUS_ACTIVE, models.RENT_STATUS_CANCELED, models.RENT_STATUS_NO_SHOW},
+	models.RENT_STATUS_ACTIVE:   {models.RENT_STATUS_COMPLETED},
+}
+
+func checkRentTransition(from, to string) error {
+	for _, status := range rentTransitions[from] {
+		if status == to {
+			return nil
+		}
+	}
+
+	return &models.Error{
+		Msg:    fmt.Sprintf("rent can not be switched from '%s' to '%s'", from, to),
+		Status: http.StatusConflict,
+	}
+}
+
+// checkRentStatusTx locks the rent row until the end of tx and checks if rent can be switched to the provided status
+func (s *service) checkRentStatusTx(ctx context.Context, tx db.SqlTx, rentUUID string, to string) error {
+	current, err := s.repo.GetRentStatusTx(ctx, tx, rentUUID)
+	if err != nil {
+		return err
+	}
+
+	return checkRentTransition(current, to)
+}
+
+func (s *service) switchRentStatus(ctx context.Context, rentUUID string, to string) error {
+	tx, err := s.repo.StartTx(ctx)
+	if err != nil {
+		return &models.Error{
+			Msg:    fmt.Sprintf("failed to start tx: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+	defer func() {
+		if err = tx.Rollback(); err != nil {
+			s.log.Warn("failed to rollback tx", slog.String("warn", err.Error()))
+		}
+	}()
+
+	if err = s.checkRentStatusTx(ctx, tx, rentUUID, to); err != nil {
+		return err
+	}
+
+	if err = s.repo.UpdateRentStatusTx(ctx, tx, rentUUID, to); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return &models.Error{
+			Msg:    fmt.Sprintf("failed to commit tx: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return nil
+}
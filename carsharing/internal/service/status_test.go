@@ -0,0 +1,40 @@
+package service
+
+import (
+	"errors"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"testing"
+)
+
+func TestCheckRentTransition(t *testing.T) {
+	tests := []struct {
+		from, to string
+		allowed  bool
+	}{
+		{from: models.RENT_STATUS_RESERVED, to: models.RENT_STATUS_ACTIVE, allowed: true},
+		{from: models.RENT_STATUS_RESERVED, to: models.RENT_STATUS_CANCELED, allowed: true},
+		{from: models.RENT_STATUS_RESERVED, to: models.RENT_STATUS_NO_SHOW, allowed: true},
+		{from: models.RENT_STATUS_RESERVED, to: models.RENT_STATUS_COMPLETED},
+		{from: models.RENT_STATUS_ACTIVE, to: models.RENT_STATUS_COMPLETED, allowed: true},
+		{from: models.RENT_STATUS_ACTIVE, to: models.RENT_STATUS_CANCELED},
+		{from: models.RENT_STATUS_ACTIVE, to: models.RENT_STATUS_NO_SHOW},
+		{from: models.RENT_STATUS_COMPLETED, to: models.RENT_STATUS_ACTIVE},
+		{from: models.RENT_STATUS_CANCELED, to: models.RENT_STATUS_ACTIVE},
+		{from: models.RENT_STATUS_CANCELED, to: models.RENT_STATUS_CANCELED},
+		{from: models.RENT_STATUS_NO_SHOW, to: models.RENT_STATUS_CANCELED},
+	}
+
+	for _, tc := range tests {
+		err := checkRentTransition(tc.from, tc.to)
+		if tc.allowed {
+			require.NoError(t, err, "%s -> %s", tc.from, tc.to)
+			continue
+		}
+
+		var e *models.Error
+		require.True(t, errors.As(err, &e), "%s -> %s", tc.from, tc.to)
+		require.Equal(t, http.StatusConflict, e.Status)
+	}
+}
@@ -0,0 +1,310 @@
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"testing/iotest"
+	"time"
+)
+
+// TEST_S3_ENDPOINT=http://localhost:9000 runs the s3 tests against MinIO instead of the fake
+const (
+	testS3Endpoint  = "TEST_S3_ENDPOINT"
+	testS3AccessKey = "TEST_S3_ACCESS_KEY"
+	testS3SecretKey = "TEST_S3_SECRET_KEY"
+	testS3Bucket    = "TEST_S3_BUCKET"
+)
+
+func TestBackends(t *testing.T) {
+	backends := map[string]Backend{
+		BACKEND_LOCAL:  NewLocalBackend(t.TempDir()),
+		BACKEND_MEMORY: NewMemoryBackend(),
+		BACKEND_S3:     newTestS3Backend(t),
+	}
+
+	for name, b := range backends {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			for _, path := range []string{"car1/image1", "car1/image2", "car2/image1"} {
+				require.NoError(t, b.Put(ctx, path, strings.NewReader(path)))
+			}
+
+			f, err := b.Get(ctx, "car1/image2")
+			require.NoError(t, err)
+			content, err := io.ReadAll(f)
+			require.NoError(t, err)
+			require.NoError(t, f.Close())
+			require.Equal(t, "car1/image2", string(content))
+
+			_, err = b.Get(ctx, "car3/image1")
+			require.ErrorIs(t, err, os.ErrNotExist)
+
+			for _, tc := range []struct {
+				offset, length int64
+				content        string
+			}{
+				{offset: 0, length: 0, content: "car1/image2"},
+				{offset: 5, length: 3, content: "ima"},
+				{offset: 5, length: 0, content: "image2"},
+				{offset: 10, length: 100, content: "2"},
+			} {
+				f, size, err := b.GetRange(ctx, "car1/image2", tc.offset, tc.length)
+				require.NoError(t, err)
+				content, err := io.ReadAll(f)
+				require.NoError(t, err)
+				require.NoError(t, f.Close())
+				require.Equal(t, tc.content, string(content))
+				require.Equal(t, int64(len("car1/image2")), size)
+			}
+
+			_, size, err := b.GetRange(ctx, "car1/image2", 11, 0)
+			require.ErrorIs(t, err, ErrInvalidRange)
+			require.Equal(t, int64(len("car1/image2")), size)
+
+			_, _, err = b.GetRange(ctx, "car3/image1", 0, 0)
+			require.ErrorIs(t, err, os.ErrNotExist)
+
+			require.Equal(t, []string{"car1/image1", "car1/image2", "car2/image1"}, walk(t, b))
+
+			// the bucket is deleted with all the images
+			require.NoError(t, b.Delete(ctx, "car1"))
+			require.Equal(t, []string{"car2/image1"}, walk(t, b))
+
+			require.NoError(t, b.Delete(ctx, "car2/image1"))
+			require.Empty(t, walk(t, b))
+		})
+	}
+}
+
+func TestLocalBackend_Path(t *testing.T) {
+	b := NewLocalBackend(t.TempDir())
+
+	for _, path := range []string{"../car/image", "/etc/passwd", "car/../../image"} {
+		_, err := b.Get(context.Background(), path)
+		require.Error(t, err, path)
+		require.NotErrorIs(t, err, os.ErrNotExist, path)
+	}
+}
+
+func TestLocalBackend_FailedPut(t *testing.T) {
+	ctx := context.Background()
+	b := NewLocalBackend(t.TempDir())
+
+	require.NoError(t, b.Put(ctx, "car1/image1", strings.NewReader("car1/image1")))
+
+	// the failed write neither replaces the image, nor leaves the temporary file
+	err := b.Put(ctx, "car1/image1", io.MultiReader(strings.NewReader("partial"), iotest.ErrReader(errors.New("connection reset"))))
+	require.Error(t, err)
+
+	f, err := b.Get(ctx, "car1/image1")
+	require.NoError(t, err)
+	content, err := io.ReadAll(f)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	require.Equal(t, "car1/image1", string(content))
+
+	require.Equal(t, []string{"car1/image1"}, walk(t, b))
+	entries, err := os.ReadDir(filepath.Join(b.(*localBackend).root, "car1"))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+}
+
+func TestMigrate(t *testing.T) {
+	ctx := context.Background()
+
+	from, to := NewMemoryBackend(), NewLocalBackend(t.TempDir())
+	for _, path := range []string{"car1/image1", "car1/image2", "car2/image1"} {
+		require.NoError(t, from.Put(ctx, path, strings.NewReader(path)))
+	}
+	require.NoError(t, to.Put(ctx, "car1/image1", strings.NewReader("car1/image1")))
+
+	copied, err := Migrate(ctx, from, to)
+	require.NoError(t, err)
+	require.Equal(t, 2, copied)
+	require.Equal(t, walk(t, from), walk(t, to))
+
+	// migration can be restarted
+	copied, err = Migrate(ctx, from, to)
+	require.NoError(t, err)
+	require.Zero(t, copied)
+}
+
+func walk(t *testing.T, b Backend) []string {
+	var paths []string
+	require.NoError(t, b.Walk(context.Background(), func(path string) error {
+		paths = append(paths, path)
+		return nil
+	}))
+	sort.Strings(paths)
+
+	return paths
+}
+
+func newTestS3Backend(t *testing.T) Backend {
+	p := S3Params{
+		Endpoint:  os.Getenv(testS3Endpoint),
+		Bucket:    os.Getenv(testS3Bucket),
+		AccessKey: os.Getenv(testS3AccessKey),
+		SecretKey: os.Getenv(testS3SecretKey),
+	}
+
+	if p.Endpoint == "" {
+		srv := httptest.NewServer(newFakeS3("images"))
+		t.Cleanup(srv.Close)
+
+		p = S3Params{Endpoint: srv.URL, Bucket: "images", AccessKey: "access", SecretKey: "secret"}
+	}
+
+	b, err := NewS3Backend(p)
+	require.NoError(t, err)
+
+	return b
+}
+
+// fakeS3 implements the subset of S3 api used by the backend, the page size is small to test the pagination
+type fakeS3 struct {
+	bucket string
+
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+const fakeS3PageSize = 2
+
+func newFakeS3(bucket string) *fakeS3 {
+	return &fakeS3{
+		bucket:  bucket,
+		objects: make(map[string][]byte),
+	}
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256") {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if bucket != f.bucket {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch {
+	case r.Method == http.MethodPut:
+		b, err := readS3Payload(r)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.objects[key] = b
+	case r.Method == http.MethodGet && key == "":
+		f.list(w, r)
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		b, ok := f.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		// ServeContent handles the Range header, the client requires the modification time
+		http.ServeContent(w, r, key, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), bytes.NewReader(b))
+	case r.Method == http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// readS3Payload decodes the aws-chunked body, the client streams the signed chunks over plain http
+func readS3Payload(r *http.Request) ([]byte, error) {
+	if r.Header.Get("X-Amz-Content-Sha256") != "STREAMING-AWS4-HMAC-SHA256-PAYLOAD" {
+		return io.ReadAll(r.Body)
+	}
+
+	var (
+		body    = bufio.NewReader(r.Body)
+		payload []byte
+	)
+	for {
+		// <hex size>;chunk-signature=<signature>\r\n<data>\r\n
+		header, err := body.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		hexSize, _, _ := strings.Cut(header, ";")
+		size, err := strconv.ParseInt(hexSize, 16, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		chunk := make([]byte, size+2)
+		if _, err = io.ReadFull(body, chunk); err != nil {
+			return nil, err
+		}
+		if size == 0 {
+			return payload, nil
+		}
+		payload = append(payload, chunk[:size]...)
+	}
+}
+
+type fakeS3ListResult struct {
+	XMLName  xml.Name `xml:"ListBucketResult"`
+	Contents []struct {
+		Key string `xml:"Key"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+func (f *fakeS3) list(w http.ResponseWriter, r *http.Request) {
+	var keys []string
+	for key := range f.objects {
+		if strings.HasPrefix(key, r.URL.Query().Get("prefix")) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	// the continuation token is the last key of the previous page
+	if token := r.URL.Query().Get("continuation-token"); token != "" {
+		i := sort.SearchStrings(keys, token)
+		for i < len(keys) && keys[i] <= token {
+			i++
+		}
+		keys = keys[i:]
+	}
+
+	var res fakeS3ListResult
+	if len(keys) > fakeS3PageSize {
+		keys = keys[:fakeS3PageSize]
+		res.IsTruncated = true
+		res.NextContinuationToken = keys[len(keys)-1]
+	}
+	for _, key := range keys {
+		res.Contents = append(res.Contents, struct {
+			Key string `xml:"Key"`
+		}{Key: key})
+	}
+
+	_ = xml.NewEncoder(w).Encode(res)
+}
@@ -1,69 +1,188 @@
 package storage
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
-	"github.com/Shopify/go-storage"
-	"github.com/alserov/rently/carsharing/internal/log"
+	"github.com/alserov/rently/carsharing/internal/config"
+	"github.com/alserov/rently/carsharing/internal/models"
 	"github.com/google/uuid"
 	"io"
+	"os"
 )
 
 type ImageStorage interface {
 	Save(ctx context.Context, bucket string, f io.Reader) (string, error)
+	// SaveVariants stores all the variants of the image under the same id
+	SaveVariants(ctx context.Context, bucket string, variants map[string][]byte) (string, error)
 	Get(ctx context.Context, path string) (io.ReadCloser, error)
+	// GetRange returns the part of the image and the size of the whole image, zero length means up to the end,
+	// the size is returned with ErrInvalidRange too
+	GetRange(ctx context.Context, path string, offset, length int64) (io.ReadCloser, int64, error)
 	Delete(ctx context.Context, key string) error
+	// Walk calls fn with the path of every stored file, variants included
+	Walk(ctx context.Context, fn func(path string) error) error
 }
 
-const path = "./files/images"
+// Backend stores the images by their paths, the path is '<bucket>/<id>'
+type Backend interface {
+	Put(ctx context.Context, path string, f io.Reader) error
+	Get(ctx context.Context, path string) (io.ReadCloser, error)
+	// GetRange returns ErrInvalidRange and the size of the image, if the offset is not inside the image
+	GetRange(ctx context.Context, path string, offset, length int64) (io.ReadCloser, int64, error)
+	// Delete removes the image by the path or all the images of the bucket
+	Delete(ctx context.Context, path string) error
+	// Walk calls fn with the path of every stored image
+	Walk(ctx context.Context, fn func(path string) error) error
+}
+
+const (
+	BACKEND_LOCAL  = "local"
+	BACKEND_S3     = "s3"
+	BACKEND_MEMORY = "memory"
+)
+
+const DEFAULT_LOCAL_PATH = "./files/images"
+
+var ErrInvalidRange = errors.New("invalid range")
+
+func MustNewBackend(cfg config.Storage, backend string) Backend {
+	switch backend {
+	case BACKEND_LOCAL:
+		path := cfg.Local.Path
+		if path == "" {
+			path = DEFAULT_LOCAL_PATH
+		}
+		return NewLocalBackend(path)
+	case BACKEND_S3:
+		b, err := NewS3Backend(S3Params{
+			Endpoint:  cfg.S3.Endpoint,
+			Region:    cfg.S3.Region,
+			Bucket:    cfg.S3.Bucket,
+			AccessKey: cfg.S3.AccessKey,
+			SecretKey: cfg.S3.SecretKey,
+		})
+		if err != nil {
+			panic("failed to init s3 storage: " + err.Error())
+		}
+		return b
+	case BACKEND_MEMORY:
+		return NewMemoryBackend()
+	default:
+		panic("unknown storage backend: " + backend)
+	}
+}
 
-func NewImageStorage() ImageStorage {
+func NewImageStorage(backend Backend) ImageStorage {
 	return &imageStorage{
-		s: storage.NewLocalFS(path),
+		b: backend,
 	}
 }
 
 type imageStorage struct {
-	s storage.FS
+	b Backend
 }
 
 func (is imageStorage) Get(ctx context.Context, path string) (io.ReadCloser, error) {
-	f, err := is.s.Open(ctx, fmt.Sprintf("/%s", path), &storage.ReaderOptions{})
+	f, err := is.b.Get(ctx, path)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get file: %v", err)
+		return nil, fmt.Errorf("failed to get file: %w", err)
 	}
 
 	return f, nil
 }
 
+func (is imageStorage) GetRange(ctx context.Context, path string, offset, length int64) (io.ReadCloser, int64, error) {
+	f, size, err := is.b.GetRange(ctx, path, offset, length)
+	if err != nil {
+		return nil, size, fmt.Errorf("failed to get file: %w", err)
+	}
+
+	return f, size, nil
+}
+
 func (is imageStorage) Save(ctx context.Context, bucket string, f io.Reader) (string, error) {
 	id := uuid.New().String()
 
-	w, err := is.s.Create(ctx, fmt.Sprintf("/%s/%s", bucket, id), &storage.WriterOptions{})
-	defer func() {
-		if err = w.Close(); err != nil {
-			log.GetLogger().Err("failed to close file", err)
-		}
-	}()
-	if err != nil {
-		return "", fmt.Errorf("failed to create file: %v", err)
+	if err := is.b.Put(ctx, fmt.Sprintf("%s/%s", bucket, id), f); err != nil {
+		return "", fmt.Errorf("failed to save image: %w", err)
 	}
 
-	data, err := io.ReadAll(f)
-	if err != nil {
-		return "", fmt.Errorf("failed to read file: %v", err)
+	return id, nil
+}
+
+func (is imageStorage) SaveVariants(ctx context.Context, bucket string, variants map[string][]byte) (string, error) {
+	id := uuid.New().String()
+	path := fmt.Sprintf("%s/%s", bucket, id)
+
+	// the original is saved last, so the original without the variants is always the image,
+	// uploaded before the variants were introduced, and never the interrupted upload
+	for variant, b := range variants {
+		if variant == models.IMAGE_VARIANT_ORIGINAL {
+			continue
+		}
+		if err := is.b.Put(ctx, VariantPath(path, variant), bytes.NewReader(b)); err != nil {
+			return "", fmt.Errorf("failed to save %s image: %w", variant, err)
+		}
 	}
 
-	if _, err = w.Write(data); err != nil {
-		return "", fmt.Errorf("failed to save image: %v", err)
+	if b, ok := variants[models.IMAGE_VARIANT_ORIGINAL]; ok {
+		if err := is.b.Put(ctx, path, bytes.NewReader(b)); err != nil {
+			return "", fmt.Errorf("failed to save %s image: %w", models.IMAGE_VARIANT_ORIGINAL, err)
+		}
 	}
 
 	return id, nil
 }
 
+// VariantPath the original is stored by the path of the image, so the images,
+// uploaded before the variants were introduced, are still valid originals
+func VariantPath(path, variant string) string {
+	if variant == "" || variant == models.IMAGE_VARIANT_ORIGINAL {
+		return path
+	}
+	return path + "." + variant
+}
+
+func (is imageStorage) Walk(ctx context.Context, fn func(path string) error) error {
+	return is.b.Walk(ctx, fn)
+}
+
 func (is imageStorage) Delete(ctx context.Context, key string) error {
-	if err := is.s.Delete(ctx, key); err != nil {
-		return fmt.Errorf("failed to delete file: %v", err)
+	if err := is.b.Delete(ctx, key); err != nil {
+		return fmt.Errorf("failed to delete file: %w", err)
 	}
 	return nil
 }
+
+// Migrate copies all the images, which are missing in the target backend, the paths are kept,
+// so the image ids, stored with the cars, stay valid
+func Migrate(ctx context.Context, from, to Backend) (int, error) {
+	var copied int
+
+	err := from.Walk(ctx, func(path string) error {
+		existing, err := to.Get(ctx, path)
+		if err == nil {
+			return existing.Close()
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to check %s: %w", path, err)
+		}
+
+		f, err := from.Get(ctx, path)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		defer f.Close()
+
+		if err = to.Put(ctx, path, f); err != nil {
+			return fmt.Errorf("failed to copy %s: %w", path, err)
+		}
+
+		copied++
+		return nil
+	})
+
+	return copied, err
+}
@@ -0,0 +1,164 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LOCAL_TEMP_PREFIX marks the images, which are being written, they are skipped by Walk
+const LOCAL_TEMP_PREFIX = ".tmp-"
+
+// NewLocalBackend stores the images in the directory, it is not shared between the instances of the service
+func NewLocalBackend(root string) Backend {
+	return &localBackend{root: root}
+}
+
+type localBackend struct {
+	root string
+}
+
+func (l *localBackend) Put(_ context.Context, path string, f io.Reader) error {
+	p, err := l.path(path)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		return fmt.Errorf("failed to create bucket: %w", err)
+	}
+
+	// the image is written to the temporary file and renamed, so the readers never see the partly written image
+	tmp, err := os.CreateTemp(filepath.Dir(p), LOCAL_TEMP_PREFIX+filepath.Base(p)+"-*")
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tmp.Close()
+			_ = os.Remove(tmp.Name())
+		}
+	}()
+
+	if _, err = io.Copy(tmp, f); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+
+	if err = tmp.Sync(); err != nil {
+		return fmt.Errorf("failed to sync file: %w", err)
+	}
+
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close file: %w", err)
+	}
+
+	if err = os.Rename(tmp.Name(), p); err != nil {
+		return fmt.Errorf("failed to rename file: %w", err)
+	}
+
+	return syncDir(filepath.Dir(p))
+}
+
+func (l *localBackend) Get(_ context.Context, path string) (io.ReadCloser, error) {
+	p, err := l.path(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return os.Open(p)
+}
+
+func (l *localBackend) GetRange(_ context.Context, path string, offset, length int64) (io.ReadCloser, int64, error) {
+	p, err := l.path(path)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	f, err := os.Open(p)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return nil, 0, err
+	}
+
+	r, err := rangeReader(f, info.Size(), offset, length)
+	if err != nil {
+		_ = f.Close()
+		return nil, info.Size(), err
+	}
+
+	return struct {
+		io.Reader
+		io.Closer
+	}{r, f}, info.Size(), nil
+}
+
+func (l *localBackend) Delete(_ context.Context, path string) error {
+	p, err := l.path(path)
+	if err != nil {
+		return err
+	}
+
+	return os.RemoveAll(p)
+}
+
+func (l *localBackend) Walk(ctx context.Context, fn func(path string) error) error {
+	err := filepath.WalkDir(l.root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if d.IsDir() || strings.HasPrefix(d.Name(), LOCAL_TEMP_PREFIX) {
+			return nil
+		}
+
+		rel, err := filepath.Rel(l.root, p)
+		if err != nil {
+			return err
+		}
+
+		return fn(filepath.ToSlash(rel))
+	})
+	if errors.Is(err, os.ErrNotExist) {
+		// nothing has been saved yet
+		return nil
+	}
+
+	return err
+}
+
+// syncDir persists the rename of the file in the directory
+func syncDir(path string) error {
+	dir, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open bucket: %w", err)
+	}
+	defer dir.Close()
+
+	if err = dir.Sync(); err != nil {
+		return fmt.Errorf("failed to sync bucket: %w", err)
+	}
+
+	return nil
+}
+
+// path resolves the image path in the root, the paths come from the requests, so they can not leave the root
+func (l *localBackend) path(path string) (string, error) {
+	p := filepath.FromSlash(path)
+	if !filepath.IsLocal(p) {
+		return "", fmt.Errorf("invalid image path: %s", path)
+	}
+
+	return filepath.Join(l.root, p), nil
+}
@@ -0,0 +1,114 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// NewMemoryBackend keeps the images in the process memory, it is meant for tests and local runs
+func NewMemoryBackend() Backend {
+	return &memoryBackend{
+		images: make(map[string][]byte),
+	}
+}
+
+type memoryBackend struct {
+	mu     sync.RWMutex
+	images map[string][]byte
+}
+
+func (m *memoryBackend) Put(_ context.Context, path string, f io.Reader) error {
+	b, err := io.ReadAll(f)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.images[path] = b
+	return nil
+}
+
+func (m *memoryBackend) Get(_ context.Context, path string) (io.ReadCloser, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	b, ok := m.images[path]
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", path, os.ErrNotExist)
+	}
+
+	return io.NopCloser(bytes.NewReader(b)), nil
+}
+
+func (m *memoryBackend) GetRange(_ context.Context, path string, offset, length int64) (io.ReadCloser, int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	b, ok := m.images[path]
+	if !ok {
+		return nil, 0, fmt.Errorf("%s: %w", path, os.ErrNotExist)
+	}
+
+	r, err := rangeReader(bytes.NewReader(b), int64(len(b)), offset, length)
+	if err != nil {
+		return nil, int64(len(b)), err
+	}
+
+	return io.NopCloser(r), int64(len(b)), nil
+}
+
+// rangeReader limits the reader to the range, the range is checked against the size of the image
+func rangeReader(r io.ReadSeeker, size, offset, length int64) (io.Reader, error) {
+	if offset < 0 || length < 0 || (offset > 0 && offset >= size) {
+		return nil, fmt.Errorf("%d-%d of %d: %w", offset, offset+length, size, ErrInvalidRange)
+	}
+
+	if _, err := r.Seek(offset, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("failed to seek: %w", err)
+	}
+
+	if length == 0 || offset+length > size {
+		length = size - offset
+	}
+
+	return io.LimitReader(r, length), nil
+}
+
+func (m *memoryBackend) Delete(_ context.Context, path string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for p := range m.images {
+		if p == path || strings.HasPrefix(p, path+"/") {
+			delete(m.images, p)
+		}
+	}
+
+	return nil
+}
+
+func (m *memoryBackend) Walk(_ context.Context, fn func(path string) error) error {
+	m.mu.RLock()
+	paths := make([]string, 0, len(m.images))
+	for p := range m.images {
+		paths = append(paths, p)
+	}
+	m.mu.RUnlock()
+
+	sort.Strings(paths)
+	for _, p := range paths {
+		if err := fn(p); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
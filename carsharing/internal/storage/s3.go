@@ -0,0 +1,173 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+type S3Params struct {
+	// Endpoint is the url of the S3 compatible storage, e.g. http://localhost:9000 for MinIO
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+
+	// Transport is the default one of the client, if it is not set
+	Transport http.RoundTripper
+}
+
+const DEFAULT_S3_REGION = "us-east-1"
+
+// NewS3Backend stores the images in the bucket of S3 compatible storage,
+// the requests are path-style, so MinIO works without the dns setup
+func NewS3Backend(p S3Params) (Backend, error) {
+	endpoint, err := url.Parse(p.Endpoint)
+	if err != nil || endpoint.Host == "" || strings.Trim(endpoint.Path, "/") != "" {
+		return nil, fmt.Errorf("invalid endpoint: %s", p.Endpoint)
+	}
+
+	if p.Bucket == "" {
+		return nil, errors.New("bucket is not provided")
+	}
+
+	if p.Region == "" {
+		p.Region = DEFAULT_S3_REGION
+	}
+
+	client, err := minio.New(endpoint.Host, &minio.Options{
+		Creds:        credentials.NewStaticV4(p.AccessKey, p.SecretKey, ""),
+		Secure:       endpoint.Scheme == "https",
+		Region:       p.Region,
+		BucketLookup: minio.BucketLookupPath,
+		Transport:    p.Transport,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create s3 client: %w", err)
+	}
+
+	return &s3Backend{
+		client: client,
+		bucket: p.Bucket,
+	}, nil
+}
+
+type s3Backend struct {
+	client *minio.Client
+	bucket string
+}
+
+func (s *s3Backend) Put(ctx context.Context, path string, f io.Reader) error {
+	// images are small, with the known size the object is uploaded with a single request
+	b, err := io.ReadAll(f)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+
+	if _, err = s.client.PutObject(ctx, s.bucket, path, bytes.NewReader(b), int64(len(b)), minio.PutObjectOptions{}); err != nil {
+		return s3Error(path, err)
+	}
+
+	return nil
+}
+
+func (s *s3Backend) Get(ctx context.Context, path string) (io.ReadCloser, error) {
+	// the object is requested lazily, so it is stated to report the missing object right away
+	obj, err := s.client.GetObject(ctx, s.bucket, path, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, s3Error(path, err)
+	}
+
+	if _, err = obj.Stat(); err != nil {
+		_ = obj.Close()
+		return nil, s3Error(path, err)
+	}
+
+	return obj, nil
+}
+
+func (s *s3Backend) GetRange(ctx context.Context, path string, offset, length int64) (io.ReadCloser, int64, error) {
+	// the size of the ranged response is the size of the range, so the whole size is requested separately
+	info, err := s.client.StatObject(ctx, s.bucket, path, minio.StatObjectOptions{})
+	if err != nil {
+		return nil, 0, s3Error(path, err)
+	}
+
+	if offset < 0 || length < 0 || (offset > 0 && offset >= info.Size) {
+		return nil, info.Size, fmt.Errorf("%d-%d of %d: %w", offset, offset+length, info.Size, ErrInvalidRange)
+	}
+
+	var opts minio.GetObjectOptions
+	switch {
+	case length > 0:
+		err = opts.SetRange(offset, offset+length-1)
+	case offset > 0:
+		err = opts.SetRange(offset, 0)
+	}
+	if err != nil {
+		return nil, info.Size, fmt.Errorf("%d-%d: %w", offset, offset+length, ErrInvalidRange)
+	}
+
+	// the object is already stated, the stat of the ranged object would drop the range
+	obj, err := s.client.GetObject(ctx, s.bucket, path, opts)
+	if err != nil {
+		return nil, 0, s3Error(path, err)
+	}
+
+	return obj, info.Size, nil
+}
+
+func (s *s3Backend) Delete(ctx context.Context, path string) error {
+	if err := s.client.RemoveObject(ctx, s.bucket, path, minio.RemoveObjectOptions{}); err != nil {
+		return s3Error(path, err)
+	}
+
+	// S3 has no directories, so the images of the bucket are found by the prefix
+	return s.list(ctx, path+"/", func(key string) error {
+		if err := s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{}); err != nil {
+			return s3Error(key, err)
+		}
+		return nil
+	})
+}
+
+func (s *s3Backend) Walk(ctx context.Context, fn func(path string) error) error {
+	return s.list(ctx, "", fn)
+}
+
+// list calls fn with the keys of the prefix
+func (s *s3Backend) list(ctx context.Context, prefix string, fn func(key string) error) error {
+	// the listing is stopped, if fn fails
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	for obj := range s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if obj.Err != nil {
+			return fmt.Errorf("failed to list objects: %w", obj.Err)
+		}
+
+		if err := fn(obj.Key); err != nil {
+			return err
+		}
+	}
+
+	return ctx.Err()
+}
+
+// s3Error missing objects are reported as os.ErrNotExist
+func s3Error(path string, err error) error {
+	if minio.ToErrorResponse(err).StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%s: %w", path, os.ErrNotExist)
+	}
+
+	return fmt.Errorf("s3 error: %w", err)
+}
@@ -1,9 +1,12 @@
 package convertation
 
 import (
+	"encoding/json"
+	"fmt"
 	"github.com/alserov/rently/carsharing/internal/models"
 	"github.com/alserov/rently/proto/gen/carsharing"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"sort"
 	"time"
 )
 
@@ -18,17 +21,34 @@ type PbToModel interface {
 
 	GetCarsByParamsReqToService(req *carsharing.GetCarsByParamsReq) models.CarParams
 	GetAvailableCarsReqToService(req *carsharing.GetAvailableCarsReq) models.Period
+	AvailableCarsParamsToService(req *carsharing.GetAvailableCarsReq) models.CarParams
+	SearchCarsReqToService(req *carsharing.SearchCarsReq) models.SearchCarsReq
+	SearchCarsResToPb(res models.SearchCarsRes) *carsharing.SearchCarsRes
+	PageToService(req *carsharing.Page) models.Page
+	QuotePriceReqToService(req *carsharing.QuotePriceReq) models.QuotePriceReq
 
+	UpdateCarReqToService(req *carsharing.UpdateCarReq) models.UpdateCarReq
 	UpdateCarPriceReqToService(req *carsharing.UpdateCarPriceReq) models.UpdateCarPriceReq
+	CreatePromoCodeReqToService(req *carsharing.CreatePromoCodeReq) models.PromoCode
+	StationToService(req *carsharing.Station) models.Station
+	AreaToService(req *carsharing.Area) models.Area
+	ScheduleMaintenanceReqToService(req *carsharing.ScheduleMaintenanceReq) models.Maintenance
+	CloseMaintenanceReqToService(req *carsharing.CloseMaintenanceReq) models.CloseMaintenanceReq
 }
 
 type ModelToPb interface {
 	CreateRentToPb(res models.CreateRentRes) *carsharing.CreateRentRes
 	CheckRentToPb(res models.Rent) *carsharing.CheckRentRes
 	RentsStartOnDateToPb(res []models.RentStartData) *carsharing.GetRentStartingOnDateRes
-	CarsToPb(res []models.CarMainInfo) *carsharing.GetCarsRes
+	CarsToPb(res models.CarsPage) *carsharing.GetCarsRes
 	CarToPb(res models.Car) *carsharing.Car
 	GetImageResToPb(res []byte) *carsharing.GetImageRes
+	CarImagesToPb(res []models.CarImage) *carsharing.GetCarImagesRes
+	PriceQuoteToPb(res models.PriceQuote) *carsharing.QuotePriceRes
+	CarChangesToPb(res []models.CarChange) *carsharing.GetCarChangesRes
+	StationsToPb(res []models.Station) *carsharing.GetStationsRes
+	ScheduleMaintenanceResToPb(res models.ScheduleMaintenanceRes) *carsharing.ScheduleMaintenanceRes
+	MaintenancesToPb(res models.CarMaintenances) *carsharing.GetMaintenancesRes
 }
 
 func NewServerConverter() ServerConverter {
@@ -61,7 +81,25 @@ func (s *serverConverter) GetImageResToPb(res []byte) *carsharing.GetImageRes {
 func (s *serverConverter) UpdateCarPriceReqToService(req *carsharing.UpdateCarPriceReq) models.UpdateCarPriceReq {
 	return models.UpdateCarPriceReq{
 		CarUUID: req.CarUUID,
-		Price:   req.PricePerDay,
+		Price:   s.moneyToService(req.Price, req.PricePerDay),
+	}
+}
+
+func (s *serverConverter) UpdateCarReqToService(req *carsharing.UpdateCarReq) models.UpdateCarReq {
+	car := req.GetCar()
+
+	return models.UpdateCarReq{
+		CarUUID: req.CarUUID,
+		Car: models.Car{
+			Brand:       car.GetBrand(),
+			Type:        car.GetType(),
+			MaxSpeed:    car.GetMaxSpeed(),
+			Seats:       car.GetSeats(),
+			Category:    car.GetCategory(),
+			PricePerDay: s.moneyToService(car.GetPrice(), car.GetPricePerDay()),
+			StationUUID: car.GetStationUUID(),
+		},
+		Fields: req.GetUpdateMask().GetPaths(),
 	}
 }
 
@@ -72,8 +110,34 @@ func (s *serverConverter) CreateCarReqToService(req *carsharing.CreateCarReq) mo
 		MaxSpeed:    req.MaxSpeed,
 		Seats:       req.Seats,
 		Category:    req.Category,
-		PricePerDay: req.PricePerDay,
+		PricePerDay: s.moneyToService(req.Price, req.PricePerDay),
+		StationUUID: req.StationUUID,
+	}
+}
+
+func (s *serverConverter) CreatePromoCodeReqToService(req *carsharing.CreatePromoCodeReq) models.PromoCode {
+	promo := models.PromoCode{
+		Code:           req.Code,
+		Percent:        int(req.Percent),
+		MaxUses:        int(req.MaxUses),
+		MaxUsesPerUser: int(req.MaxUsesPerUser),
+		Categories:     req.Categories,
 	}
+
+	if req.Amount != nil {
+		promo.Amount = models.NewMoney(req.Amount.Amount, req.Amount.Currency)
+	}
+
+	if req.ValidFrom != nil {
+		promo.ValidFrom = req.ValidFrom.AsTime()
+	}
+
+	if req.ValidUntil != nil {
+		validUntil := req.ValidUntil.AsTime()
+		promo.ValidUntil = &validUntil
+	}
+
+	return promo
 }
 
 func (s *serverConverter) CreateRentToPb(res models.CreateRentRes) *carsharing.CreateRentRes {
@@ -84,12 +148,96 @@ func (s *serverConverter) CreateRentToPb(res models.CreateRentRes) *carsharing.C
 
 func (s *serverConverter) GetCarsByParamsReqToService(req *carsharing.GetCarsByParamsReq) models.CarParams {
 	return models.CarParams{
-		Brand:       req.Brand,
-		Type:        req.Type,
-		MaxSpeed:    req.MaxSpeed,
-		Seats:       req.Seats,
-		Category:    req.Category,
-		PricePerDay: req.PricePerDay,
+		Brand:    req.Brand,
+		Type:     req.Type,
+		MaxSpeed: req.MaxSpeed,
+		Seats:    req.Seats,
+		Category: req.Category,
+		MaxPrice: s.moneyToService(req.MaxPrice, req.PricePerDay),
+		MinPrice: s.moneyToService(req.MinPrice, 0),
+	}
+}
+
+func (s *serverConverter) AvailableCarsParamsToService(req *carsharing.GetAvailableCarsReq) models.CarParams {
+	return models.CarParams{
+		Brand:    req.Brand,
+		Type:     req.Type,
+		MaxSpeed: req.MaxSpeed,
+		Seats:    req.Seats,
+		Category: req.Category,
+		MaxPrice: s.moneyToService(req.MaxPrice, 0),
+		MinPrice: s.moneyToService(req.MinPrice, 0),
+
+		StationUUID: req.StationUUID,
+		Near:        s.AreaToService(req.Near),
+	}
+}
+
+func (s *serverConverter) AreaToService(req *carsharing.Area) models.Area {
+	return models.Area{
+		Latitude:  req.GetLatitude(),
+		Longitude: req.GetLongitude(),
+		RadiusKm:  req.GetRadiusKm(),
+	}
+}
+
+func (s *serverConverter) StationToService(req *carsharing.Station) models.Station {
+	timeZone := req.GetTimeZone()
+	if timeZone == "" {
+		timeZone = "UTC"
+	}
+
+	return models.Station{
+		Name:      req.GetName(),
+		Address:   req.GetAddress(),
+		Latitude:  req.GetLatitude(),
+		Longitude: req.GetLongitude(),
+		OpensAt:   dayMinutes(req.GetOpensAt()),
+		ClosesAt:  dayMinutes(req.GetClosesAt()),
+		TimeZone:  timeZone,
+		Capacity:  int(req.GetCapacity()),
+	}
+}
+
+func (s *serverConverter) StationsToPb(res []models.Station) *carsharing.GetStationsRes {
+	stations := make([]*carsharing.Station, 0, len(res))
+	for _, station := range res {
+		stations = append(stations, &carsharing.Station{
+			UUID:      station.UUID,
+			Name:      station.Name,
+			Address:   station.Address,
+			Latitude:  station.Latitude,
+			Longitude: station.Longitude,
+			OpensAt:   dayTime(station.OpensAt),
+			ClosesAt:  dayTime(station.ClosesAt),
+			TimeZone:  station.TimeZone,
+			Capacity:  int32(station.Capacity),
+		})
+	}
+
+	return &carsharing.GetStationsRes{Stations: stations}
+}
+
+// dayMinutes returns the minutes from the midnight, empty time is the midnight
+func dayMinutes(dayTime string) int {
+	t, err := time.Parse(models.DAY_TIME_FORMAT, dayTime)
+	if err != nil {
+		return 0
+	}
+
+	return t.Hour()*60 + t.Minute()
+}
+
+func dayTime(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+func (s *serverConverter) PageToService(req *carsharing.Page) models.Page {
+	return models.Page{
+		Size:       int(req.GetSize()),
+		Token:      req.GetToken(),
+		SortBy:     req.GetSortBy(),
+		Descending: req.GetDescending(),
 	}
 }
 
@@ -100,23 +248,155 @@ func (s *serverConverter) CarToPb(res models.Car) *carsharing.Car {
 		MaxSpeed:    res.MaxSpeed,
 		Seats:       res.Seats,
 		Category:    res.Category,
-		PricePerDay: res.PricePerDay,
+		PricePerDay: res.PricePerDay.Units(),
+		Price:       s.moneyToPb(res.PricePerDay),
 		UUID:        res.UUID,
 		Images:      res.Images,
+		MainImage:   res.MainImage,
+		StationUUID: res.StationUUID,
 	}
 }
 
-func (s *serverConverter) CarsToPb(res []models.CarMainInfo) *carsharing.GetCarsRes {
-	var cars carsharing.GetCarsRes
+func (s *serverConverter) CarImagesToPb(res []models.CarImage) *carsharing.GetCarImagesRes {
+	images := make([]*carsharing.CarImage, 0, len(res))
+	for _, image := range res {
+		images = append(images, &carsharing.CarImage{
+			Id:       image.ID,
+			Position: image.Position,
+			Main:     image.Main,
+			Variants: image.Variants,
+		})
+	}
+
+	return &carsharing.GetCarImagesRes{Images: images}
+}
+
+func (s *serverConverter) CarChangesToPb(res []models.CarChange) *carsharing.GetCarChangesRes {
+	changes := make([]*carsharing.CarChange, 0, len(res))
+	for _, change := range res {
+		c := &carsharing.CarChange{
+			ID:        change.ID,
+			Actor:     change.Actor,
+			Action:    change.Action,
+			ChangedAt: s.timeToTimestampPb(change.ChangedAt),
+		}
+
+		for field, value := range change.Changes {
+			c.Changes = append(c.Changes, &carsharing.FieldChange{
+				Field: field,
+				Old:   jsonValue(value.Old),
+				New:   jsonValue(value.New),
+			})
+		}
+		// the fields are sorted, so the response does not depend on the map order
+		sort.Slice(c.Changes, func(i, j int) bool {
+			return c.Changes[i].Field < c.Changes[j].Field
+		})
 
-	for _, v := range res {
+		changes = append(changes, c)
+	}
+
+	return &carsharing.GetCarChangesRes{Changes: changes}
+}
+
+func (s *serverConverter) ScheduleMaintenanceReqToService(req *carsharing.ScheduleMaintenanceReq) models.Maintenance {
+	m := models.Maintenance{
+		CarUUID:    req.CarUUID,
+		Kind:       req.Kind,
+		Reason:     req.Reason,
+		IntervalKm: req.IntervalKm,
+	}
+
+	if req.Start != nil {
+		m.Start = req.Start.AsTime()
+	}
+
+	if req.End != nil {
+		end := req.End.AsTime()
+		m.End = &end
+	}
+
+	return m
+}
+
+func (s *serverConverter) CloseMaintenanceReqToService(req *carsharing.CloseMaintenanceReq) models.CloseMaintenanceReq {
+	return models.CloseMaintenanceReq{
+		UUID:    req.UUID,
+		Mileage: req.Mileage,
+	}
+}
+
+func (s *serverConverter) ScheduleMaintenanceResToPb(res models.ScheduleMaintenanceRes) *carsharing.ScheduleMaintenanceRes {
+	conflicts := make([]*carsharing.RentConflict, 0, len(res.Conflicts))
+	for _, conflict := range res.Conflicts {
+		conflicts = append(conflicts, &carsharing.RentConflict{
+			RentUUID:  conflict.RentUUID,
+			Status:    conflict.Status,
+			RentStart: s.timeToTimestampPb(conflict.RentStart),
+			RentEnd:   s.timeToTimestampPb(conflict.RentEnd),
+		})
+	}
+
+	return &carsharing.ScheduleMaintenanceRes{
+		UUID:      res.UUID,
+		Conflicts: conflicts,
+	}
+}
+
+func (s *serverConverter) MaintenancesToPb(res models.CarMaintenances) *carsharing.GetMaintenancesRes {
+	maintenances := make([]*carsharing.Maintenance, 0, len(res.Maintenances))
+	for _, m := range res.Maintenances {
+		maintenance := &carsharing.Maintenance{
+			UUID:       m.UUID,
+			CarUUID:    m.CarUUID,
+			Kind:       m.Kind,
+			Reason:     m.Reason,
+			Start:      s.timeToTimestampPb(m.Start),
+			IntervalKm: m.IntervalKm,
+			Mileage:    m.Mileage,
+			Actor:      m.Actor,
+		}
+
+		if m.End != nil {
+			maintenance.End = s.timeToTimestampPb(*m.End)
+		}
+		if m.ClosedAt != nil {
+			maintenance.ClosedAt = s.timeToTimestampPb(*m.ClosedAt)
+		}
+
+		maintenances = append(maintenances, maintenance)
+	}
+
+	return &carsharing.GetMaintenancesRes{
+		Maintenances:       maintenances,
+		NextServiceMileage: res.NextServiceMileage,
+	}
+}
+
+func jsonValue(v any) string {
+	if v == nil {
+		return ""
+	}
+
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+func (s *serverConverter) CarsToPb(res models.CarsPage) *carsharing.GetCarsRes {
+	cars := carsharing.GetCarsRes{
+		NextPageToken: res.NextPageToken,
+	}
+
+	for _, v := range res.Cars {
 		c := &carsharing.CarMainInfo{
 			Brand:       v.Brand,
 			Type:        v.Type,
 			Category:    v.Category,
-			PricePerDay: v.PricePerDay,
+			PricePerDay: v.PricePerDay.Units(),
+			Price:       s.moneyToPb(v.PricePerDay),
 			UUID:        v.UUID,
 			Image:       v.Image,
+			StationUUID: v.StationUUID,
 		}
 		cars.Cars = append(cars.Cars, c)
 	}
@@ -124,6 +404,39 @@ func (s *serverConverter) CarsToPb(res []models.CarMainInfo) *carsharing.GetCars
 	return &cars
 }
 
+func (s *serverConverter) SearchCarsReqToService(req *carsharing.SearchCarsReq) models.SearchCarsReq {
+	return models.SearchCarsReq{
+		Query:    req.Query,
+		Brand:    req.Brand,
+		Category: req.Category,
+		Seats:    req.Seats,
+	}
+}
+
+func (s *serverConverter) SearchCarsResToPb(res models.SearchCarsRes) *carsharing.SearchCarsRes {
+	cars := s.CarsToPb(res.CarsPage)
+
+	return &carsharing.SearchCarsRes{
+		Cars:          cars.Cars,
+		NextPageToken: cars.NextPageToken,
+		Brands:        s.facetsToPb(res.Facets.Brands),
+		Categories:    s.facetsToPb(res.Facets.Categories),
+		Seats:         s.facetsToPb(res.Facets.Seats),
+	}
+}
+
+func (s *serverConverter) facetsToPb(facets []models.Facet) []*carsharing.Facet {
+	res := make([]*carsharing.Facet, 0, len(facets))
+	for _, f := range facets {
+		res = append(res, &carsharing.Facet{
+			Value: f.Value,
+			Count: f.Count,
+		})
+	}
+
+	return res
+}
+
 func (s *serverConverter) GetAvailableCarsReqToService(req *carsharing.GetAvailableCarsReq) models.Period {
 	from := req.Start.AsTime()
 	to := req.End.AsTime()
@@ -133,12 +446,47 @@ func (s *serverConverter) GetAvailableCarsReqToService(req *carsharing.GetAvaila
 	}
 }
 
+func (s *serverConverter) QuotePriceReqToService(req *carsharing.QuotePriceReq) models.QuotePriceReq {
+	return models.QuotePriceReq{
+		CarUUID:        req.CarUUID,
+		RentStart:      req.RentStart.AsTime(),
+		RentEnd:        req.RentEnd.AsTime(),
+		PickupStation:  req.PickupStation,
+		DropOffStation: req.DropOffStation,
+	}
+}
+
+func (s *serverConverter) PriceQuoteToPb(res models.PriceQuote) *carsharing.QuotePriceRes {
+	adjustments := make([]*carsharing.PriceAdjustment, 0, len(res.Adjustments))
+	for _, a := range res.Adjustments {
+		adjustments = append(adjustments, &carsharing.PriceAdjustment{
+			Rule:   a.Rule,
+			Amount: a.Amount.Units(),
+			Value:  s.moneyToPb(a.Amount),
+		})
+	}
+
+	return &carsharing.QuotePriceRes{
+		Price:       res.Price.Units(),
+		BasePrice:   res.BasePrice.Units(),
+		Hours:       int32(res.Hours),
+		Adjustments: adjustments,
+		Total:       s.moneyToPb(res.Price),
+		Base:        s.moneyToPb(res.BasePrice),
+	}
+}
+
 func (s *serverConverter) CheckRentToPb(res models.Rent) *carsharing.CheckRentRes {
 	return &carsharing.CheckRentRes{
 		CarUUID:   res.CarUUID,
-		RentPrice: res.RentPrice,
+		RentPrice: res.RentPrice.Units(),
+		Price:     s.moneyToPb(res.RentPrice),
 		RentStart: s.timeToTimestampPb(res.RentStart),
 		RentEnd:   s.timeToTimestampPb(res.RentEnd),
+		Status:    res.Status,
+
+		PickupStation:  res.PickupStation,
+		DropOffStation: res.DropOffStation,
 	}
 }
 
@@ -151,9 +499,29 @@ func (s *serverConverter) CreateRentReqToService(req *carsharing.CreateRentReq)
 		PhoneNumber:    req.PhoneNumber,
 		PassportNumber: req.PassportNumber,
 		PaymentSource:  req.PaymentSource,
+		Email:          req.Email,
 		Token:          req.Token,
+		PromoCode:      req.PromoCode,
 		RentStart:      req.RentStart.AsTime(),
 		RentEnd:        req.RentEnd.AsTime(),
+		PickupStation:  req.PickupStation,
+		DropOffStation: req.DropOffStation,
+	}
+}
+
+// moneyToService falls back to the deprecated float field, if the client does not set the money one
+func (s *serverConverter) moneyToService(money *carsharing.Money, units float32) models.Money {
+	if money == nil {
+		return models.MoneyFromUnits(float64(units), models.DEFAULT_CURRENCY)
+	}
+
+	return models.NewMoney(money.Amount, money.Currency)
+}
+
+func (s *serverConverter) moneyToPb(money models.Money) *carsharing.Money {
+	return &carsharing.Money{
+		Amount:   money.Amount,
+		Currency: money.Currency,
 	}
 }
 
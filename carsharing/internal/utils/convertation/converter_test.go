@@ -2,6 +2,7 @@ package convertation
 
 import (
 	"fmt"
+	"github.com/alserov/rently/carsharing/internal/models"
 	"github.com/alserov/rently/proto/gen/carsharing"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -42,3 +43,47 @@ func TestServerConverter_CreateRentReqToService(t *testing.T) {
 	require.Equal(t, req.RentStart.AsTime(), converted.RentStart, "rent start")
 	require.Equal(t, req.RentEnd.AsTime(), converted.RentEnd, "rent end")
 }
+
+func TestServerConverter_UpdateCarPriceReqToService(t *testing.T) {
+	c := NewServerConverter()
+
+	// deprecated float field, that can not be represented exactly
+	converted := c.UpdateCarPriceReqToService(&carsharing.UpdateCarPriceReq{
+		CarUUID:     "uuid",
+		PricePerDay: 19.99,
+	})
+	require.Equal(t, models.NewMoney(1999, models.DEFAULT_CURRENCY), converted.Price)
+
+	// money field takes precedence
+	converted = c.UpdateCarPriceReqToService(&carsharing.UpdateCarPriceReq{
+		CarUUID:     "uuid",
+		PricePerDay: 19.99,
+		Price:       &carsharing.Money{Amount: 2500, Currency: "EUR"},
+	})
+	require.Equal(t, models.NewMoney(2500, "EUR"), converted.Price)
+
+	car := c.CarToPb(models.Car{PricePerDay: models.NewMoney(1999, models.DEFAULT_CURRENCY)})
+	require.Equal(t, int64(1999), car.Price.Amount)
+	require.Equal(t, models.DEFAULT_CURRENCY, car.Price.Currency)
+	require.Equal(t, float32(19.99), car.PricePerDay)
+}
+
+func TestServerConverter_CarChangesToPb(t *testing.T) {
+	c := NewServerConverter()
+
+	res := c.CarChangesToPb([]models.CarChange{{
+		ID:     1,
+		Actor:  "admin",
+		Action: models.CAR_CHANGE_UPDATE,
+		Changes: map[string]models.FieldChange{
+			models.CAR_FIELD_SEATS: {Old: 4, New: 7},
+			models.CAR_FIELD_BRAND: {Old: "bmw", New: "audi"},
+		},
+	}})
+	require.Len(t, res.Changes, 1)
+	require.Equal(t, "admin", res.Changes[0].Actor)
+
+	require.Len(t, res.Changes[0].Changes, 2)
+	require.Equal(t, &carsharing.FieldChange{Field: models.CAR_FIELD_BRAND, Old: `"bmw"`, New: `"audi"`}, res.Changes[0].Changes[0])
+	require.Equal(t, &carsharing.FieldChange{Field: models.CAR_FIELD_SEATS, Old: "4", New: "7"}, res.Changes[0].Changes[1])
+}
@@ -2,10 +2,12 @@ package validation
 
 import (
 	"fmt"
+	"github.com/alserov/rently/carsharing/internal/models"
 	"github.com/alserov/rently/proto/gen/carsharing"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"regexp"
+	"strings"
 	"time"
 )
 
@@ -13,15 +15,35 @@ type Validator interface {
 	ValidateCreateRentReq(req *carsharing.CreateRentReq) error
 	ValidateCancelRentReq(req *carsharing.CancelRentReq) error
 	ValidateCheckRentReq(req *carsharing.CheckRentReq) error
+	ValidateStartRentReq(req *carsharing.StartRentReq) error
+	ValidateCompleteRentReq(req *carsharing.CompleteRentReq) error
+	ValidateMarkNoShowReq(req *carsharing.MarkNoShowReq) error
 
 	ValidateGetCarsByParamsReq(req *carsharing.GetCarsByParamsReq) error
 	ValidateGetCarByUUID(req *carsharing.GetCarByUUIDReq) error
 	ValidateGetAvailableCarsReq(req *carsharing.GetAvailableCarsReq) error
+	ValidateSearchCarsReq(req *carsharing.SearchCarsReq) error
 	ValidateGetCarImageReq(req *carsharing.GetImageReq) error
+	ValidateGetImageStreamReq(req *carsharing.GetImageStreamReq) error
+	ValidateQuotePriceReq(req *carsharing.QuotePriceReq) error
+	ValidateGetStationsReq(req *carsharing.GetStationsReq) error
 
+	ValidateCreateStationReq(req *carsharing.CreateStationReq) error
 	ValidateCreateCarReq(req *carsharing.CreateCarReq) error
+	ValidateUploadCarImageInfo(info *carsharing.UploadCarImageInfo) error
+	ValidateGetCarImagesReq(req *carsharing.GetCarImagesReq) error
+	ValidateDeleteCarImageReq(req *carsharing.DeleteCarImageReq) error
+	ValidateReorderCarImagesReq(req *carsharing.ReorderCarImagesReq) error
+	ValidateSetMainCarImageReq(req *carsharing.SetMainCarImageReq) error
 	ValidateDeleteCarReq(req *carsharing.DeleteCarReq) error
+	ValidateUpdateCarReq(req *carsharing.UpdateCarReq) error
 	ValidateUpdateCarPriceReq(req *carsharing.UpdateCarPriceReq) error
+	ValidateGetCarChangesReq(req *carsharing.GetCarChangesReq) error
+	ValidateScheduleMaintenanceReq(req *carsharing.ScheduleMaintenanceReq) error
+	ValidateCloseMaintenanceReq(req *carsharing.CloseMaintenanceReq) error
+	ValidateGetMaintenancesReq(req *carsharing.GetMaintenancesReq) error
+	ValidateCreatePromoCodeReq(req *carsharing.CreatePromoCodeReq) error
+	ValidateExpirePromoCodeReq(req *carsharing.ExpirePromoCodeReq) error
 
 	ValidateGetRentStartingTomorrowReq(req *carsharing.GetRentStartingOnDateReq) error
 }
@@ -31,6 +53,7 @@ func NewValidator() Validator {
 		regExpPhone:    regexp.MustCompile(`^[1-9]\d{9}$`),
 		regExpPassport: regexp.MustCompile(`^[A-Z0-9]{9}$`),
 		card:           regexp.MustCompile(`^[0-9]{13}(?:[0-9]{3})?$`),
+		promoCode:      regexp.MustCompile(`^[A-Za-z0-9_-]{3,32}$`),
 	}
 }
 
@@ -44,12 +67,23 @@ const (
 	ERR_INVALID_PRICE_PER_DAY   = "price can not be less or equal to 0.txt"
 	ERR_INVALID_IMAGES_AMOUNT   = "the carsharing should have at least one image"
 	ERR_INVALID_RENT_START_TIME = "invalid rent start time"
+	ERR_INVALID_PROMO_CODE      = "promo code should consist of 3-32 letters, digits, '-' or '_'"
+	ERR_INVALID_DISCOUNT        = "promo code should have either percent from 1 to 100 or positive amount discount"
+	ERR_INVALID_USAGE_LIMIT     = "usage limit can not be negative"
+	ERR_EMPTY_UPDATE_MASK       = "update mask can not be empty"
+	ERR_INVALID_COORDINATES     = "latitude should be from -90 to 90 and longitude from -180 to 180"
+	ERR_INVALID_CAPACITY        = "station capacity should be positive"
+	ERR_INVALID_MAINTENANCE     = "maintenance kind should be either planned or unplanned"
+	ERR_INVALID_MILEAGE         = "mileage can not be negative"
 )
 
+const MAX_SEARCH_QUERY_LENGTH = 100
+
 type validator struct {
 	regExpPhone    *regexp.Regexp
 	regExpPassport *regexp.Regexp
 	card           *regexp.Regexp
+	promoCode      *regexp.Regexp
 }
 
 func (v *validator) ValidateGetRentStartingTomorrowReq(req *carsharing.GetRentStartingOnDateReq) error {
@@ -64,6 +98,66 @@ func (v *validator) ValidateGetCarImageReq(req *carsharing.GetImageReq) error {
 	if req.GetBucket() == "" || req.GetId() == "" {
 		return fmt.Errorf("image bucket or id %v", ERR_EMPTY)
 	}
+	return validateImageVariant(req.GetVariant())
+}
+
+func (v *validator) ValidateGetImageStreamReq(req *carsharing.GetImageStreamReq) error {
+	if req.GetBucket() == "" || req.GetId() == "" {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("image bucket or id %s", ERR_EMPTY))
+	}
+	if req.GetOffset() < 0 || req.GetLength() < 0 {
+		return status.Error(codes.InvalidArgument, "offset and length can not be negative")
+	}
+	return validateImageVariant(req.GetVariant())
+}
+
+func validateImageVariant(variant string) error {
+	switch variant {
+	case "", models.IMAGE_VARIANT_THUMBNAIL, models.IMAGE_VARIANT_MEDIUM, models.IMAGE_VARIANT_ORIGINAL:
+		return nil
+	default:
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("invalid image variant: %s", variant))
+	}
+}
+
+func (v *validator) ValidateUploadCarImageInfo(info *carsharing.UploadCarImageInfo) error {
+	if info == nil {
+		return status.Error(codes.InvalidArgument, "image info is expected in the first message")
+	}
+	if info.GetCarUUID() == "" {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("car uuid %s", ERR_EMPTY))
+	}
+	return nil
+}
+
+func (v *validator) ValidateGetCarImagesReq(req *carsharing.GetCarImagesReq) error {
+	if req.GetCarUUID() == "" {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("car uuid %s", ERR_EMPTY))
+	}
+	return nil
+}
+
+func (v *validator) ValidateDeleteCarImageReq(req *carsharing.DeleteCarImageReq) error {
+	if req.GetCarUUID() == "" || req.GetId() == "" {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("car uuid or image id %s", ERR_EMPTY))
+	}
+	return nil
+}
+
+func (v *validator) ValidateReorderCarImagesReq(req *carsharing.ReorderCarImagesReq) error {
+	if req.GetCarUUID() == "" {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("car uuid %s", ERR_EMPTY))
+	}
+	if len(req.GetIds()) == 0 {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("image ids %s", ERR_EMPTY))
+	}
+	return nil
+}
+
+func (v *validator) ValidateSetMainCarImageReq(req *carsharing.SetMainCarImageReq) error {
+	if req.GetCarUUID() == "" || req.GetId() == "" {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("car uuid or image id %s", ERR_EMPTY))
+	}
 	return nil
 }
 
@@ -79,13 +173,123 @@ func (v *validator) ValidateUpdateCarPriceReq(req *carsharing.UpdateCarPriceReq)
 		return status.Error(codes.InvalidArgument, fmt.Sprintf("carsharing uuid %s", ERR_EMPTY))
 	}
 
-	if req.GetPricePerDay() <= 0 {
-		return status.Error(codes.InvalidArgument, ERR_INVALID_PRICE_PER_DAY)
+	if err := v.validatePrice(req.GetPrice(), req.GetPricePerDay()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (v *validator) ValidateUpdateCarReq(req *carsharing.UpdateCarReq) error {
+	if req.GetCarUUID() == "" {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("carsharing uuid %s", ERR_EMPTY))
+	}
+
+	if len(req.GetUpdateMask().GetPaths()) == 0 {
+		return status.Error(codes.InvalidArgument, ERR_EMPTY_UPDATE_MASK)
+	}
+
+	car := req.GetCar()
+	for _, path := range req.GetUpdateMask().GetPaths() {
+		switch path {
+		case models.CAR_FIELD_BRAND:
+			if car.GetBrand() == "" {
+				return status.Error(codes.InvalidArgument, fmt.Sprintf("carsharing brand %s", ERR_EMPTY))
+			}
+		case models.CAR_FIELD_TYPE:
+			if car.GetType() == "" {
+				return status.Error(codes.InvalidArgument, fmt.Sprintf("carsharing type %s", ERR_EMPTY))
+			}
+		case models.CAR_FIELD_CATEGORY:
+			if car.GetCategory() == "" {
+				return status.Error(codes.InvalidArgument, fmt.Sprintf("carsharing category %s", ERR_EMPTY))
+			}
+		case models.CAR_FIELD_SEATS:
+			if car.GetSeats() < 1 {
+				return status.Error(codes.InvalidArgument, ERR_INVALID_SEATS_AMOUNT)
+			}
+		case models.CAR_FIELD_MAX_SPEED:
+			if car.GetMaxSpeed() < 0 {
+				return status.Error(codes.InvalidArgument, ERR_INVALID_SPEED)
+			}
+		case models.CAR_FIELD_PRICE:
+			if err := v.validatePrice(car.GetPrice(), car.GetPricePerDay()); err != nil {
+				return err
+			}
+		case models.CAR_FIELD_STATION:
+			// the empty station unassigns the car
+		default:
+			return status.Error(codes.InvalidArgument, fmt.Sprintf("carsharing field %s can not be updated", path))
+		}
+	}
+
+	return nil
+}
+
+func (v *validator) ValidateGetCarChangesReq(req *carsharing.GetCarChangesReq) error {
+	if req.GetCarUUID() == "" {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("carsharing uuid %s", ERR_EMPTY))
+	}
+	return nil
+}
+
+// ValidateScheduleMaintenanceReq the planned maintenance has to have the period, the unplanned one starts now by default
+func (v *validator) ValidateScheduleMaintenanceReq(req *carsharing.ScheduleMaintenanceReq) error {
+	if req.GetCarUUID() == "" {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("carsharing uuid %s", ERR_EMPTY))
+	}
+
+	if req.GetReason() == "" {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("maintenance reason %s", ERR_EMPTY))
+	}
+
+	switch req.GetKind() {
+	case models.MAINTENANCE_PLANNED:
+		if req.GetStart() == nil || req.GetEnd() == nil {
+			return status.Error(codes.InvalidArgument, fmt.Sprintf("planned maintenance period %s", ERR_EMPTY))
+		}
+	case models.MAINTENANCE_UNPLANNED:
+	default:
+		return status.Error(codes.InvalidArgument, ERR_INVALID_MAINTENANCE)
+	}
+
+	if req.GetEnd() != nil {
+		start := time.Now()
+		if req.GetStart() != nil {
+			start = req.GetStart().AsTime()
+		}
+
+		if !req.GetEnd().AsTime().After(start) {
+			return status.Error(codes.InvalidArgument, "maintenance end should be after its start")
+		}
+	}
+
+	if req.GetIntervalKm() < 0 {
+		return status.Error(codes.InvalidArgument, ERR_INVALID_MILEAGE)
 	}
 
 	return nil
 }
 
+func (v *validator) ValidateCloseMaintenanceReq(req *carsharing.CloseMaintenanceReq) error {
+	if req.GetUUID() == "" {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("maintenance uuid %s", ERR_EMPTY))
+	}
+
+	if req.GetMileage() < 0 {
+		return status.Error(codes.InvalidArgument, ERR_INVALID_MILEAGE)
+	}
+
+	return nil
+}
+
+func (v *validator) ValidateGetMaintenancesReq(req *carsharing.GetMaintenancesReq) error {
+	if req.GetCarUUID() == "" {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("carsharing uuid %s", ERR_EMPTY))
+	}
+	return nil
+}
+
 func (v *validator) ValidateCreateCarReq(req *carsharing.CreateCarReq) error {
 	if req.GetBrand() == "" {
 		return status.Error(codes.InvalidArgument, fmt.Sprintf("carsharing brand %s", ERR_EMPTY))
@@ -103,8 +307,8 @@ func (v *validator) ValidateCreateCarReq(req *carsharing.CreateCarReq) error {
 		return status.Error(codes.InvalidArgument, ERR_INVALID_SPEED)
 	}
 
-	if req.GetPricePerDay() <= 0 {
-		return status.Error(codes.InvalidArgument, ERR_INVALID_PRICE_PER_DAY)
+	if err := v.validatePrice(req.GetPrice(), req.GetPricePerDay()); err != nil {
+		return err
 	}
 
 	if req.GetType() == "" {
@@ -135,6 +339,24 @@ func (v *validator) ValidateGetCarsByParamsReq(req *carsharing.GetCarsByParamsRe
 		return status.Error(codes.InvalidArgument, ERR_INVALID_PRICE_PER_DAY)
 	}
 
+	if err := v.validatePriceRange(req.GetMinPrice(), req.GetMaxPrice()); err != nil {
+		return err
+	}
+
+	return v.validatePage(req.GetPage())
+}
+
+func (v *validator) validatePage(page *carsharing.Page) error {
+	if page.GetSize() < 0 || page.GetSize() > models.MAX_PAGE_SIZE {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("page size should be from 1 to %d", models.MAX_PAGE_SIZE))
+	}
+
+	switch page.GetSortBy() {
+	case "", models.SORT_BY_PRICE, models.SORT_BY_MAX_SPEED, models.SORT_BY_SEATS, models.SORT_BY_BRAND, models.SORT_BY_RELEVANCE:
+	default:
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("cars can not be sorted by %s", page.GetSortBy()))
+	}
+
 	return nil
 }
 
@@ -147,8 +369,146 @@ func (v *validator) ValidateGetCarByUUID(req *carsharing.GetCarByUUIDReq) error
 }
 
 func (v *validator) ValidateGetAvailableCarsReq(req *carsharing.GetAvailableCarsReq) error {
-	if req.GetStart().AsTime().Before(req.GetEnd().AsTime()) {
-		return status.Error(codes.InvalidArgument, "rent end can not be earlier than rent start")
+	if req.GetStart() == nil || req.GetEnd() == nil {
+		return status.Error(codes.InvalidArgument, "rent start and rent end are required")
+	}
+
+	if !req.GetEnd().AsTime().After(req.GetStart().AsTime()) {
+		return status.Error(codes.InvalidArgument, "rent end should be later than rent start")
+	}
+
+	if req.GetMaxSpeed() < 0 {
+		return status.Error(codes.InvalidArgument, ERR_INVALID_SPEED)
+	}
+
+	if req.GetSeats() < 0 {
+		return status.Error(codes.InvalidArgument, ERR_INVALID_SEATS_AMOUNT)
+	}
+
+	if err := v.validatePriceRange(req.GetMinPrice(), req.GetMaxPrice()); err != nil {
+		return err
+	}
+
+	if err := v.validateArea(req.GetNear()); err != nil {
+		return err
+	}
+
+	return v.validatePage(req.GetPage())
+}
+
+func (v *validator) ValidateGetStationsReq(req *carsharing.GetStationsReq) error {
+	return v.validateArea(req.GetNear())
+}
+
+func (v *validator) ValidateCreateStationReq(req *carsharing.CreateStationReq) error {
+	station := req.GetStation()
+
+	if station.GetName() == "" {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("station name %s", ERR_EMPTY))
+	}
+
+	if station.GetAddress() == "" {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("station address %s", ERR_EMPTY))
+	}
+
+	if err := v.validateCoordinates(station.GetLatitude(), station.GetLongitude()); err != nil {
+		return err
+	}
+
+	for _, dayTime := range []string{station.GetOpensAt(), station.GetClosesAt()} {
+		if _, err := time.Parse(models.DAY_TIME_FORMAT, dayTime); dayTime != "" && err != nil {
+			return status.Error(codes.InvalidArgument, fmt.Sprintf("invalid opening hours %s, expected HH:MM", dayTime))
+		}
+	}
+
+	if _, err := time.LoadLocation(station.GetTimeZone()); err != nil {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("invalid time zone %s", station.GetTimeZone()))
+	}
+
+	if station.GetCapacity() < 1 {
+		return status.Error(codes.InvalidArgument, ERR_INVALID_CAPACITY)
+	}
+
+	return nil
+}
+
+// validateArea empty area is valid, it means no filter
+func (v *validator) validateArea(area *carsharing.Area) error {
+	if area.GetRadiusKm() == 0 {
+		return nil
+	}
+
+	if area.GetRadiusKm() < 0 || area.GetRadiusKm() > models.MAX_AREA_RADIUS_KM {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("radius should be from 0 to %d km", models.MAX_AREA_RADIUS_KM))
+	}
+
+	return v.validateCoordinates(area.GetLatitude(), area.GetLongitude())
+}
+
+func (v *validator) validateCoordinates(latitude, longitude float64) error {
+	if latitude < -90 || latitude > 90 || longitude < -180 || longitude > 180 {
+		return status.Error(codes.InvalidArgument, ERR_INVALID_COORDINATES)
+	}
+
+	return nil
+}
+
+func (v *validator) ValidateSearchCarsReq(req *carsharing.SearchCarsReq) error {
+	if strings.TrimSpace(req.GetQuery()) == "" {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("search query %s", ERR_EMPTY))
+	}
+
+	if len(req.GetQuery()) > MAX_SEARCH_QUERY_LENGTH {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("search query should not be longer than %d", MAX_SEARCH_QUERY_LENGTH))
+	}
+
+	if req.GetSeats() < 0 {
+		return status.Error(codes.InvalidArgument, ERR_INVALID_SEATS_AMOUNT)
+	}
+
+	return v.validatePage(req.GetPage())
+}
+
+// validatePriceRange checks the optional price bounds of the cars filter
+func (v *validator) validatePriceRange(min, max *carsharing.Money) error {
+	for _, price := range []*carsharing.Money{min, max} {
+		if price == nil {
+			continue
+		}
+
+		if price.GetAmount() < 0 {
+			return status.Error(codes.InvalidArgument, ERR_INVALID_PRICE_PER_DAY)
+		}
+
+		if err := models.NewMoney(price.GetAmount(), price.GetCurrency()).Validate(); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	if min != nil && max != nil {
+		if min.GetCurrency() != max.GetCurrency() {
+			return status.Error(codes.InvalidArgument, "min and max price should be in the same currency")
+		}
+
+		if max.GetAmount() != 0 && min.GetAmount() > max.GetAmount() {
+			return status.Error(codes.InvalidArgument, "min price can not be greater than max price")
+		}
+	}
+
+	return nil
+}
+
+func (v *validator) ValidateQuotePriceReq(req *carsharing.QuotePriceReq) error {
+	if req.GetCarUUID() == "" {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("car uuid %s", ERR_EMPTY))
+	}
+
+	if req.GetRentStart() == nil || req.GetRentEnd() == nil {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("rent period %s", ERR_EMPTY))
+	}
+
+	if !req.GetRentEnd().AsTime().After(req.GetRentStart().AsTime()) {
+		return status.Error(codes.InvalidArgument, "invalid rent end timestamp")
 	}
 
 	return nil
@@ -170,6 +530,30 @@ func (v *validator) ValidateCheckRentReq(req *carsharing.CheckRentReq) error {
 	return nil
 }
 
+func (v *validator) ValidateStartRentReq(req *carsharing.StartRentReq) error {
+	if req.GetRentUUID() == "" {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("rent uuid %s", ERR_EMPTY))
+	}
+
+	return nil
+}
+
+func (v *validator) ValidateCompleteRentReq(req *carsharing.CompleteRentReq) error {
+	if req.GetRentUUID() == "" {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("rent uuid %s", ERR_EMPTY))
+	}
+
+	return nil
+}
+
+func (v *validator) ValidateMarkNoShowReq(req *carsharing.MarkNoShowReq) error {
+	if req.GetRentUUID() == "" {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("rent uuid %s", ERR_EMPTY))
+	}
+
+	return nil
+}
+
 func (v *validator) ValidateCreateRentReq(req *carsharing.CreateRentReq) error {
 	if !req.GetRentEnd().AsTime().After(req.GetRentStart().AsTime()) {
 		return status.Error(codes.InvalidArgument, "invalid rent end timestamp")
@@ -179,6 +563,10 @@ func (v *validator) ValidateCreateRentReq(req *carsharing.CreateRentReq) error {
 		return status.Error(codes.InvalidArgument, fmt.Sprintf("carsharing uuid %s", ERR_EMPTY))
 	}
 
+	if req.GetPromoCode() != "" && !v.promoCode.MatchString(req.GetPromoCode()) {
+		return status.Error(codes.InvalidArgument, ERR_INVALID_PROMO_CODE)
+	}
+
 	if req.Token == "" {
 		if req.GetPaymentSource() == "" {
 			return status.Error(codes.InvalidArgument, fmt.Sprintf("card number %s", ERR_EMPTY))
@@ -200,6 +588,71 @@ func (v *validator) ValidateCreateRentReq(req *carsharing.CreateRentReq) error {
 	return nil
 }
 
+// validatePrice checks the money field, or the deprecated float one if the money is not set
+func (v *validator) validatePrice(price *carsharing.Money, units float32) error {
+	if price == nil {
+		if units <= 0 {
+			return status.Error(codes.InvalidArgument, ERR_INVALID_PRICE_PER_DAY)
+		}
+		return nil
+	}
+
+	if price.GetAmount() <= 0 {
+		return status.Error(codes.InvalidArgument, ERR_INVALID_PRICE_PER_DAY)
+	}
+
+	if err := models.NewMoney(price.GetAmount(), price.GetCurrency()).Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return nil
+}
+
+func (v *validator) ValidateCreatePromoCodeReq(req *carsharing.CreatePromoCodeReq) error {
+	if !v.promoCode.MatchString(req.GetCode()) {
+		return status.Error(codes.InvalidArgument, ERR_INVALID_PROMO_CODE)
+	}
+
+	switch {
+	case req.GetPercent() != 0 && req.GetAmount() != nil, req.GetPercent() == 0 && req.GetAmount() == nil:
+		return status.Error(codes.InvalidArgument, ERR_INVALID_DISCOUNT)
+	case req.GetAmount() != nil:
+		if req.GetAmount().GetAmount() <= 0 {
+			return status.Error(codes.InvalidArgument, ERR_INVALID_DISCOUNT)
+		}
+		if err := models.NewMoney(req.GetAmount().GetAmount(), req.GetAmount().GetCurrency()).Validate(); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	case req.GetPercent() < 1 || req.GetPercent() > models.MAX_PROMO_PERCENT:
+		return status.Error(codes.InvalidArgument, ERR_INVALID_DISCOUNT)
+	}
+
+	if req.GetMaxUses() < 0 || req.GetMaxUsesPerUser() < 0 {
+		return status.Error(codes.InvalidArgument, ERR_INVALID_USAGE_LIMIT)
+	}
+
+	if req.GetValidUntil() != nil {
+		validFrom := time.Now()
+		if req.GetValidFrom() != nil {
+			validFrom = req.GetValidFrom().AsTime()
+		}
+
+		if !req.GetValidUntil().AsTime().After(validFrom) {
+			return status.Error(codes.InvalidArgument, "promo code validity end should be after its start")
+		}
+	}
+
+	return nil
+}
+
+func (v *validator) ValidateExpirePromoCodeReq(req *carsharing.ExpirePromoCodeReq) error {
+	if req.GetCode() == "" {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("promo code %s", ERR_EMPTY))
+	}
+
+	return nil
+}
+
 func (v *validator) validatePhoneNumber(phoneNumber string) error {
 	valid := v.regExpPhone.MatchString(phoneNumber)
 	if !valid {
@@ -0,0 +1,140 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"github.com/alserov/rently/carsharing/internal/db"
+	"github.com/alserov/rently/carsharing/internal/log"
+	"github.com/alserov/rently/carsharing/internal/storage"
+	"log/slog"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+type ImageCollectorParams struct {
+	Repo    db.Repository
+	Storage storage.ImageStorage
+}
+
+// NewImageCollector returns the worker, that deletes the stored files of the images, which are not linked to any car.
+// The files are saved before the image is linked, so the image is deleted only if it is orphaned on two runs in a row.
+// The gallery images, uploaded before the images had the rows, are linked to the car of their bucket instead
+func NewImageCollector(p ImageCollectorParams) Actor {
+	return &imageCollector{
+		log:      log.GetLogger(),
+		repo:     p.Repo,
+		storage:  p.Storage,
+		suspects: make(map[string]struct{}),
+	}
+}
+
+type imageCollector struct {
+	log log.Logger
+
+	repo    db.Repository
+	storage storage.ImageStorage
+
+	// suspects are the images, which were orphaned on the previous run
+	suspects map[string]struct{}
+}
+
+// IMAGE_COLLECTOR_BATCH is the number of ids checked by the single query
+const IMAGE_COLLECTOR_BATCH = 500
+
+func (c *imageCollector) Action() error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute*5)
+	defer cancel()
+
+	files := make(map[string][]string)
+	err := c.storage.Walk(ctx, func(p string) error {
+		id := imageID(p)
+		files[id] = append(files[id], p)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to walk images: %w", err)
+	}
+
+	ids := make([]string, 0, len(files))
+	for id := range files {
+		ids = append(ids, id)
+	}
+
+	linked := make(map[string]struct{}, len(ids))
+	for start := 0; start < len(ids); start += IMAGE_COLLECTOR_BATCH {
+		existing, err := c.repo.GetExistingImages(ctx, ids[start:min(start+IMAGE_COLLECTOR_BATCH, len(ids))])
+		if err != nil {
+			return err
+		}
+		for _, id := range existing {
+			linked[id] = struct{}{}
+		}
+	}
+
+	if err = c.linkLegacyImages(ctx, files, linked); err != nil {
+		return err
+	}
+
+	suspects := make(map[string]struct{})
+	for id, paths := range files {
+		if _, ok := linked[id]; ok {
+			continue
+		}
+
+		if _, ok := c.suspects[id]; !ok {
+			suspects[id] = struct{}{}
+			continue
+		}
+
+		for _, p := range paths {
+			if err = c.storage.Delete(ctx, p); err != nil {
+				c.log.Error("failed to delete orphaned image", slog.String("path", p), slog.String("error", err.Error()))
+			}
+		}
+		c.log.Info("deleted orphaned image", slog.String("id", id), slog.Int("files", len(paths)))
+	}
+	c.suspects = suspects
+
+	return nil
+}
+
+// linkLegacyImages links the unlinked images, which have only the original, to the car of the bucket,
+// the images, which are saved with the variants, always have them, so only the legacy ones match
+func (c *imageCollector) linkLegacyImages(ctx context.Context, files map[string][]string, linked map[string]struct{}) error {
+	legacy := make(map[string][]string)
+	for id, paths := range files {
+		if _, ok := linked[id]; ok || len(paths) != 1 || path.Base(paths[0]) != id {
+			continue
+		}
+
+		bucket := path.Dir(paths[0])
+		legacy[bucket] = append(legacy[bucket], id)
+	}
+
+	for bucket, ids := range legacy {
+		sort.Strings(ids)
+
+		// the bucket of the car is its uuid
+		added, err := c.repo.LinkLegacyImages(ctx, bucket, ids)
+		if err != nil {
+			return err
+		}
+		for _, id := range added {
+			linked[id] = struct{}{}
+		}
+
+		if len(added) > 0 {
+			c.log.Info("linked legacy images", slog.String("car", bucket), slog.Int("images", len(added)))
+		}
+	}
+
+	return nil
+}
+
+// imageID returns the id of the image by the path of its file, '<bucket>/<id>' or '<bucket>/<id>.<variant>'
+func imageID(p string) string {
+	id, _, _ := strings.Cut(path.Base(p), ".")
+	return id
+}
@@ -0,0 +1,72 @@
+package workers
+
+import (
+	"context"
+	repomock "github.com/alserov/rently/carsharing/internal/db/mocks"
+	"github.com/alserov/rently/carsharing/internal/log"
+	"github.com/alserov/rently/carsharing/internal/storage"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestImageCollector(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	log.MustSetup(log.ENV_LOCAL)
+
+	ctx := context.Background()
+	backend := storage.NewMemoryBackend()
+	// the legacy images have only the original, the car of the sold bucket does not exist anymore
+	for _, p := range []string{"car/linked", "car/linked.thumbnail", "car/orphan", "car/orphan.thumbnail", "car/legacy", "sold/legacy2"} {
+		require.NoError(t, backend.Put(ctx, p, strings.NewReader(p)))
+	}
+
+	linked := map[string]struct{}{"linked": {}}
+	repo := repomock.NewMockRepository(ctrl)
+	repo.EXPECT().
+		GetExistingImages(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, ids []string) ([]string, error) {
+			var existing []string
+			for _, id := range ids {
+				if _, ok := linked[id]; ok {
+					existing = append(existing, id)
+				}
+			}
+			return existing, nil
+		}).
+		Times(2)
+	repo.EXPECT().
+		LinkLegacyImages(gomock.Any(), "car", []string{"legacy"}).
+		DoAndReturn(func(_ context.Context, _ string, ids []string) ([]string, error) {
+			linked["legacy"] = struct{}{}
+			return ids, nil
+		}).
+		Times(1)
+	repo.EXPECT().LinkLegacyImages(gomock.Any(), "sold", []string{"legacy2"}).Return(nil, nil).Times(2)
+
+	collector := NewImageCollector(ImageCollectorParams{
+		Repo:    repo,
+		Storage: storage.NewImageStorage(backend),
+	})
+
+	// the orphan may be the image, which is being uploaded, so it is kept on the first run
+	require.NoError(t, collector.Action())
+	require.Equal(t, []string{"car/legacy", "car/linked", "car/linked.thumbnail", "car/orphan", "car/orphan.thumbnail", "sold/legacy2"}, walkPaths(t, backend))
+
+	require.NoError(t, collector.Action())
+	require.Equal(t, []string{"car/legacy", "car/linked", "car/linked.thumbnail"}, walkPaths(t, backend))
+}
+
+func walkPaths(t *testing.T, b storage.Backend) []string {
+	var paths []string
+	require.NoError(t, b.Walk(context.Background(), func(p string) error {
+		paths = append(paths, p)
+		return nil
+	}))
+	sort.Strings(paths)
+	return paths
+}
@@ -0,0 +1,161 @@
+package workers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/alserov/rently/carsharing/internal/db"
+	"github.com/alserov/rently/carsharing/internal/log"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"github.com/alserov/rently/carsharing/internal/notifications"
+	"github.com/alserov/rently/carsharing/internal/payment"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+type OutboxRelayParams struct {
+	Repo     db.Repository
+	Payment  payment.Payer
+	Notifier notifications.Notifier
+}
+
+// NewOutboxRelay returns the worker, that handles events stored in outbox: captures and refunds charges
+// and publishes rent notifications
+func NewOutboxRelay(p OutboxRelayParams) Actor {
+	return &outboxRelay{
+		log:      log.GetLogger(),
+		repo:     p.Repo,
+		payment:  p.Payment,
+		notifier: p.Notifier,
+	}
+}
+
+const (
+	OUTBOX_BATCH_SIZE = 100
+	// OUTBOX_MAX_ATTEMPTS the exhausted charge events are requeued by the reconciler, so the money is not left uncollected
+	OUTBOX_MAX_ATTEMPTS = 10
+)
+
+type outboxRelay struct {
+	log log.Logger
+
+	repo db.Repository
+
+	payment payment.Payer
+
+	notifier notifications.Notifier
+}
+
+func (r *outboxRelay) Action() error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	for i := 0; i < OUTBOX_BATCH_SIZE; i++ {
+		processed, err := r.processNext(ctx)
+		if err != nil {
+			return err
+		}
+		if !processed {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// processNext handles the oldest pending event, every event is handled in its own tx,
+// so the event stays locked for other relays until it is handled
+func (r *outboxRelay) processNext(ctx context.Context) (bool, error) {
+	tx, err := r.repo.StartTx(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to start tx: %w", err)
+	}
+	defer func() {
+		if err = tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+			r.log.Warn("failed to rollback tx", slog.String("warn", err.Error()))
+		}
+	}()
+
+	event, found, err := r.repo.GetPendingOutboxEventTx(ctx, tx, OUTBOX_MAX_ATTEMPTS)
+	if err != nil || !found {
+		return false, err
+	}
+
+	if err = r.handle(ctx, tx, event); err != nil {
+		r.log.Error("failed to handle outbox event",
+			slog.Int64("id", event.ID), slog.String("type", event.Type), slog.String("error", err.Error()))
+
+		if err = r.repo.MarkOutboxEventFailedTx(ctx, tx, event.ID, err.Error()); err != nil {
+			return false, err
+		}
+	} else if err = r.repo.MarkOutboxEventProcessedTx(ctx, tx, event.ID); err != nil {
+		return false, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit tx: %w", err)
+	}
+
+	return true, nil
+}
+
+func (r *outboxRelay) handle(ctx context.Context, tx db.SqlTx, event models.OutboxEvent) error {
+	switch event.Type {
+	case models.EVENT_CHARGE_CAPTURE:
+		var e models.ChargeEvent
+		if err := unmarshalEvent(event, &e); err != nil {
+			return err
+		}
+
+		// the event is retried, if the tx fails after the capture, so the captured charge is not an error
+		if err := r.payment.Capture(e.ChargeUUID); err != nil {
+			return err
+		}
+
+		return r.repo.UpdateChargeStatusTx(ctx, tx, e.ChargeUUID, models.CHARGE_STATUS_SUCCEEDED)
+	case models.EVENT_CHARGE_REFUND:
+		var e models.ChargeEvent
+		if err := unmarshalEvent(event, &e); err != nil {
+			return err
+		}
+
+		if err := r.payment.Refund(e.ChargeUUID); err != nil {
+			return err
+		}
+
+		return r.repo.UpdateChargeStatusTx(ctx, tx, e.ChargeUUID, models.CHARGE_STATUS_REFUNDED)
+	case models.EVENT_RENT_CREATED:
+		var n models.RentNotification
+		if err := unmarshalEvent(event, &n); err != nil {
+			return err
+		}
+
+		return r.notifier.RentCreated(ctx, n)
+	case models.EVENT_RENT_CANCELED:
+		var n models.RentNotification
+		if err := unmarshalEvent(event, &n); err != nil {
+			return err
+		}
+
+		return r.notifier.RentCanceled(ctx, n)
+	default:
+		return &models.Error{
+			Msg:    fmt.Sprintf("unknown outbox event type: %s", event.Type),
+			Status: http.StatusInternalServerError,
+		}
+	}
+}
+
+func unmarshalEvent(event models.OutboxEvent, target any) error {
+	if err := json.Unmarshal(event.Payload, target); err != nil {
+		return &models.Error{
+			Msg:    fmt.Sprintf("failed to unmarshal outbox event payload: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return nil
+}
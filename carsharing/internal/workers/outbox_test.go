@@ -0,0 +1,83 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/alserov/rently/carsharing/internal/config"
+	"github.com/alserov/rently/carsharing/internal/db"
+	repomock "github.com/alserov/rently/carsharing/internal/db/mocks"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"github.com/alserov/rently/carsharing/internal/notifications"
+	"github.com/alserov/rently/carsharing/internal/payment"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type testProducer struct {
+	ids []string
+}
+
+func (p *testProducer) Produce(_ context.Context, _ any, id string, _ string) error {
+	p.ids = append(p.ids, id)
+	return nil
+}
+
+func event(t *testing.T, eventType string, payload any) models.OutboxEvent {
+	b, err := json.Marshal(payload)
+	require.NoError(t, err)
+
+	return models.OutboxEvent{ID: 1, Type: eventType, Payload: b}
+}
+
+func TestOutboxRelay_Handle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	gateway := payment.NewFakeGateway(payment.FakeParams{})
+	chargeID, err := gateway.Authorize("tok_visa", models.NewMoney(100, models.DEFAULT_CURRENCY))
+	require.NoError(t, err)
+
+	repo := repomock.NewMockRepository(ctrl)
+	gomock.InOrder(
+		repo.EXPECT().UpdateChargeStatusTx(ctx, gomock.Any(), chargeID, models.CHARGE_STATUS_SUCCEEDED).Return(nil).Times(2),
+		repo.EXPECT().UpdateChargeStatusTx(ctx, gomock.Any(), chargeID, models.CHARGE_STATUS_REFUNDED).Return(nil).Times(2),
+	)
+
+	producer := &testProducer{}
+
+	r := &outboxRelay{
+		repo:     repo,
+		payment:  gateway,
+		notifier: notifications.NewNotifier(producer, config.Notification{}),
+	}
+
+	chargeEvent := models.ChargeEvent{ChargeUUID: chargeID, RentUUID: "uuid"}
+
+	// capture
+	require.NoError(t, r.handle(ctx, db.SqlTx{}, event(t, models.EVENT_CHARGE_CAPTURE, chargeEvent)))
+	ch, _ := gateway.Charge(chargeID)
+	require.True(t, ch.Captured)
+
+	// the event, retried after the failed commit, is handled again
+	require.NoError(t, r.handle(ctx, db.SqlTx{}, event(t, models.EVENT_CHARGE_CAPTURE, chargeEvent)))
+
+	// refund
+	require.NoError(t, r.handle(ctx, db.SqlTx{}, event(t, models.EVENT_CHARGE_REFUND, chargeEvent)))
+	require.NoError(t, r.handle(ctx, db.SqlTx{}, event(t, models.EVENT_CHARGE_REFUND, chargeEvent)))
+	ch, _ = gateway.Charge(chargeID)
+	require.True(t, ch.Refunded)
+
+	// failed capture does not change charge status
+	require.Error(t, r.handle(ctx, db.SqlTx{}, event(t, models.EVENT_CHARGE_CAPTURE, chargeEvent)))
+
+	// notifications
+	require.NoError(t, r.handle(ctx, db.SqlTx{}, event(t, models.EVENT_RENT_CREATED, models.RentNotification{RentUUID: "uuid"})))
+	require.NoError(t, r.handle(ctx, db.SqlTx{}, event(t, models.EVENT_RENT_CANCELED, models.RentNotification{RentUUID: "uuid"})))
+	require.Equal(t, []string{notifications.RENT_CREATED_ID, notifications.RENT_CANCELED_ID}, producer.ids)
+
+	// unknown event
+	require.Error(t, r.handle(ctx, db.SqlTx{}, event(t, "unknown", nil)))
+}
@@ -0,0 +1,146 @@
+package workers
+
+import (
+	"context"
+	"github.com/alserov/rently/carsharing/internal/db"
+	"github.com/alserov/rently/carsharing/internal/log"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"github.com/alserov/rently/carsharing/internal/payment"
+	"log/slog"
+	"time"
+)
+
+type ReconcilerParams struct {
+	Repo    db.Repository
+	Payment payment.Payer
+}
+
+// NewReconciler returns the worker, that releases the authorizations without the stored charge, which are left,
+// when the rent tx is not committed after the authorization, requeues the captures and the refunds, which have exhausted
+// their attempts, and reports active rents without charge and charges stuck after the rent end to be resolved manually
+func NewReconciler(p ReconcilerParams) Actor {
+	return &reconciler{
+		log:     log.GetLogger(),
+		repo:    p.Repo,
+		payment: p.Payment,
+		now:     time.Now,
+	}
+}
+
+const (
+	// RECONCILE_LOOKBACK covers the lifetime of the authorizations at the provider
+	RECONCILE_LOOKBACK = time.Hour * 24 * 7
+	// RECONCILE_GRACE_PERIOD skips the fresh authorizations, the rent tx of which can be still running
+	RECONCILE_GRACE_PERIOD = time.Minute * 15
+)
+
+type reconciler struct {
+	log log.Logger
+
+	repo db.Repository
+
+	payment payment.Payer
+
+	now func() time.Time
+}
+
+func (r *reconciler) Action() error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	if err := r.releaseOrphanedAuthorizations(ctx); err != nil {
+		return err
+	}
+
+	if err := r.requeueStuckCharges(ctx); err != nil {
+		return err
+	}
+
+	rentUUIDs, err := r.repo.GetRentsWithoutCharge(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, rentUUID := range rentUUIDs {
+		r.log.Error("found rent without succeeded charge", slog.String("rent", rentUUID))
+	}
+
+	return nil
+}
+
+func (r *reconciler) releaseOrphanedAuthorizations(ctx context.Context) error {
+	now := r.now()
+
+	auths, err := r.payment.GetAuthorizations(now.Add(-RECONCILE_LOOKBACK))
+	if err != nil {
+		return err
+	}
+
+	var ids []string
+	for _, auth := range auths {
+		if auth.CreatedAt.Before(now.Add(-RECONCILE_GRACE_PERIOD)) {
+			ids = append(ids, auth.ChargeID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	existing, err := r.repo.GetExistingCharges(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	stored := make(map[string]struct{}, len(existing))
+	for _, id := range existing {
+		stored[id] = struct{}{}
+	}
+
+	for _, id := range ids {
+		if _, ok := stored[id]; ok {
+			continue
+		}
+
+		r.log.Warn("found authorization without charge, releasing", slog.String("charge", id))
+
+		// the other authorizations are released, the failed one is retried on the next run
+		if err = r.payment.Refund(id); err != nil {
+			r.log.Error("failed to release authorization", slog.String("charge", id), slog.String("error", err.Error()))
+		}
+	}
+
+	return nil
+}
+
+// requeueStuckCharges the capture follows the authorization right away, so the charge is stuck, if it is still
+// authorized after the rent end, the events of the stuck charges are retried again, while they keep failing
+func (r *reconciler) requeueStuckCharges(ctx context.Context) error {
+	charges, err := r.repo.GetStuckCharges(ctx, r.now())
+	if err != nil {
+		return err
+	}
+
+	for _, ch := range charges {
+		eventType := models.EVENT_CHARGE_CAPTURE
+		if ch.Status == models.CHARGE_STATUS_REFUNDING {
+			eventType = models.EVENT_CHARGE_REFUND
+		}
+
+		requeued, err := r.repo.RequeueChargeEvent(ctx, eventType, ch.ChargeUUID, OUTBOX_MAX_ATTEMPTS)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case requeued:
+			r.log.Error("found charge event with exhausted attempts, requeued",
+				slog.String("charge", ch.ChargeUUID), slog.String("rent", ch.RentUUID), slog.String("type", eventType))
+		case ch.Status == models.CHARGE_STATUS_AUTHORIZED:
+			// the capture is either still retried or missing
+			r.log.Error("found charge authorized after rent end",
+				slog.String("charge", ch.ChargeUUID), slog.String("rent", ch.RentUUID))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package workers
+
+import (
+	"context"
+	repomock "github.com/alserov/rently/carsharing/internal/db/mocks"
+	"github.com/alserov/rently/carsharing/internal/log"
+	"github.com/alserov/rently/carsharing/internal/models"
+	"github.com/alserov/rently/carsharing/internal/payment"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestReconciler_AuthorizationWithoutCharge(t *testing.T) {
+	log.MustSetup(log.ENV_LOCAL)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	gateway := payment.NewFakeGateway(payment.FakeParams{})
+
+	amount := models.NewMoney(100, models.DEFAULT_CURRENCY)
+	orphaned, err := gateway.Authorize("tok_visa", amount)
+	require.NoError(t, err)
+	stored, err := gateway.Authorize("tok_visa", amount)
+	require.NoError(t, err)
+	captured, err := gateway.Authorize("tok_visa", amount)
+	require.NoError(t, err)
+	require.NoError(t, gateway.Capture(captured))
+
+	repo := repomock.NewMockRepository(ctrl)
+	repo.EXPECT().
+		GetExistingCharges(gomock.Any(), []string{orphaned, stored}).
+		Return([]string{stored}, nil).
+		Times(1)
+	repo.EXPECT().GetRentsWithoutCharge(gomock.Any()).Return(nil, nil).Times(2)
+	repo.EXPECT().GetStuckCharges(gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+
+	r := &reconciler{
+		log:     log.GetLogger(),
+		repo:    repo,
+		payment: gateway,
+		now:     time.Now,
+	}
+
+	// the fresh authorizations can belong to the running rent tx
+	require.NoError(t, r.Action())
+	ch, _ := gateway.Charge(orphaned)
+	require.False(t, ch.Refunded)
+
+	r.now = func() time.Time { return time.Now().Add(RECONCILE_GRACE_PERIOD) }
+	require.NoError(t, r.Action())
+
+	ch, _ = gateway.Charge(orphaned)
+	require.True(t, ch.Refunded)
+	ch, _ = gateway.Charge(stored)
+	require.False(t, ch.Refunded)
+	ch, _ = gateway.Charge(captured)
+	require.False(t, ch.Refunded)
+}
+
+func TestReconciler_StuckCharges(t *testing.T) {
+	log.MustSetup(log.ENV_LOCAL)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now()
+
+	repo := repomock.NewMockRepository(ctrl)
+	repo.EXPECT().
+		GetStuckCharges(gomock.Any(), now).
+		Return([]models.Charge{
+			{ChargeUUID: "exhausted", RentUUID: "rent", Status: models.CHARGE_STATUS_AUTHORIZED},
+			{ChargeUUID: "retried", RentUUID: "rent", Status: models.CHARGE_STATUS_AUTHORIZED},
+			{ChargeUUID: "refunding", RentUUID: "rent", Status: models.CHARGE_STATUS_REFUNDING},
+		}, nil).
+		Times(1)
+	// the capture and the refund are requeued by their charge statuses
+	repo.EXPECT().RequeueChargeEvent(gomock.Any(), models.EVENT_CHARGE_CAPTURE, "exhausted", OUTBOX_MAX_ATTEMPTS).Return(true, nil).Times(1)
+	repo.EXPECT().RequeueChargeEvent(gomock.Any(), models.EVENT_CHARGE_CAPTURE, "retried", OUTBOX_MAX_ATTEMPTS).Return(false, nil).Times(1)
+	repo.EXPECT().RequeueChargeEvent(gomock.Any(), models.EVENT_CHARGE_REFUND, "refunding", OUTBOX_MAX_ATTEMPTS).Return(true, nil).Times(1)
+
+	r := &reconciler{
+		log:  log.GetLogger(),
+		repo: repo,
+		now:  func() time.Time { return now },
+	}
+
+	require.NoError(t, r.requeueStuckCharges(context.Background()))
+}
@@ -0,0 +1,20 @@
+package workers
+
+import (
+	"fmt"
+	"github.com/alserov/rently/carsharing/internal/log"
+	"time"
+)
+
+type Actor interface {
+	Action() error
+}
+
+func StartWithTicker(ticker *time.Ticker, actor Actor) {
+	l := log.GetLogger()
+	for range ticker.C {
+		if err := actor.Action(); err != nil {
+			l.Error(fmt.Errorf("ticker error: %w", err).Error())
+		}
+	}
+}
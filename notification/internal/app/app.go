@@ -41,6 +41,8 @@ func MustStart(cfg *config.Config) {
 
 	cons := rabbit.NewConsumer(rbtCh)
 	wm.Add(workers.NewEmailWorker(587, "smtp.gmail.com", cons.Consume(cfg.Broker.Topics.Email)))
+	wm.Add(workers.NewEmailWorker(587, "smtp.gmail.com", cons.Consume(cfg.Broker.Topics.RentCreated)))
+	wm.Add(workers.NewEmailWorker(587, "smtp.gmail.com", cons.Consume(cfg.Broker.Topics.RentCanceled)))
 
 	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer cancel()
@@ -23,7 +23,9 @@ type Broker struct {
 }
 
 type Topics struct {
-	Email string `yaml:"email"`
+	Email        string `yaml:"email"`
+	RentCreated  string `yaml:"rentCreated"`
+	RentCanceled string `yaml:"rentCanceled"`
 }
 
 func MustLoad() *Config {
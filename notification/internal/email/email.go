@@ -1,16 +1,19 @@
 package email
 
 import (
+	"bytes"
+	"embed"
 	"errors"
 	"fmt"
 	"github.com/jordan-wright/email"
+	"html/template"
 	"net/smtp"
-	"os"
 	"strconv"
+	"time"
 )
 
 type Emailer interface {
-	Send(t MessageType, to string) error
+	Send(t MessageType, to string, data any) error
 }
 
 type MessageType int
@@ -18,10 +21,20 @@ type MessageType int
 const (
 	REGISTER MessageType = iota
 	LOGIN
+	RENT_CREATED
+	RENT_CANCELED
+	PASSWORD_RESET
+	EMAIL_VERIFICATION
 
 	ERR_UNKNOWN_MESSAGE_TYPE = "unknown message type"
+	ERR_EMPTY_RECIPIENT      = "recipient can not be empty"
 )
 
+// templates are embedded, so they do not depend on the working directory
+//
+//go:embed templates/*.html
+var templates embed.FS
+
 func MessageTypeStringToInt(val string) MessageType {
 	t, err := strconv.Atoi(val)
 	if err != nil {
@@ -31,34 +44,83 @@ func MessageTypeStringToInt(val string) MessageType {
 	return MessageType(t)
 }
 
+// RentInfo is a message produced by carsharing service on rent creation and cancellation
+type RentInfo struct {
+	RentUUID string  `json:"rentUUID"`
+	CarUUID  string  `json:"carUUID"`
+	UserUUID string  `json:"userUUID"`
+	Email    string  `json:"email"`
+	Amount   float32 `json:"amount"`
+
+	RentStart time.Time `json:"rentStart"`
+	RentEnd   time.Time `json:"rentEnd"`
+}
+
+// PasswordResetInfo is a message produced by user service, when the user forgot the password
+type PasswordResetInfo struct {
+	Email     string    `json:"email"`
+	Link      string    `json:"link"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// EmailVerificationInfo is a message produced by user service on registration
+type EmailVerificationInfo struct {
+	Email     string    `json:"email"`
+	Link      string    `json:"link"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// Transport delivers the built emails
+type Transport interface {
+	Send(m *email.Email) error
+}
+
 type Params struct {
 	From     string
 	Password string
 	Sender   string
 	SmtpHost string
 	SmtpPort int
+	// Transport is the smtp one, if it is not set
+	Transport Transport
 }
 
 func NewEmailer(p Params) Emailer {
+	if p.Transport == nil {
+		p.Transport = &smtpTransport{
+			addr: fmt.Sprintf("%s:%d", p.SmtpHost, p.SmtpPort),
+			auth: smtp.PlainAuth("", p.From, p.Password, p.SmtpHost),
+		}
+	}
+
 	return &emailer{
-		from:     p.From,
-		sender:   p.Sender,
-		password: p.Password,
-		smtpHost: p.SmtpHost,
-		smtpPort: p.SmtpPort,
+		from:      p.From,
+		sender:    p.Sender,
+		transport: p.Transport,
 	}
 }
 
 type emailer struct {
-	from     string
-	password string
-	sender   string
+	from   string
+	sender string
+
+	transport Transport
+}
+
+type smtpTransport struct {
+	addr string
+	auth smtp.Auth
+}
 
-	smtpHost string
-	smtpPort int
+func (t *smtpTransport) Send(m *email.Email) error {
+	return m.Send(t.addr, t.auth)
 }
 
-func (e *emailer) Send(t MessageType, to string) error {
+func (e *emailer) Send(t MessageType, to string, data any) error {
+	if to == "" {
+		return errors.New(ERR_EMPTY_RECIPIENT)
+	}
+
 	var (
 		m   = email.NewEmail()
 		err error
@@ -68,6 +130,14 @@ func (e *emailer) Send(t MessageType, to string) error {
 		err = e.register(m, to)
 	case LOGIN:
 		err = e.login(m, to)
+	case RENT_CREATED:
+		err = e.rentCreated(m, to, data)
+	case RENT_CANCELED:
+		err = e.rentCanceled(m, to, data)
+	case PASSWORD_RESET:
+		err = e.passwordReset(m, to, data)
+	case EMAIL_VERIFICATION:
+		err = e.emailVerification(m, to, data)
 	default:
 		err = errors.New(ERR_UNKNOWN_MESSAGE_TYPE)
 	}
@@ -77,7 +147,7 @@ func (e *emailer) Send(t MessageType, to string) error {
 		return fmt.Errorf("failed to send email: %w", err)
 	}
 
-	return m.Send(fmt.Sprintf("%s:%d", e.smtpHost, e.smtpPort), smtp.PlainAuth("", e.from, e.password, e.smtpHost))
+	return e.transport.Send(m)
 }
 
 func (e *emailer) login(m *email.Email, to string) error {
@@ -85,7 +155,7 @@ func (e *emailer) login(m *email.Email, to string) error {
 	m.Sender = e.sender
 	m.To = []string{to}
 	m.Subject = "Login"
-	b, err := os.ReadFile("internal/email/templates/auth.html")
+	b, err := templates.ReadFile("templates/auth.html")
 	if err != nil {
 		return fmt.Errorf("failed to read html template file: %w", err)
 	}
@@ -99,7 +169,7 @@ func (e *emailer) register(m *email.Email, to string) error {
 	m.Sender = e.sender
 	m.To = []string{to}
 	m.Subject = "Registration"
-	b, err := os.ReadFile("internal/email/templates/auth.html")
+	b, err := templates.ReadFile("templates/auth.html")
 	if err != nil {
 		return fmt.Errorf("failed to read html template file: %w", err)
 	}
@@ -107,3 +177,73 @@ func (e *emailer) register(m *email.Email, to string) error {
 
 	return nil
 }
+
+func (e *emailer) rentCreated(m *email.Email, to string, data any) error {
+	m.From = e.from
+	m.Sender = e.sender
+	m.To = []string{to}
+	m.Subject = "Rent confirmation"
+	b, err := execute("rent_created.html", data)
+	if err != nil {
+		return err
+	}
+	m.HTML = b
+
+	return nil
+}
+
+func (e *emailer) rentCanceled(m *email.Email, to string, data any) error {
+	m.From = e.from
+	m.Sender = e.sender
+	m.To = []string{to}
+	m.Subject = "Rent cancellation"
+	b, err := execute("rent_canceled.html", data)
+	if err != nil {
+		return err
+	}
+	m.HTML = b
+
+	return nil
+}
+
+func (e *emailer) passwordReset(m *email.Email, to string, data any) error {
+	m.From = e.from
+	m.Sender = e.sender
+	m.To = []string{to}
+	m.Subject = "Password reset"
+	b, err := execute("password_reset.html", data)
+	if err != nil {
+		return err
+	}
+	m.HTML = b
+
+	return nil
+}
+
+func (e *emailer) emailVerification(m *email.Email, to string, data any) error {
+	m.From = e.from
+	m.Sender = e.sender
+	m.To = []string{to}
+	m.Subject = "Email verification"
+	b, err := execute("email_verification.html", data)
+	if err != nil {
+		return err
+	}
+	m.HTML = b
+
+	return nil
+}
+
+func execute(name string, data any) ([]byte, error) {
+	tmpl, err := template.ParseFS(templates, "templates/"+name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse html template file: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err = tmpl.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("failed to execute html template: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
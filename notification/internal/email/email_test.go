@@ -1,17 +1,83 @@
 package email
 
 import (
+	"github.com/jordan-wright/email"
 	"github.com/stretchr/testify/require"
 	"testing"
+	"time"
 )
 
 func TestEmailer_Send(t *testing.T) {
+	transport := &fakeTransport{}
 	e := NewEmailer(Params{
-		From:     "asroka4ev@gmail.com",
-		Password: "bwch rbes cemw ayyc",
-		SmtpHost: "smtp.gmail.com",
-		SmtpPort: 587,
+		From:      "from@mail.com",
+		Sender:    "Rently",
+		Transport: transport,
 	})
-	err := e.Registration("myweirdmailik@gmail.com")
+
+	require.NoError(t, e.Send(REGISTER, "to@mail.com", nil))
+	require.Len(t, transport.sent, 1)
+	require.Equal(t, []string{"to@mail.com"}, transport.sent[0].To)
+	require.Equal(t, "Registration", transport.sent[0].Subject)
+	require.NotEmpty(t, transport.sent[0].HTML)
+
+	require.Error(t, e.Send(REGISTER, "", nil))
+	require.Error(t, e.Send(MessageType(-1), "to@mail.com", nil))
+	require.Len(t, transport.sent, 1)
+}
+
+func TestExecuteRentTemplates(t *testing.T) {
+	rentStart := time.Date(2024, 2, 10, 12, 0, 0, 0, time.UTC)
+	rent := RentInfo{
+		RentUUID:  "rent_uuid",
+		CarUUID:   "car_uuid",
+		Email:     "email@mail.com",
+		Amount:    150.5,
+		RentStart: rentStart,
+		RentEnd:   rentStart.Add(time.Hour * 24 * 3),
+	}
+
+	for _, tmpl := range []string{"rent_created.html", "rent_canceled.html"} {
+		b, err := execute(tmpl, rent)
+		require.NoError(t, err)
+		require.Contains(t, string(b), rent.RentUUID)
+		require.Contains(t, string(b), "150.50")
+		require.Contains(t, string(b), "10.02.2024 12:00")
+		require.Contains(t, string(b), "13.02.2024 12:00")
+	}
+}
+
+func TestExecutePasswordResetTemplate(t *testing.T) {
+	reset := PasswordResetInfo{
+		Email:     "email@mail.com",
+		Link:      "http://localhost/password/reset?code=abc&lang=en",
+		ExpiresAt: time.Date(2024, 2, 10, 12, 30, 0, 0, time.UTC),
+	}
+
+	b, err := execute("password_reset.html", reset)
 	require.NoError(t, err)
+	require.Contains(t, string(b), `href="http://localhost/password/reset?code=abc&amp;lang=en"`)
+	require.Contains(t, string(b), "10.02.2024 12:30")
+}
+
+func TestExecuteEmailVerificationTemplate(t *testing.T) {
+	verification := EmailVerificationInfo{
+		Email:     "email@mail.com",
+		Link:      "http://localhost/email/verify?code=abc",
+		ExpiresAt: time.Date(2024, 2, 10, 12, 30, 0, 0, time.UTC),
+	}
+
+	b, err := execute("email_verification.html", verification)
+	require.NoError(t, err)
+	require.Contains(t, string(b), `href="http://localhost/email/verify?code=abc"`)
+	require.Contains(t, string(b), "10.02.2024 12:30")
+}
+
+type fakeTransport struct {
+	sent []*email.Email
+}
+
+func (t *fakeTransport) Send(m *email.Email) error {
+	t.sent = append(t.sent, m)
+	return nil
 }
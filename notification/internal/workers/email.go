@@ -42,13 +42,8 @@ func (w *worker) Start() {
 	for i := 0; i < sendMailWorkersAmount; i++ {
 		go func() {
 			for msg := range w.msgs {
-				var mail string
-				if err := json.Unmarshal(msg.Body, &mail); err != nil {
-					chErr <- fmt.Errorf("failed to unmarshal message: %w", err)
-				}
-
-				if err := w.email.Send(email.MessageTypeStringToInt(msg.CorrelationId), mail); err != nil {
-					chErr <- fmt.Errorf("failed to send email: %v", err)
+				if err := w.send(msg); err != nil {
+					chErr <- err
 				}
 			}
 		}()
@@ -58,3 +53,48 @@ func (w *worker) Start() {
 		w.log.Error("failed to send email", slog.String("error", e.Error()))
 	}
 }
+
+func (w *worker) send(msg amqp.Delivery) error {
+	t := email.MessageTypeStringToInt(msg.CorrelationId)
+
+	switch t {
+	case email.RENT_CREATED, email.RENT_CANCELED:
+		var rent email.RentInfo
+		if err := json.Unmarshal(msg.Body, &rent); err != nil {
+			return fmt.Errorf("failed to unmarshal message: %w", err)
+		}
+
+		if err := w.email.Send(t, rent.Email, rent); err != nil {
+			return fmt.Errorf("failed to send email: %v", err)
+		}
+	case email.PASSWORD_RESET:
+		var reset email.PasswordResetInfo
+		if err := json.Unmarshal(msg.Body, &reset); err != nil {
+			return fmt.Errorf("failed to unmarshal message: %w", err)
+		}
+
+		if err := w.email.Send(t, reset.Email, reset); err != nil {
+			return fmt.Errorf("failed to send email: %v", err)
+		}
+	case email.EMAIL_VERIFICATION:
+		var verification email.EmailVerificationInfo
+		if err := json.Unmarshal(msg.Body, &verification); err != nil {
+			return fmt.Errorf("failed to unmarshal message: %w", err)
+		}
+
+		if err := w.email.Send(t, verification.Email, verification); err != nil {
+			return fmt.Errorf("failed to send email: %v", err)
+		}
+	default:
+		var mail string
+		if err := json.Unmarshal(msg.Body, &mail); err != nil {
+			return fmt.Errorf("failed to unmarshal message: %w", err)
+		}
+
+		if err := w.email.Send(t, mail, nil); err != nil {
+			return fmt.Errorf("failed to send email: %v", err)
+		}
+	}
+
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -22,6 +23,63 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Money is an amount in minor units of the currency
+type Money struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount int64 `protobuf:"varint,1,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	// ISO 4217 currency code
+	Currency string `protobuf:"bytes,2,opt,name=Currency,proto3" json:"Currency,omitempty"`
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Money) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type GetRentStartingOnDateReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -33,7 +91,7 @@ type GetRentStartingOnDateReq struct {
 func (x *GetRentStartingOnDateReq) Reset() {
 	*x = GetRentStartingOnDateReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_carsharing_proto_msgTypes[0]
+		mi := &file_protos_carsharing_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -46,7 +104,7 @@ func (x *GetRentStartingOnDateReq) String() string {
 func (*GetRentStartingOnDateReq) ProtoMessage() {}
 
 func (x *GetRentStartingOnDateReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_carsharing_proto_msgTypes[0]
+	mi := &file_protos_carsharing_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -59,7 +117,7 @@ func (x *GetRentStartingOnDateReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRentStartingOnDateReq.ProtoReflect.Descriptor instead.
 func (*GetRentStartingOnDateReq) Descriptor() ([]byte, []int) {
-	return file_protos_carsharing_proto_rawDescGZIP(), []int{0}
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *GetRentStartingOnDateReq) GetStartingOn() *timestamppb.Timestamp {
@@ -80,7 +138,7 @@ type GetRentStartingOnDateRes struct {
 func (x *GetRentStartingOnDateRes) Reset() {
 	*x = GetRentStartingOnDateRes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_carsharing_proto_msgTypes[1]
+		mi := &file_protos_carsharing_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -93,7 +151,7 @@ func (x *GetRentStartingOnDateRes) String() string {
 func (*GetRentStartingOnDateRes) ProtoMessage() {}
 
 func (x *GetRentStartingOnDateRes) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_carsharing_proto_msgTypes[1]
+	mi := &file_protos_carsharing_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -106,7 +164,7 @@ func (x *GetRentStartingOnDateRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRentStartingOnDateRes.ProtoReflect.Descriptor instead.
 func (*GetRentStartingOnDateRes) Descriptor() ([]byte, []int) {
-	return file_protos_carsharing_proto_rawDescGZIP(), []int{1}
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *GetRentStartingOnDateRes) GetRentsInfo() []*CheckRentRes {
@@ -121,18 +179,21 @@ type CarMainInfo struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UUID        string  `protobuf:"bytes,1,opt,name=UUID,proto3" json:"UUID,omitempty"`
-	Brand       string  `protobuf:"bytes,2,opt,name=Brand,proto3" json:"Brand,omitempty"`
-	Type        string  `protobuf:"bytes,3,opt,name=Type,proto3" json:"Type,omitempty"`
-	Category    string  `protobuf:"bytes,4,opt,name=Category,proto3" json:"Category,omitempty"`
+	UUID     string `protobuf:"bytes,1,opt,name=UUID,proto3" json:"UUID,omitempty"`
+	Brand    string `protobuf:"bytes,2,opt,name=Brand,proto3" json:"Brand,omitempty"`
+	Type     string `protobuf:"bytes,3,opt,name=Type,proto3" json:"Type,omitempty"`
+	Category string `protobuf:"bytes,4,opt,name=Category,proto3" json:"Category,omitempty"`
+	// Deprecated: Do not use.
 	PricePerDay float32 `protobuf:"fixed32,5,opt,name=PricePerDay,proto3" json:"PricePerDay,omitempty"`
 	Image       string  `protobuf:"bytes,6,opt,name=Image,proto3" json:"Image,omitempty"`
+	Price       *Money  `protobuf:"bytes,7,opt,name=Price,proto3" json:"Price,omitempty"`
+	StationUUID string  `protobuf:"bytes,8,opt,name=StationUUID,proto3" json:"StationUUID,omitempty"`
 }
 
 func (x *CarMainInfo) Reset() {
 	*x = CarMainInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_carsharing_proto_msgTypes[2]
+		mi := &file_protos_carsharing_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -145,7 +206,7 @@ func (x *CarMainInfo) String() string {
 func (*CarMainInfo) ProtoMessage() {}
 
 func (x *CarMainInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_carsharing_proto_msgTypes[2]
+	mi := &file_protos_carsharing_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -158,7 +219,7 @@ func (x *CarMainInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CarMainInfo.ProtoReflect.Descriptor instead.
 func (*CarMainInfo) Descriptor() ([]byte, []int) {
-	return file_protos_carsharing_proto_rawDescGZIP(), []int{2}
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CarMainInfo) GetUUID() string {
@@ -189,6 +250,7 @@ func (x *CarMainInfo) GetCategory() string {
 	return ""
 }
 
+// Deprecated: Do not use.
 func (x *CarMainInfo) GetPricePerDay() float32 {
 	if x != nil {
 		return x.PricePerDay
@@ -203,6 +265,20 @@ func (x *CarMainInfo) GetImage() string {
 	return ""
 }
 
+func (x *CarMainInfo) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *CarMainInfo) GetStationUUID() string {
+	if x != nil {
+		return x.StationUUID
+	}
+	return ""
+}
+
 type GetImageReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -210,12 +286,14 @@ type GetImageReq struct {
 
 	Bucket string `protobuf:"bytes,1,opt,name=Bucket,proto3" json:"Bucket,omitempty"`
 	Id     string `protobuf:"bytes,2,opt,name=Id,proto3" json:"Id,omitempty"`
+	// thumbnail, medium or original, empty means original
+	Variant string `protobuf:"bytes,3,opt,name=Variant,proto3" json:"Variant,omitempty"`
 }
 
 func (x *GetImageReq) Reset() {
 	*x = GetImageReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_carsharing_proto_msgTypes[3]
+		mi := &file_protos_carsharing_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -228,7 +306,7 @@ func (x *GetImageReq) String() string {
 func (*GetImageReq) ProtoMessage() {}
 
 func (x *GetImageReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_carsharing_proto_msgTypes[3]
+	mi := &file_protos_carsharing_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -241,7 +319,7 @@ func (x *GetImageReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetImageReq.ProtoReflect.Descriptor instead.
 func (*GetImageReq) Descriptor() ([]byte, []int) {
-	return file_protos_carsharing_proto_rawDescGZIP(), []int{3}
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetImageReq) GetBucket() string {
@@ -258,31 +336,43 @@ func (x *GetImageReq) GetId() string {
 	return ""
 }
 
-type GetImageRes struct {
+func (x *GetImageReq) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+type GetImageStreamReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	File []byte `protobuf:"bytes,1,opt,name=File,proto3" json:"File,omitempty"`
+	Bucket  string `protobuf:"bytes,1,opt,name=Bucket,proto3" json:"Bucket,omitempty"`
+	Id      string `protobuf:"bytes,2,opt,name=Id,proto3" json:"Id,omitempty"`
+	Variant string `protobuf:"bytes,3,opt,name=Variant,proto3" json:"Variant,omitempty"`
+	// Offset and Length select the bytes of the image, zero Length means up to the end
+	Offset int64 `protobuf:"varint,4,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	Length int64 `protobuf:"varint,5,opt,name=Length,proto3" json:"Length,omitempty"`
 }
 
-func (x *GetImageRes) Reset() {
-	*x = GetImageRes{}
+func (x *GetImageStreamReq) Reset() {
+	*x = GetImageStreamReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_carsharing_proto_msgTypes[4]
+		mi := &file_protos_carsharing_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetImageRes) String() string {
+func (x *GetImageStreamReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetImageRes) ProtoMessage() {}
+func (*GetImageStreamReq) ProtoMessage() {}
 
-func (x *GetImageRes) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_carsharing_proto_msgTypes[4]
+func (x *GetImageStreamReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -293,83 +383,60 @@ func (x *GetImageRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetImageRes.ProtoReflect.Descriptor instead.
-func (*GetImageRes) Descriptor() ([]byte, []int) {
-	return file_protos_carsharing_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use GetImageStreamReq.ProtoReflect.Descriptor instead.
+func (*GetImageStreamReq) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetImageRes) GetFile() []byte {
+func (x *GetImageStreamReq) GetBucket() string {
 	if x != nil {
-		return x.File
+		return x.Bucket
 	}
-	return nil
-}
-
-type UpdateCarPriceReq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	CarUUID     string  `protobuf:"bytes,1,opt,name=CarUUID,proto3" json:"CarUUID,omitempty"`
-	PricePerDay float32 `protobuf:"fixed32,2,opt,name=PricePerDay,proto3" json:"PricePerDay,omitempty"`
+	return ""
 }
 
-func (x *UpdateCarPriceReq) Reset() {
-	*x = UpdateCarPriceReq{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_carsharing_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetImageStreamReq) GetId() string {
+	if x != nil {
+		return x.Id
 	}
+	return ""
 }
 
-func (x *UpdateCarPriceReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*UpdateCarPriceReq) ProtoMessage() {}
-
-func (x *UpdateCarPriceReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_carsharing_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GetImageStreamReq) GetVariant() string {
+	if x != nil {
+		return x.Variant
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UpdateCarPriceReq.ProtoReflect.Descriptor instead.
-func (*UpdateCarPriceReq) Descriptor() ([]byte, []int) {
-	return file_protos_carsharing_proto_rawDescGZIP(), []int{5}
+	return ""
 }
 
-func (x *UpdateCarPriceReq) GetCarUUID() string {
+func (x *GetImageStreamReq) GetOffset() int64 {
 	if x != nil {
-		return x.CarUUID
+		return x.Offset
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateCarPriceReq) GetPricePerDay() float32 {
+func (x *GetImageStreamReq) GetLength() int64 {
 	if x != nil {
-		return x.PricePerDay
+		return x.Length
 	}
 	return 0
 }
 
-type DeleteCarReq struct {
+type ImageChunk struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CarUUID string `protobuf:"bytes,1,opt,name=CarUUID,proto3" json:"CarUUID,omitempty"`
+	// ContentType, Size and Offset are sent with the first chunk only, Size is the size of the whole image
+	ContentType string `protobuf:"bytes,1,opt,name=ContentType,proto3" json:"ContentType,omitempty"`
+	Size        int64  `protobuf:"varint,2,opt,name=Size,proto3" json:"Size,omitempty"`
+	Offset      int64  `protobuf:"varint,3,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	Data        []byte `protobuf:"bytes,4,opt,name=Data,proto3" json:"Data,omitempty"`
 }
 
-func (x *DeleteCarReq) Reset() {
-	*x = DeleteCarReq{}
+func (x *ImageChunk) Reset() {
+	*x = ImageChunk{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_carsharing_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -377,13 +444,13 @@ func (x *DeleteCarReq) Reset() {
 	}
 }
 
-func (x *DeleteCarReq) String() string {
+func (x *ImageChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCarReq) ProtoMessage() {}
+func (*ImageChunk) ProtoMessage() {}
 
-func (x *DeleteCarReq) ProtoReflect() protoreflect.Message {
+func (x *ImageChunk) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_carsharing_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -395,35 +462,53 @@ func (x *DeleteCarReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCarReq.ProtoReflect.Descriptor instead.
-func (*DeleteCarReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImageChunk.ProtoReflect.Descriptor instead.
+func (*ImageChunk) Descriptor() ([]byte, []int) {
 	return file_protos_carsharing_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *DeleteCarReq) GetCarUUID() string {
+func (x *ImageChunk) GetContentType() string {
 	if x != nil {
-		return x.CarUUID
+		return x.ContentType
 	}
 	return ""
 }
 
-type CreateCarReq struct {
+func (x *ImageChunk) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ImageChunk) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ImageChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// UploadCarImageReq the first message carries Info, the next ones carry the chunks of the file
+type UploadCarImageReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Brand       string   `protobuf:"bytes,1,opt,name=Brand,proto3" json:"Brand,omitempty"`
-	Type        string   `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
-	MaxSpeed    int32    `protobuf:"varint,3,opt,name=MaxSpeed,proto3" json:"MaxSpeed,omitempty"`
-	Seats       int32    `protobuf:"varint,4,opt,name=Seats,proto3" json:"Seats,omitempty"`
-	Category    string   `protobuf:"bytes,5,opt,name=Category,proto3" json:"Category,omitempty"`
-	PricePerDay float32  `protobuf:"fixed32,6,opt,name=PricePerDay,proto3" json:"PricePerDay,omitempty"`
-	Images      [][]byte `protobuf:"bytes,7,rep,name=Images,proto3" json:"Images,omitempty"`
-	MainImage   []byte   `protobuf:"bytes,8,opt,name=MainImage,proto3" json:"MainImage,omitempty"`
+	// Types that are assignable to Data:
+	//	*UploadCarImageReq_Info
+	//	*UploadCarImageReq_Chunk
+	Data isUploadCarImageReq_Data `protobuf_oneof:"Data"`
 }
 
-func (x *CreateCarReq) Reset() {
-	*x = CreateCarReq{}
+func (x *UploadCarImageReq) Reset() {
+	*x = UploadCarImageReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_carsharing_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -431,13 +516,13 @@ func (x *CreateCarReq) Reset() {
 	}
 }
 
-func (x *CreateCarReq) String() string {
+func (x *UploadCarImageReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCarReq) ProtoMessage() {}
+func (*UploadCarImageReq) ProtoMessage() {}
 
-func (x *CreateCarReq) ProtoReflect() protoreflect.Message {
+func (x *UploadCarImageReq) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_carsharing_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -449,83 +534,60 @@ func (x *CreateCarReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCarReq.ProtoReflect.Descriptor instead.
-func (*CreateCarReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use UploadCarImageReq.ProtoReflect.Descriptor instead.
+func (*UploadCarImageReq) Descriptor() ([]byte, []int) {
 	return file_protos_carsharing_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CreateCarReq) GetBrand() string {
-	if x != nil {
-		return x.Brand
+func (m *UploadCarImageReq) GetData() isUploadCarImageReq_Data {
+	if m != nil {
+		return m.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateCarReq) GetType() string {
-	if x != nil {
-		return x.Type
+func (x *UploadCarImageReq) GetInfo() *UploadCarImageInfo {
+	if x, ok := x.GetData().(*UploadCarImageReq_Info); ok {
+		return x.Info
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateCarReq) GetMaxSpeed() int32 {
-	if x != nil {
-		return x.MaxSpeed
+func (x *UploadCarImageReq) GetChunk() []byte {
+	if x, ok := x.GetData().(*UploadCarImageReq_Chunk); ok {
+		return x.Chunk
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateCarReq) GetSeats() int32 {
-	if x != nil {
-		return x.Seats
-	}
-	return 0
+type isUploadCarImageReq_Data interface {
+	isUploadCarImageReq_Data()
 }
 
-func (x *CreateCarReq) GetCategory() string {
-	if x != nil {
-		return x.Category
-	}
-	return ""
+type UploadCarImageReq_Info struct {
+	Info *UploadCarImageInfo `protobuf:"bytes,1,opt,name=Info,proto3,oneof"`
 }
 
-func (x *CreateCarReq) GetPricePerDay() float32 {
-	if x != nil {
-		return x.PricePerDay
-	}
-	return 0
+type UploadCarImageReq_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=Chunk,proto3,oneof"`
 }
 
-func (x *CreateCarReq) GetImages() [][]byte {
-	if x != nil {
-		return x.Images
-	}
-	return nil
-}
+func (*UploadCarImageReq_Info) isUploadCarImageReq_Data() {}
 
-func (x *CreateCarReq) GetMainImage() []byte {
-	if x != nil {
-		return x.MainImage
-	}
-	return nil
-}
+func (*UploadCarImageReq_Chunk) isUploadCarImageReq_Data() {}
 
-type CreateRentReq struct {
+type UploadCarImageInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CarUUID        string                 `protobuf:"bytes,1,opt,name=CarUUID,proto3" json:"CarUUID,omitempty"`
-	PhoneNumber    string                 `protobuf:"bytes,2,opt,name=PhoneNumber,proto3" json:"PhoneNumber,omitempty"`
-	PassportNumber string                 `protobuf:"bytes,3,opt,name=PassportNumber,proto3" json:"PassportNumber,omitempty"`
-	PaymentSource  string                 `protobuf:"bytes,4,opt,name=PaymentSource,proto3" json:"PaymentSource,omitempty"`
-	Token          string                 `protobuf:"bytes,5,opt,name=Token,proto3" json:"Token,omitempty"`
-	RentStart      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=RentStart,proto3" json:"RentStart,omitempty"`
-	RentEnd        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=RentEnd,proto3" json:"RentEnd,omitempty"`
+	CarUUID string `protobuf:"bytes,1,opt,name=CarUUID,proto3" json:"CarUUID,omitempty"`
+	// Main replaces the main image of the car
+	Main bool `protobuf:"varint,2,opt,name=Main,proto3" json:"Main,omitempty"`
 }
 
-func (x *CreateRentReq) Reset() {
-	*x = CreateRentReq{}
+func (x *UploadCarImageInfo) Reset() {
+	*x = UploadCarImageInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_carsharing_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -533,13 +595,13 @@ func (x *CreateRentReq) Reset() {
 	}
 }
 
-func (x *CreateRentReq) String() string {
+func (x *UploadCarImageInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRentReq) ProtoMessage() {}
+func (*UploadCarImageInfo) ProtoMessage() {}
 
-func (x *CreateRentReq) ProtoReflect() protoreflect.Message {
+func (x *UploadCarImageInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_carsharing_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -551,70 +613,35 @@ func (x *CreateRentReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRentReq.ProtoReflect.Descriptor instead.
-func (*CreateRentReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use UploadCarImageInfo.ProtoReflect.Descriptor instead.
+func (*UploadCarImageInfo) Descriptor() ([]byte, []int) {
 	return file_protos_carsharing_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *CreateRentReq) GetCarUUID() string {
+func (x *UploadCarImageInfo) GetCarUUID() string {
 	if x != nil {
 		return x.CarUUID
 	}
 	return ""
 }
 
-func (x *CreateRentReq) GetPhoneNumber() string {
+func (x *UploadCarImageInfo) GetMain() bool {
 	if x != nil {
-		return x.PhoneNumber
+		return x.Main
 	}
-	return ""
+	return false
 }
 
-func (x *CreateRentReq) GetPassportNumber() string {
-	if x != nil {
-		return x.PassportNumber
-	}
-	return ""
+type UploadCarImageRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
 }
 
-func (x *CreateRentReq) GetPaymentSource() string {
-	if x != nil {
-		return x.PaymentSource
-	}
-	return ""
-}
-
-func (x *CreateRentReq) GetToken() string {
-	if x != nil {
-		return x.Token
-	}
-	return ""
-}
-
-func (x *CreateRentReq) GetRentStart() *timestamppb.Timestamp {
-	if x != nil {
-		return x.RentStart
-	}
-	return nil
-}
-
-func (x *CreateRentReq) GetRentEnd() *timestamppb.Timestamp {
-	if x != nil {
-		return x.RentEnd
-	}
-	return nil
-}
-
-type CreateRentRes struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	RentUUID string `protobuf:"bytes,1,opt,name=RentUUID,proto3" json:"RentUUID,omitempty"`
-}
-
-func (x *CreateRentRes) Reset() {
-	*x = CreateRentRes{}
+func (x *UploadCarImageRes) Reset() {
+	*x = UploadCarImageRes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_carsharing_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -622,13 +649,13 @@ func (x *CreateRentRes) Reset() {
 	}
 }
 
-func (x *CreateRentRes) String() string {
+func (x *UploadCarImageRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRentRes) ProtoMessage() {}
+func (*UploadCarImageRes) ProtoMessage() {}
 
-func (x *CreateRentRes) ProtoReflect() protoreflect.Message {
+func (x *UploadCarImageRes) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_carsharing_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -640,28 +667,28 @@ func (x *CreateRentRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRentRes.ProtoReflect.Descriptor instead.
-func (*CreateRentRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use UploadCarImageRes.ProtoReflect.Descriptor instead.
+func (*UploadCarImageRes) Descriptor() ([]byte, []int) {
 	return file_protos_carsharing_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *CreateRentRes) GetRentUUID() string {
+func (x *UploadCarImageRes) GetId() string {
 	if x != nil {
-		return x.RentUUID
+		return x.Id
 	}
 	return ""
 }
 
-type CancelRentReq struct {
+type GetCarImagesReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RentUUID string `protobuf:"bytes,1,opt,name=RentUUID,proto3" json:"RentUUID,omitempty"`
+	CarUUID string `protobuf:"bytes,1,opt,name=CarUUID,proto3" json:"CarUUID,omitempty"`
 }
 
-func (x *CancelRentReq) Reset() {
-	*x = CancelRentReq{}
+func (x *GetCarImagesReq) Reset() {
+	*x = GetCarImagesReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_carsharing_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -669,13 +696,13 @@ func (x *CancelRentReq) Reset() {
 	}
 }
 
-func (x *CancelRentReq) String() string {
+func (x *GetCarImagesReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelRentReq) ProtoMessage() {}
+func (*GetCarImagesReq) ProtoMessage() {}
 
-func (x *CancelRentReq) ProtoReflect() protoreflect.Message {
+func (x *GetCarImagesReq) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_carsharing_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -687,28 +714,32 @@ func (x *CancelRentReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelRentReq.ProtoReflect.Descriptor instead.
-func (*CancelRentReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCarImagesReq.ProtoReflect.Descriptor instead.
+func (*GetCarImagesReq) Descriptor() ([]byte, []int) {
 	return file_protos_carsharing_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *CancelRentReq) GetRentUUID() string {
+func (x *GetCarImagesReq) GetCarUUID() string {
 	if x != nil {
-		return x.RentUUID
+		return x.CarUUID
 	}
 	return ""
 }
 
-type CheckRentReq struct {
+type CarImage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RentUUID string `protobuf:"bytes,2,opt,name=RentUUID,proto3" json:"RentUUID,omitempty"`
+	Id       string `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Position int32  `protobuf:"varint,2,opt,name=Position,proto3" json:"Position,omitempty"`
+	Main     bool   `protobuf:"varint,3,opt,name=Main,proto3" json:"Main,omitempty"`
+	// Variants are the stored sizes of the image: thumbnail, medium, original
+	Variants []string `protobuf:"bytes,4,rep,name=Variants,proto3" json:"Variants,omitempty"`
 }
 
-func (x *CheckRentReq) Reset() {
-	*x = CheckRentReq{}
+func (x *CarImage) Reset() {
+	*x = CarImage{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_carsharing_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -716,13 +747,13 @@ func (x *CheckRentReq) Reset() {
 	}
 }
 
-func (x *CheckRentReq) String() string {
+func (x *CarImage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckRentReq) ProtoMessage() {}
+func (*CarImage) ProtoMessage() {}
 
-func (x *CheckRentReq) ProtoReflect() protoreflect.Message {
+func (x *CarImage) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_carsharing_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -734,32 +765,50 @@ func (x *CheckRentReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckRentReq.ProtoReflect.Descriptor instead.
-func (*CheckRentReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use CarImage.ProtoReflect.Descriptor instead.
+func (*CarImage) Descriptor() ([]byte, []int) {
 	return file_protos_carsharing_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *CheckRentReq) GetRentUUID() string {
+func (x *CarImage) GetId() string {
 	if x != nil {
-		return x.RentUUID
+		return x.Id
 	}
 	return ""
 }
 
-type CheckRentRes struct {
+func (x *CarImage) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *CarImage) GetMain() bool {
+	if x != nil {
+		return x.Main
+	}
+	return false
+}
+
+func (x *CarImage) GetVariants() []string {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+// GetCarImagesRes Images are ordered by the position
+type GetCarImagesRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CarUUID   string                 `protobuf:"bytes,1,opt,name=CarUUID,proto3" json:"CarUUID,omitempty"`
-	RentPrice float32                `protobuf:"fixed32,2,opt,name=RentPrice,proto3" json:"RentPrice,omitempty"`
-	UserUUID  string                 `protobuf:"bytes,3,opt,name=UserUUID,proto3" json:"UserUUID,omitempty"`
-	RentStart *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=RentStart,proto3" json:"RentStart,omitempty"`
-	RentEnd   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=RentEnd,proto3" json:"RentEnd,omitempty"`
+	Images []*CarImage `protobuf:"bytes,1,rep,name=Images,proto3" json:"Images,omitempty"`
 }
 
-func (x *CheckRentRes) Reset() {
-	*x = CheckRentRes{}
+func (x *GetCarImagesRes) Reset() {
+	*x = GetCarImagesRes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_carsharing_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -767,13 +816,13 @@ func (x *CheckRentRes) Reset() {
 	}
 }
 
-func (x *CheckRentRes) String() string {
+func (x *GetCarImagesRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckRentRes) ProtoMessage() {}
+func (*GetCarImagesRes) ProtoMessage() {}
 
-func (x *CheckRentRes) ProtoReflect() protoreflect.Message {
+func (x *GetCarImagesRes) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_carsharing_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -785,64 +834,29 @@ func (x *CheckRentRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckRentRes.ProtoReflect.Descriptor instead.
-func (*CheckRentRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCarImagesRes.ProtoReflect.Descriptor instead.
+func (*GetCarImagesRes) Descriptor() ([]byte, []int) {
 	return file_protos_carsharing_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *CheckRentRes) GetCarUUID() string {
-	if x != nil {
-		return x.CarUUID
-	}
-	return ""
-}
-
-func (x *CheckRentRes) GetRentPrice() float32 {
-	if x != nil {
-		return x.RentPrice
-	}
-	return 0
-}
-
-func (x *CheckRentRes) GetUserUUID() string {
-	if x != nil {
-		return x.UserUUID
-	}
-	return ""
-}
-
-func (x *CheckRentRes) GetRentStart() *timestamppb.Timestamp {
-	if x != nil {
-		return x.RentStart
-	}
-	return nil
-}
-
-func (x *CheckRentRes) GetRentEnd() *timestamppb.Timestamp {
+func (x *GetCarImagesRes) GetImages() []*CarImage {
 	if x != nil {
-		return x.RentEnd
+		return x.Images
 	}
 	return nil
 }
 
-type Car struct {
+type DeleteCarImageReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Brand       string   `protobuf:"bytes,1,opt,name=Brand,proto3" json:"Brand,omitempty"`
-	Type        string   `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
-	MaxSpeed    int32    `protobuf:"varint,3,opt,name=MaxSpeed,proto3" json:"MaxSpeed,omitempty"`
-	Seats       int32    `protobuf:"varint,4,opt,name=Seats,proto3" json:"Seats,omitempty"`
-	Category    string   `protobuf:"bytes,5,opt,name=Category,proto3" json:"Category,omitempty"`
-	PricePerDay float32  `protobuf:"fixed32,6,opt,name=PricePerDay,proto3" json:"PricePerDay,omitempty"`
-	UUID        string   `protobuf:"bytes,7,opt,name=UUID,proto3" json:"UUID,omitempty"`
-	Images      []string `protobuf:"bytes,8,rep,name=Images,proto3" json:"Images,omitempty"`
-	MainImage   string   `protobuf:"bytes,9,opt,name=MainImage,proto3" json:"MainImage,omitempty"`
+	CarUUID string `protobuf:"bytes,1,opt,name=CarUUID,proto3" json:"CarUUID,omitempty"`
+	Id      string `protobuf:"bytes,2,opt,name=Id,proto3" json:"Id,omitempty"`
 }
 
-func (x *Car) Reset() {
-	*x = Car{}
+func (x *DeleteCarImageReq) Reset() {
+	*x = DeleteCarImageReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_carsharing_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -850,13 +864,13 @@ func (x *Car) Reset() {
 	}
 }
 
-func (x *Car) String() string {
+func (x *DeleteCarImageReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Car) ProtoMessage() {}
+func (*DeleteCarImageReq) ProtoMessage() {}
 
-func (x *Car) ProtoReflect() protoreflect.Message {
+func (x *DeleteCarImageReq) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_carsharing_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -868,85 +882,37 @@ func (x *Car) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Car.ProtoReflect.Descriptor instead.
-func (*Car) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteCarImageReq.ProtoReflect.Descriptor instead.
+func (*DeleteCarImageReq) Descriptor() ([]byte, []int) {
 	return file_protos_carsharing_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *Car) GetBrand() string {
-	if x != nil {
-		return x.Brand
-	}
-	return ""
-}
-
-func (x *Car) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-func (x *Car) GetMaxSpeed() int32 {
-	if x != nil {
-		return x.MaxSpeed
-	}
-	return 0
-}
-
-func (x *Car) GetSeats() int32 {
-	if x != nil {
-		return x.Seats
-	}
-	return 0
-}
-
-func (x *Car) GetCategory() string {
-	if x != nil {
-		return x.Category
-	}
-	return ""
-}
-
-func (x *Car) GetPricePerDay() float32 {
-	if x != nil {
-		return x.PricePerDay
-	}
-	return 0
-}
-
-func (x *Car) GetUUID() string {
+func (x *DeleteCarImageReq) GetCarUUID() string {
 	if x != nil {
-		return x.UUID
+		return x.CarUUID
 	}
 	return ""
 }
 
-func (x *Car) GetImages() []string {
-	if x != nil {
-		return x.Images
-	}
-	return nil
-}
-
-func (x *Car) GetMainImage() string {
+func (x *DeleteCarImageReq) GetId() string {
 	if x != nil {
-		return x.MainImage
+		return x.Id
 	}
 	return ""
 }
 
-type GetAvailableCarsReq struct {
+// ReorderCarImagesReq Ids are all the images of the car in the new order
+type ReorderCarImagesReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Start *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=Start,proto3" json:"Start,omitempty"`
-	End   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=End,proto3" json:"End,omitempty"`
+	CarUUID string   `protobuf:"bytes,1,opt,name=CarUUID,proto3" json:"CarUUID,omitempty"`
+	Ids     []string `protobuf:"bytes,2,rep,name=Ids,proto3" json:"Ids,omitempty"`
 }
 
-func (x *GetAvailableCarsReq) Reset() {
-	*x = GetAvailableCarsReq{}
+func (x *ReorderCarImagesReq) Reset() {
+	*x = ReorderCarImagesReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_carsharing_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -954,13 +920,13 @@ func (x *GetAvailableCarsReq) Reset() {
 	}
 }
 
-func (x *GetAvailableCarsReq) String() string {
+func (x *ReorderCarImagesReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAvailableCarsReq) ProtoMessage() {}
+func (*ReorderCarImagesReq) ProtoMessage() {}
 
-func (x *GetAvailableCarsReq) ProtoReflect() protoreflect.Message {
+func (x *ReorderCarImagesReq) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_carsharing_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -972,35 +938,36 @@ func (x *GetAvailableCarsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAvailableCarsReq.ProtoReflect.Descriptor instead.
-func (*GetAvailableCarsReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReorderCarImagesReq.ProtoReflect.Descriptor instead.
+func (*ReorderCarImagesReq) Descriptor() ([]byte, []int) {
 	return file_protos_carsharing_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetAvailableCarsReq) GetStart() *timestamppb.Timestamp {
+func (x *ReorderCarImagesReq) GetCarUUID() string {
 	if x != nil {
-		return x.Start
+		return x.CarUUID
 	}
-	return nil
+	return ""
 }
 
-func (x *GetAvailableCarsReq) GetEnd() *timestamppb.Timestamp {
+func (x *ReorderCarImagesReq) GetIds() []string {
 	if x != nil {
-		return x.End
+		return x.Ids
 	}
 	return nil
 }
 
-type GetCarsRes struct {
+type SetMainCarImageReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cars []*CarMainInfo `protobuf:"bytes,1,rep,name=Cars,proto3" json:"Cars,omitempty"`
+	CarUUID string `protobuf:"bytes,1,opt,name=CarUUID,proto3" json:"CarUUID,omitempty"`
+	Id      string `protobuf:"bytes,2,opt,name=Id,proto3" json:"Id,omitempty"`
 }
 
-func (x *GetCarsRes) Reset() {
-	*x = GetCarsRes{}
+func (x *SetMainCarImageReq) Reset() {
+	*x = SetMainCarImageReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_carsharing_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1008,13 +975,13 @@ func (x *GetCarsRes) Reset() {
 	}
 }
 
-func (x *GetCarsRes) String() string {
+func (x *SetMainCarImageReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCarsRes) ProtoMessage() {}
+func (*SetMainCarImageReq) ProtoMessage() {}
 
-func (x *GetCarsRes) ProtoReflect() protoreflect.Message {
+func (x *SetMainCarImageReq) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_carsharing_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1026,33 +993,35 @@ func (x *GetCarsRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCarsRes.ProtoReflect.Descriptor instead.
-func (*GetCarsRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetMainCarImageReq.ProtoReflect.Descriptor instead.
+func (*SetMainCarImageReq) Descriptor() ([]byte, []int) {
 	return file_protos_carsharing_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetCarsRes) GetCars() []*CarMainInfo {
+func (x *SetMainCarImageReq) GetCarUUID() string {
 	if x != nil {
-		return x.Cars
+		return x.CarUUID
 	}
-	return nil
+	return ""
 }
 
-type GetCarsByParamsReq struct {
+func (x *SetMainCarImageReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetImageRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Brand       string  `protobuf:"bytes,1,opt,name=Brand,proto3" json:"Brand,omitempty"`
-	Type        string  `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
-	MaxSpeed    int32   `protobuf:"varint,3,opt,name=MaxSpeed,proto3" json:"MaxSpeed,omitempty"`
-	Seats       int32   `protobuf:"varint,4,opt,name=Seats,proto3" json:"Seats,omitempty"`
-	Category    string  `protobuf:"bytes,5,opt,name=Category,proto3" json:"Category,omitempty"`
-	PricePerDay float32 `protobuf:"fixed32,6,opt,name=PricePerDay,proto3" json:"PricePerDay,omitempty"`
+	File []byte `protobuf:"bytes,1,opt,name=File,proto3" json:"File,omitempty"`
 }
 
-func (x *GetCarsByParamsReq) Reset() {
-	*x = GetCarsByParamsReq{}
+func (x *GetImageRes) Reset() {
+	*x = GetImageRes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_carsharing_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1060,13 +1029,13 @@ func (x *GetCarsByParamsReq) Reset() {
 	}
 }
 
-func (x *GetCarsByParamsReq) String() string {
+func (x *GetImageRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCarsByParamsReq) ProtoMessage() {}
+func (*GetImageRes) ProtoMessage() {}
 
-func (x *GetCarsByParamsReq) ProtoReflect() protoreflect.Message {
+func (x *GetImageRes) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_carsharing_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1078,23 +1047,2791 @@ func (x *GetCarsByParamsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCarsByParamsReq.ProtoReflect.Descriptor instead.
-func (*GetCarsByParamsReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetImageRes.ProtoReflect.Descriptor instead.
+func (*GetImageRes) Descriptor() ([]byte, []int) {
 	return file_protos_carsharing_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetCarsByParamsReq) GetBrand() string {
+func (x *GetImageRes) GetFile() []byte {
 	if x != nil {
-		return x.Brand
+		return x.File
 	}
-	return ""
+	return nil
 }
 
-func (x *GetCarsByParamsReq) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
+type UpdateCarPriceReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CarUUID string `protobuf:"bytes,1,opt,name=CarUUID,proto3" json:"CarUUID,omitempty"`
+	// Deprecated: Do not use.
+	PricePerDay float32 `protobuf:"fixed32,2,opt,name=PricePerDay,proto3" json:"PricePerDay,omitempty"`
+	Price       *Money  `protobuf:"bytes,3,opt,name=Price,proto3" json:"Price,omitempty"`
+}
+
+func (x *UpdateCarPriceReq) Reset() {
+	*x = UpdateCarPriceReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateCarPriceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCarPriceReq) ProtoMessage() {}
+
+func (x *UpdateCarPriceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCarPriceReq.ProtoReflect.Descriptor instead.
+func (*UpdateCarPriceReq) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateCarPriceReq) GetCarUUID() string {
+	if x != nil {
+		return x.CarUUID
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (x *UpdateCarPriceReq) GetPricePerDay() float32 {
+	if x != nil {
+		return x.PricePerDay
+	}
+	return 0
+}
+
+func (x *UpdateCarPriceReq) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+// UpdateCarReq only the fields of the mask are updated, the paths are the names of the Car fields: Brand, Type, MaxSpeed, Seats, Category, Price
+type UpdateCarReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CarUUID    string                 `protobuf:"bytes,1,opt,name=CarUUID,proto3" json:"CarUUID,omitempty"`
+	Car        *Car                   `protobuf:"bytes,2,opt,name=Car,proto3" json:"Car,omitempty"`
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=UpdateMask,proto3" json:"UpdateMask,omitempty"`
+}
+
+func (x *UpdateCarReq) Reset() {
+	*x = UpdateCarReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateCarReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCarReq) ProtoMessage() {}
+
+func (x *UpdateCarReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCarReq.ProtoReflect.Descriptor instead.
+func (*UpdateCarReq) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateCarReq) GetCarUUID() string {
+	if x != nil {
+		return x.CarUUID
+	}
+	return ""
+}
+
+func (x *UpdateCarReq) GetCar() *Car {
+	if x != nil {
+		return x.Car
+	}
+	return nil
+}
+
+func (x *UpdateCarReq) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteCarReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CarUUID string `protobuf:"bytes,1,opt,name=CarUUID,proto3" json:"CarUUID,omitempty"`
+}
+
+func (x *DeleteCarReq) Reset() {
+	*x = DeleteCarReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCarReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCarReq) ProtoMessage() {}
+
+func (x *DeleteCarReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCarReq.ProtoReflect.Descriptor instead.
+func (*DeleteCarReq) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeleteCarReq) GetCarUUID() string {
+	if x != nil {
+		return x.CarUUID
+	}
+	return ""
+}
+
+type GetCarChangesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CarUUID string `protobuf:"bytes,1,opt,name=CarUUID,proto3" json:"CarUUID,omitempty"`
+}
+
+func (x *GetCarChangesReq) Reset() {
+	*x = GetCarChangesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCarChangesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCarChangesReq) ProtoMessage() {}
+
+func (x *GetCarChangesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCarChangesReq.ProtoReflect.Descriptor instead.
+func (*GetCarChangesReq) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetCarChangesReq) GetCarUUID() string {
+	if x != nil {
+		return x.CarUUID
+	}
+	return ""
+}
+
+type GetCarChangesRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changes []*CarChange `protobuf:"bytes,1,rep,name=Changes,proto3" json:"Changes,omitempty"`
+}
+
+func (x *GetCarChangesRes) Reset() {
+	*x = GetCarChangesRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCarChangesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCarChangesRes) ProtoMessage() {}
+
+func (x *GetCarChangesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCarChangesRes.ProtoReflect.Descriptor instead.
+func (*GetCarChangesRes) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetCarChangesRes) GetChanges() []*CarChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type CarChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	// Actor is the uuid of the admin, who made the change
+	Actor     string                 `protobuf:"bytes,2,opt,name=Actor,proto3" json:"Actor,omitempty"`
+	Action    string                 `protobuf:"bytes,3,opt,name=Action,proto3" json:"Action,omitempty"`
+	Changes   []*FieldChange         `protobuf:"bytes,4,rep,name=Changes,proto3" json:"Changes,omitempty"`
+	ChangedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=ChangedAt,proto3" json:"ChangedAt,omitempty"`
+}
+
+func (x *CarChange) Reset() {
+	*x = CarChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CarChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CarChange) ProtoMessage() {}
+
+func (x *CarChange) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CarChange.ProtoReflect.Descriptor instead.
+func (*CarChange) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CarChange) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *CarChange) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *CarChange) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *CarChange) GetChanges() []*FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *CarChange) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+// FieldChange the values are json encoded
+type FieldChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field string `protobuf:"bytes,1,opt,name=Field,proto3" json:"Field,omitempty"`
+	Old   string `protobuf:"bytes,2,opt,name=Old,proto3" json:"Old,omitempty"`
+	New   string `protobuf:"bytes,3,opt,name=New,proto3" json:"New,omitempty"`
+}
+
+func (x *FieldChange) Reset() {
+	*x = FieldChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldChange) ProtoMessage() {}
+
+func (x *FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldChange.ProtoReflect.Descriptor instead.
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *FieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldChange) GetOld() string {
+	if x != nil {
+		return x.Old
+	}
+	return ""
+}
+
+func (x *FieldChange) GetNew() string {
+	if x != nil {
+		return x.New
+	}
+	return ""
+}
+
+type CreateCarReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Brand    string `protobuf:"bytes,1,opt,name=Brand,proto3" json:"Brand,omitempty"`
+	Type     string `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	MaxSpeed int32  `protobuf:"varint,3,opt,name=MaxSpeed,proto3" json:"MaxSpeed,omitempty"`
+	Seats    int32  `protobuf:"varint,4,opt,name=Seats,proto3" json:"Seats,omitempty"`
+	Category string `protobuf:"bytes,5,opt,name=Category,proto3" json:"Category,omitempty"`
+	// Deprecated: Do not use.
+	PricePerDay float32  `protobuf:"fixed32,6,opt,name=PricePerDay,proto3" json:"PricePerDay,omitempty"`
+	Images      [][]byte `protobuf:"bytes,7,rep,name=Images,proto3" json:"Images,omitempty"`
+	MainImage   []byte   `protobuf:"bytes,8,opt,name=MainImage,proto3" json:"MainImage,omitempty"`
+	Price       *Money   `protobuf:"bytes,9,opt,name=Price,proto3" json:"Price,omitempty"`
+	// StationUUID is the home station of the car, the car is picked up at
+	StationUUID string `protobuf:"bytes,10,opt,name=StationUUID,proto3" json:"StationUUID,omitempty"`
+}
+
+func (x *CreateCarReq) Reset() {
+	*x = CreateCarReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCarReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCarReq) ProtoMessage() {}
+
+func (x *CreateCarReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCarReq.ProtoReflect.Descriptor instead.
+func (*CreateCarReq) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateCarReq) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *CreateCarReq) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateCarReq) GetMaxSpeed() int32 {
+	if x != nil {
+		return x.MaxSpeed
+	}
+	return 0
+}
+
+func (x *CreateCarReq) GetSeats() int32 {
+	if x != nil {
+		return x.Seats
+	}
+	return 0
+}
+
+func (x *CreateCarReq) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (x *CreateCarReq) GetPricePerDay() float32 {
+	if x != nil {
+		return x.PricePerDay
+	}
+	return 0
+}
+
+func (x *CreateCarReq) GetImages() [][]byte {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *CreateCarReq) GetMainImage() []byte {
+	if x != nil {
+		return x.MainImage
+	}
+	return nil
+}
+
+func (x *CreateCarReq) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *CreateCarReq) GetStationUUID() string {
+	if x != nil {
+		return x.StationUUID
+	}
+	return ""
+}
+
+type CreateRentReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CarUUID        string                 `protobuf:"bytes,1,opt,name=CarUUID,proto3" json:"CarUUID,omitempty"`
+	PhoneNumber    string                 `protobuf:"bytes,2,opt,name=PhoneNumber,proto3" json:"PhoneNumber,omitempty"`
+	PassportNumber string                 `protobuf:"bytes,3,opt,name=PassportNumber,proto3" json:"PassportNumber,omitempty"`
+	PaymentSource  string                 `protobuf:"bytes,4,opt,name=PaymentSource,proto3" json:"PaymentSource,omitempty"`
+	Token          string                 `protobuf:"bytes,5,opt,name=Token,proto3" json:"Token,omitempty"`
+	RentStart      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=RentStart,proto3" json:"RentStart,omitempty"`
+	RentEnd        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=RentEnd,proto3" json:"RentEnd,omitempty"`
+	Email          string                 `protobuf:"bytes,8,opt,name=Email,proto3" json:"Email,omitempty"`
+	PromoCode      string                 `protobuf:"bytes,9,opt,name=PromoCode,proto3" json:"PromoCode,omitempty"`
+	// PickupStation has to be the station of the car, empty means the car station,
+	// empty DropOffStation means the pickup one, the drop-off at the other station is charged with the one way fee
+	PickupStation  string `protobuf:"bytes,10,opt,name=PickupStation,proto3" json:"PickupStation,omitempty"`
+	DropOffStation string `protobuf:"bytes,11,opt,name=DropOffStation,proto3" json:"DropOffStation,omitempty"`
+}
+
+func (x *CreateRentReq) Reset() {
+	*x = CreateRentReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRentReq) ProtoMessage() {}
+
+func (x *CreateRentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRentReq.ProtoReflect.Descriptor instead.
+func (*CreateRentReq) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CreateRentReq) GetCarUUID() string {
+	if x != nil {
+		return x.CarUUID
+	}
+	return ""
+}
+
+func (x *CreateRentReq) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *CreateRentReq) GetPassportNumber() string {
+	if x != nil {
+		return x.PassportNumber
+	}
+	return ""
+}
+
+func (x *CreateRentReq) GetPaymentSource() string {
+	if x != nil {
+		return x.PaymentSource
+	}
+	return ""
+}
+
+func (x *CreateRentReq) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreateRentReq) GetRentStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RentStart
+	}
+	return nil
+}
+
+func (x *CreateRentReq) GetRentEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RentEnd
+	}
+	return nil
+}
+
+func (x *CreateRentReq) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateRentReq) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *CreateRentReq) GetPickupStation() string {
+	if x != nil {
+		return x.PickupStation
+	}
+	return ""
+}
+
+func (x *CreateRentReq) GetDropOffStation() string {
+	if x != nil {
+		return x.DropOffStation
+	}
+	return ""
+}
+
+type CreateRentRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RentUUID string `protobuf:"bytes,1,opt,name=RentUUID,proto3" json:"RentUUID,omitempty"`
+}
+
+func (x *CreateRentRes) Reset() {
+	*x = CreateRentRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRentRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRentRes) ProtoMessage() {}
+
+func (x *CreateRentRes) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRentRes.ProtoReflect.Descriptor instead.
+func (*CreateRentRes) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateRentRes) GetRentUUID() string {
+	if x != nil {
+		return x.RentUUID
+	}
+	return ""
+}
+
+type CreatePromoCodeReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	// either Percent or Amount discount is set
+	Percent int32  `protobuf:"varint,2,opt,name=Percent,proto3" json:"Percent,omitempty"`
+	Amount  *Money `protobuf:"bytes,3,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	// zero means no limit
+	MaxUses        int32 `protobuf:"varint,4,opt,name=MaxUses,proto3" json:"MaxUses,omitempty"`
+	MaxUsesPerUser int32 `protobuf:"varint,5,opt,name=MaxUsesPerUser,proto3" json:"MaxUsesPerUser,omitempty"`
+	// empty means any category
+	Categories []string               `protobuf:"bytes,6,rep,name=Categories,proto3" json:"Categories,omitempty"`
+	ValidFrom  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=ValidFrom,proto3" json:"ValidFrom,omitempty"`
+	ValidUntil *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=ValidUntil,proto3" json:"ValidUntil,omitempty"`
+}
+
+func (x *CreatePromoCodeReq) Reset() {
+	*x = CreatePromoCodeReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePromoCodeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromoCodeReq) ProtoMessage() {}
+
+func (x *CreatePromoCodeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromoCodeReq.ProtoReflect.Descriptor instead.
+func (*CreatePromoCodeReq) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreatePromoCodeReq) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreatePromoCodeReq) GetPercent() int32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *CreatePromoCodeReq) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *CreatePromoCodeReq) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *CreatePromoCodeReq) GetMaxUsesPerUser() int32 {
+	if x != nil {
+		return x.MaxUsesPerUser
+	}
+	return 0
+}
+
+func (x *CreatePromoCodeReq) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *CreatePromoCodeReq) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *CreatePromoCodeReq) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
+type ExpirePromoCodeReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+}
+
+func (x *ExpirePromoCodeReq) Reset() {
+	*x = ExpirePromoCodeReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpirePromoCodeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpirePromoCodeReq) ProtoMessage() {}
+
+func (x *ExpirePromoCodeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpirePromoCodeReq.ProtoReflect.Descriptor instead.
+func (*ExpirePromoCodeReq) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ExpirePromoCodeReq) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type CancelRentReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RentUUID string `protobuf:"bytes,1,opt,name=RentUUID,proto3" json:"RentUUID,omitempty"`
+}
+
+func (x *CancelRentReq) Reset() {
+	*x = CancelRentReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelRentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRentReq) ProtoMessage() {}
+
+func (x *CancelRentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRentReq.ProtoReflect.Descriptor instead.
+func (*CancelRentReq) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CancelRentReq) GetRentUUID() string {
+	if x != nil {
+		return x.RentUUID
+	}
+	return ""
+}
+
+type StartRentReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RentUUID string `protobuf:"bytes,1,opt,name=RentUUID,proto3" json:"RentUUID,omitempty"`
+}
+
+func (x *StartRentReq) Reset() {
+	*x = StartRentReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartRentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartRentReq) ProtoMessage() {}
+
+func (x *StartRentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartRentReq.ProtoReflect.Descriptor instead.
+func (*StartRentReq) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *StartRentReq) GetRentUUID() string {
+	if x != nil {
+		return x.RentUUID
+	}
+	return ""
+}
+
+type CompleteRentReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RentUUID string `protobuf:"bytes,1,opt,name=RentUUID,proto3" json:"RentUUID,omitempty"`
+}
+
+func (x *CompleteRentReq) Reset() {
+	*x = CompleteRentReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompleteRentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteRentReq) ProtoMessage() {}
+
+func (x *CompleteRentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteRentReq.ProtoReflect.Descriptor instead.
+func (*CompleteRentReq) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CompleteRentReq) GetRentUUID() string {
+	if x != nil {
+		return x.RentUUID
+	}
+	return ""
+}
+
+type MarkNoShowReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RentUUID string `protobuf:"bytes,1,opt,name=RentUUID,proto3" json:"RentUUID,omitempty"`
+}
+
+func (x *MarkNoShowReq) Reset() {
+	*x = MarkNoShowReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkNoShowReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNoShowReq) ProtoMessage() {}
+
+func (x *MarkNoShowReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNoShowReq.ProtoReflect.Descriptor instead.
+func (*MarkNoShowReq) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *MarkNoShowReq) GetRentUUID() string {
+	if x != nil {
+		return x.RentUUID
+	}
+	return ""
+}
+
+type QuotePriceReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CarUUID        string                 `protobuf:"bytes,1,opt,name=CarUUID,proto3" json:"CarUUID,omitempty"`
+	RentStart      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=RentStart,proto3" json:"RentStart,omitempty"`
+	RentEnd        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=RentEnd,proto3" json:"RentEnd,omitempty"`
+	PickupStation  string                 `protobuf:"bytes,4,opt,name=PickupStation,proto3" json:"PickupStation,omitempty"`
+	DropOffStation string                 `protobuf:"bytes,5,opt,name=DropOffStation,proto3" json:"DropOffStation,omitempty"`
+}
+
+func (x *QuotePriceReq) Reset() {
+	*x = QuotePriceReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuotePriceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotePriceReq) ProtoMessage() {}
+
+func (x *QuotePriceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotePriceReq.ProtoReflect.Descriptor instead.
+func (*QuotePriceReq) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *QuotePriceReq) GetCarUUID() string {
+	if x != nil {
+		return x.CarUUID
+	}
+	return ""
+}
+
+func (x *QuotePriceReq) GetRentStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RentStart
+	}
+	return nil
+}
+
+func (x *QuotePriceReq) GetRentEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RentEnd
+	}
+	return nil
+}
+
+func (x *QuotePriceReq) GetPickupStation() string {
+	if x != nil {
+		return x.PickupStation
+	}
+	return ""
+}
+
+func (x *QuotePriceReq) GetDropOffStation() string {
+	if x != nil {
+		return x.DropOffStation
+	}
+	return ""
+}
+
+type PriceAdjustment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rule string `protobuf:"bytes,1,opt,name=Rule,proto3" json:"Rule,omitempty"`
+	// Deprecated: Do not use.
+	Amount float32 `protobuf:"fixed32,2,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	Value  *Money  `protobuf:"bytes,3,opt,name=Value,proto3" json:"Value,omitempty"`
+}
+
+func (x *PriceAdjustment) Reset() {
+	*x = PriceAdjustment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceAdjustment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceAdjustment) ProtoMessage() {}
+
+func (x *PriceAdjustment) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceAdjustment.ProtoReflect.Descriptor instead.
+func (*PriceAdjustment) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PriceAdjustment) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (x *PriceAdjustment) GetAmount() float32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PriceAdjustment) GetValue() *Money {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type QuotePriceRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Deprecated: Do not use.
+	Price float32 `protobuf:"fixed32,1,opt,name=Price,proto3" json:"Price,omitempty"`
+	// Deprecated: Do not use.
+	BasePrice   float32            `protobuf:"fixed32,2,opt,name=BasePrice,proto3" json:"BasePrice,omitempty"`
+	Hours       int32              `protobuf:"varint,3,opt,name=Hours,proto3" json:"Hours,omitempty"`
+	Adjustments []*PriceAdjustment `protobuf:"bytes,4,rep,name=Adjustments,proto3" json:"Adjustments,omitempty"`
+	Total       *Money             `protobuf:"bytes,5,opt,name=Total,proto3" json:"Total,omitempty"`
+	Base        *Money             `protobuf:"bytes,6,opt,name=Base,proto3" json:"Base,omitempty"`
+}
+
+func (x *QuotePriceRes) Reset() {
+	*x = QuotePriceRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuotePriceRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotePriceRes) ProtoMessage() {}
+
+func (x *QuotePriceRes) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotePriceRes.ProtoReflect.Descriptor instead.
+func (*QuotePriceRes) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{35}
+}
+
+// Deprecated: Do not use.
+func (x *QuotePriceRes) GetPrice() float32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+// Deprecated: Do not use.
+func (x *QuotePriceRes) GetBasePrice() float32 {
+	if x != nil {
+		return x.BasePrice
+	}
+	return 0
+}
+
+func (x *QuotePriceRes) GetHours() int32 {
+	if x != nil {
+		return x.Hours
+	}
+	return 0
+}
+
+func (x *QuotePriceRes) GetAdjustments() []*PriceAdjustment {
+	if x != nil {
+		return x.Adjustments
+	}
+	return nil
+}
+
+func (x *QuotePriceRes) GetTotal() *Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *QuotePriceRes) GetBase() *Money {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type CheckRentReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RentUUID string `protobuf:"bytes,2,opt,name=RentUUID,proto3" json:"RentUUID,omitempty"`
+}
+
+func (x *CheckRentReq) Reset() {
+	*x = CheckRentReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckRentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckRentReq) ProtoMessage() {}
+
+func (x *CheckRentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckRentReq.ProtoReflect.Descriptor instead.
+func (*CheckRentReq) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CheckRentReq) GetRentUUID() string {
+	if x != nil {
+		return x.RentUUID
+	}
+	return ""
+}
+
+type CheckRentRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CarUUID string `protobuf:"bytes,1,opt,name=CarUUID,proto3" json:"CarUUID,omitempty"`
+	// Deprecated: Do not use.
+	RentPrice      float32                `protobuf:"fixed32,2,opt,name=RentPrice,proto3" json:"RentPrice,omitempty"`
+	UserUUID       string                 `protobuf:"bytes,3,opt,name=UserUUID,proto3" json:"UserUUID,omitempty"`
+	RentStart      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=RentStart,proto3" json:"RentStart,omitempty"`
+	RentEnd        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=RentEnd,proto3" json:"RentEnd,omitempty"`
+	Status         string                 `protobuf:"bytes,6,opt,name=Status,proto3" json:"Status,omitempty"`
+	Price          *Money                 `protobuf:"bytes,7,opt,name=Price,proto3" json:"Price,omitempty"`
+	PickupStation  string                 `protobuf:"bytes,8,opt,name=PickupStation,proto3" json:"PickupStation,omitempty"`
+	DropOffStation string                 `protobuf:"bytes,9,opt,name=DropOffStation,proto3" json:"DropOffStation,omitempty"`
+}
+
+func (x *CheckRentRes) Reset() {
+	*x = CheckRentRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckRentRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckRentRes) ProtoMessage() {}
+
+func (x *CheckRentRes) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckRentRes.ProtoReflect.Descriptor instead.
+func (*CheckRentRes) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CheckRentRes) GetCarUUID() string {
+	if x != nil {
+		return x.CarUUID
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (x *CheckRentRes) GetRentPrice() float32 {
+	if x != nil {
+		return x.RentPrice
+	}
+	return 0
+}
+
+func (x *CheckRentRes) GetUserUUID() string {
+	if x != nil {
+		return x.UserUUID
+	}
+	return ""
+}
+
+func (x *CheckRentRes) GetRentStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RentStart
+	}
+	return nil
+}
+
+func (x *CheckRentRes) GetRentEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RentEnd
+	}
+	return nil
+}
+
+func (x *CheckRentRes) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CheckRentRes) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *CheckRentRes) GetPickupStation() string {
+	if x != nil {
+		return x.PickupStation
+	}
+	return ""
+}
+
+func (x *CheckRentRes) GetDropOffStation() string {
+	if x != nil {
+		return x.DropOffStation
+	}
+	return ""
+}
+
+type Car struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Brand    string `protobuf:"bytes,1,opt,name=Brand,proto3" json:"Brand,omitempty"`
+	Type     string `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	MaxSpeed int32  `protobuf:"varint,3,opt,name=MaxSpeed,proto3" json:"MaxSpeed,omitempty"`
+	Seats    int32  `protobuf:"varint,4,opt,name=Seats,proto3" json:"Seats,omitempty"`
+	Category string `protobuf:"bytes,5,opt,name=Category,proto3" json:"Category,omitempty"`
+	// Deprecated: Do not use.
+	PricePerDay float32  `protobuf:"fixed32,6,opt,name=PricePerDay,proto3" json:"PricePerDay,omitempty"`
+	UUID        string   `protobuf:"bytes,7,opt,name=UUID,proto3" json:"UUID,omitempty"`
+	Images      []string `protobuf:"bytes,8,rep,name=Images,proto3" json:"Images,omitempty"`
+	MainImage   string   `protobuf:"bytes,9,opt,name=MainImage,proto3" json:"MainImage,omitempty"`
+	Price       *Money   `protobuf:"bytes,10,opt,name=Price,proto3" json:"Price,omitempty"`
+	StationUUID string   `protobuf:"bytes,11,opt,name=StationUUID,proto3" json:"StationUUID,omitempty"`
+}
+
+func (x *Car) Reset() {
+	*x = Car{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Car) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Car) ProtoMessage() {}
+
+func (x *Car) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Car.ProtoReflect.Descriptor instead.
+func (*Car) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *Car) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *Car) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Car) GetMaxSpeed() int32 {
+	if x != nil {
+		return x.MaxSpeed
+	}
+	return 0
+}
+
+func (x *Car) GetSeats() int32 {
+	if x != nil {
+		return x.Seats
+	}
+	return 0
+}
+
+func (x *Car) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (x *Car) GetPricePerDay() float32 {
+	if x != nil {
+		return x.PricePerDay
+	}
+	return 0
+}
+
+func (x *Car) GetUUID() string {
+	if x != nil {
+		return x.UUID
+	}
+	return ""
+}
+
+func (x *Car) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *Car) GetMainImage() string {
+	if x != nil {
+		return x.MainImage
+	}
+	return ""
+}
+
+func (x *Car) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *Car) GetStationUUID() string {
+	if x != nil {
+		return x.StationUUID
+	}
+	return ""
+}
+
+type GetAvailableCarsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=Start,proto3" json:"Start,omitempty"`
+	End   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=End,proto3" json:"End,omitempty"`
+	Page  *Page                  `protobuf:"bytes,3,opt,name=Page,proto3" json:"Page,omitempty"`
+	// the same filters as in GetCarsByParamsReq, empty ones are ignored
+	Brand       string `protobuf:"bytes,4,opt,name=Brand,proto3" json:"Brand,omitempty"`
+	Type        string `protobuf:"bytes,5,opt,name=Type,proto3" json:"Type,omitempty"`
+	MaxSpeed    int32  `protobuf:"varint,6,opt,name=MaxSpeed,proto3" json:"MaxSpeed,omitempty"`
+	Seats       int32  `protobuf:"varint,7,opt,name=Seats,proto3" json:"Seats,omitempty"`
+	Category    string `protobuf:"bytes,8,opt,name=Category,proto3" json:"Category,omitempty"`
+	MinPrice    *Money `protobuf:"bytes,9,opt,name=MinPrice,proto3" json:"MinPrice,omitempty"`
+	MaxPrice    *Money `protobuf:"bytes,10,opt,name=MaxPrice,proto3" json:"MaxPrice,omitempty"`
+	StationUUID string `protobuf:"bytes,11,opt,name=StationUUID,proto3" json:"StationUUID,omitempty"`
+	// Near selects the cars of the stations within the radius
+	Near *Area `protobuf:"bytes,12,opt,name=Near,proto3" json:"Near,omitempty"`
+}
+
+func (x *GetAvailableCarsReq) Reset() {
+	*x = GetAvailableCarsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAvailableCarsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailableCarsReq) ProtoMessage() {}
+
+func (x *GetAvailableCarsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailableCarsReq.ProtoReflect.Descriptor instead.
+func (*GetAvailableCarsReq) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetAvailableCarsReq) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *GetAvailableCarsReq) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *GetAvailableCarsReq) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *GetAvailableCarsReq) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *GetAvailableCarsReq) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GetAvailableCarsReq) GetMaxSpeed() int32 {
+	if x != nil {
+		return x.MaxSpeed
+	}
+	return 0
+}
+
+func (x *GetAvailableCarsReq) GetSeats() int32 {
+	if x != nil {
+		return x.Seats
+	}
+	return 0
+}
+
+func (x *GetAvailableCarsReq) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *GetAvailableCarsReq) GetMinPrice() *Money {
+	if x != nil {
+		return x.MinPrice
+	}
+	return nil
+}
+
+func (x *GetAvailableCarsReq) GetMaxPrice() *Money {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return nil
+}
+
+func (x *GetAvailableCarsReq) GetStationUUID() string {
+	if x != nil {
+		return x.StationUUID
+	}
+	return ""
+}
+
+func (x *GetAvailableCarsReq) GetNear() *Area {
+	if x != nil {
+		return x.Near
+	}
+	return nil
+}
+
+// Area is the circle around the point, the radius is in kilometers
+type Area struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latitude  float64 `protobuf:"fixed64,1,opt,name=Latitude,proto3" json:"Latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=Longitude,proto3" json:"Longitude,omitempty"`
+	RadiusKm  float64 `protobuf:"fixed64,3,opt,name=RadiusKm,proto3" json:"RadiusKm,omitempty"`
+}
+
+func (x *Area) Reset() {
+	*x = Area{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Area) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Area) ProtoMessage() {}
+
+func (x *Area) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Area.ProtoReflect.Descriptor instead.
+func (*Area) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Area) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Area) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Area) GetRadiusKm() float64 {
+	if x != nil {
+		return x.RadiusKm
+	}
+	return 0
+}
+
+type Station struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UUID      string  `protobuf:"bytes,1,opt,name=UUID,proto3" json:"UUID,omitempty"`
+	Name      string  `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Address   string  `protobuf:"bytes,3,opt,name=Address,proto3" json:"Address,omitempty"`
+	Latitude  float64 `protobuf:"fixed64,4,opt,name=Latitude,proto3" json:"Latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,5,opt,name=Longitude,proto3" json:"Longitude,omitempty"`
+	// OpensAt and ClosesAt are HH:MM in the time zone of the station, equal ones mean that the station is always open
+	OpensAt  string `protobuf:"bytes,6,opt,name=OpensAt,proto3" json:"OpensAt,omitempty"`
+	ClosesAt string `protobuf:"bytes,7,opt,name=ClosesAt,proto3" json:"ClosesAt,omitempty"`
+	// TimeZone is IANA name, e.g. Europe/Berlin, empty means UTC
+	TimeZone string `protobuf:"bytes,8,opt,name=TimeZone,proto3" json:"TimeZone,omitempty"`
+	// Capacity is the max amount of the cars, which have the station as the home one
+	Capacity int32 `protobuf:"varint,9,opt,name=Capacity,proto3" json:"Capacity,omitempty"`
+}
+
+func (x *Station) Reset() {
+	*x = Station{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Station) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Station) ProtoMessage() {}
+
+func (x *Station) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Station.ProtoReflect.Descriptor instead.
+func (*Station) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Station) GetUUID() string {
+	if x != nil {
+		return x.UUID
+	}
+	return ""
+}
+
+func (x *Station) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Station) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Station) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Station) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Station) GetOpensAt() string {
+	if x != nil {
+		return x.OpensAt
+	}
+	return ""
+}
+
+func (x *Station) GetClosesAt() string {
+	if x != nil {
+		return x.ClosesAt
+	}
+	return ""
+}
+
+func (x *Station) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *Station) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+type CreateStationReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Station *Station `protobuf:"bytes,1,opt,name=Station,proto3" json:"Station,omitempty"`
+}
+
+func (x *CreateStationReq) Reset() {
+	*x = CreateStationReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateStationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStationReq) ProtoMessage() {}
+
+func (x *CreateStationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStationReq.ProtoReflect.Descriptor instead.
+func (*CreateStationReq) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CreateStationReq) GetStation() *Station {
+	if x != nil {
+		return x.Station
+	}
+	return nil
+}
+
+type CreateStationRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UUID string `protobuf:"bytes,1,opt,name=UUID,proto3" json:"UUID,omitempty"`
+}
+
+func (x *CreateStationRes) Reset() {
+	*x = CreateStationRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateStationRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStationRes) ProtoMessage() {}
+
+func (x *CreateStationRes) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStationRes.ProtoReflect.Descriptor instead.
+func (*CreateStationRes) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreateStationRes) GetUUID() string {
+	if x != nil {
+		return x.UUID
+	}
+	return ""
+}
+
+// GetStationsReq empty area means all the stations
+type GetStationsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Near *Area `protobuf:"bytes,1,opt,name=Near,proto3" json:"Near,omitempty"`
+}
+
+func (x *GetStationsReq) Reset() {
+	*x = GetStationsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStationsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStationsReq) ProtoMessage() {}
+
+func (x *GetStationsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStationsReq.ProtoReflect.Descriptor instead.
+func (*GetStationsReq) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetStationsReq) GetNear() *Area {
+	if x != nil {
+		return x.Near
+	}
+	return nil
+}
+
+type GetStationsRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stations []*Station `protobuf:"bytes,1,rep,name=Stations,proto3" json:"Stations,omitempty"`
+}
+
+func (x *GetStationsRes) Reset() {
+	*x = GetStationsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStationsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStationsRes) ProtoMessage() {}
+
+func (x *GetStationsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStationsRes.ProtoReflect.Descriptor instead.
+func (*GetStationsRes) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetStationsRes) GetStations() []*Station {
+	if x != nil {
+		return x.Stations
+	}
+	return nil
+}
+
+// ScheduleMaintenanceReq the unplanned maintenance starts now, if Start is not set,
+// and lasts till it is closed, if End is not set
+type ScheduleMaintenanceReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CarUUID string `protobuf:"bytes,1,opt,name=CarUUID,proto3" json:"CarUUID,omitempty"`
+	// planned or unplanned
+	Kind   string                 `protobuf:"bytes,2,opt,name=Kind,proto3" json:"Kind,omitempty"`
+	Reason string                 `protobuf:"bytes,3,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	Start  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=Start,proto3" json:"Start,omitempty"`
+	End    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=End,proto3" json:"End,omitempty"`
+	// IntervalKm is the mileage, after which the next maintenance is due, zero means no interval
+	IntervalKm int64 `protobuf:"varint,6,opt,name=IntervalKm,proto3" json:"IntervalKm,omitempty"`
+}
+
+func (x *ScheduleMaintenanceReq) Reset() {
+	*x = ScheduleMaintenanceReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleMaintenanceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMaintenanceReq) ProtoMessage() {}
+
+func (x *ScheduleMaintenanceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleMaintenanceReq.ProtoReflect.Descriptor instead.
+func (*ScheduleMaintenanceReq) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ScheduleMaintenanceReq) GetCarUUID() string {
+	if x != nil {
+		return x.CarUUID
+	}
+	return ""
+}
+
+func (x *ScheduleMaintenanceReq) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ScheduleMaintenanceReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ScheduleMaintenanceReq) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *ScheduleMaintenanceReq) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *ScheduleMaintenanceReq) GetIntervalKm() int64 {
+	if x != nil {
+		return x.IntervalKm
+	}
+	return 0
+}
+
+// ScheduleMaintenanceRes Conflicts are the reserved and active rents of the car, which intersect with the maintenance
+type ScheduleMaintenanceRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UUID      string          `protobuf:"bytes,1,opt,name=UUID,proto3" json:"UUID,omitempty"`
+	Conflicts []*RentConflict `protobuf:"bytes,2,rep,name=Conflicts,proto3" json:"Conflicts,omitempty"`
+}
+
+func (x *ScheduleMaintenanceRes) Reset() {
+	*x = ScheduleMaintenanceRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleMaintenanceRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMaintenanceRes) ProtoMessage() {}
+
+func (x *ScheduleMaintenanceRes) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleMaintenanceRes.ProtoReflect.Descriptor instead.
+func (*ScheduleMaintenanceRes) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ScheduleMaintenanceRes) GetUUID() string {
+	if x != nil {
+		return x.UUID
+	}
+	return ""
+}
+
+func (x *ScheduleMaintenanceRes) GetConflicts() []*RentConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+type RentConflict struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RentUUID  string                 `protobuf:"bytes,1,opt,name=RentUUID,proto3" json:"RentUUID,omitempty"`
+	Status    string                 `protobuf:"bytes,2,opt,name=Status,proto3" json:"Status,omitempty"`
+	RentStart *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=RentStart,proto3" json:"RentStart,omitempty"`
+	RentEnd   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=RentEnd,proto3" json:"RentEnd,omitempty"`
+}
+
+func (x *RentConflict) Reset() {
+	*x = RentConflict{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RentConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RentConflict) ProtoMessage() {}
+
+func (x *RentConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RentConflict.ProtoReflect.Descriptor instead.
+func (*RentConflict) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RentConflict) GetRentUUID() string {
+	if x != nil {
+		return x.RentUUID
+	}
+	return ""
+}
+
+func (x *RentConflict) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RentConflict) GetRentStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RentStart
+	}
+	return nil
+}
+
+func (x *RentConflict) GetRentEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RentEnd
+	}
+	return nil
+}
+
+// CloseMaintenanceReq Mileage is the odometer reading of the car after the maintenance
+type CloseMaintenanceReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UUID    string `protobuf:"bytes,1,opt,name=UUID,proto3" json:"UUID,omitempty"`
+	Mileage int64  `protobuf:"varint,2,opt,name=Mileage,proto3" json:"Mileage,omitempty"`
+}
+
+func (x *CloseMaintenanceReq) Reset() {
+	*x = CloseMaintenanceReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseMaintenanceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseMaintenanceReq) ProtoMessage() {}
+
+func (x *CloseMaintenanceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseMaintenanceReq.ProtoReflect.Descriptor instead.
+func (*CloseMaintenanceReq) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CloseMaintenanceReq) GetUUID() string {
+	if x != nil {
+		return x.UUID
+	}
+	return ""
+}
+
+func (x *CloseMaintenanceReq) GetMileage() int64 {
+	if x != nil {
+		return x.Mileage
+	}
+	return 0
+}
+
+type GetMaintenancesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CarUUID string `protobuf:"bytes,1,opt,name=CarUUID,proto3" json:"CarUUID,omitempty"`
+}
+
+func (x *GetMaintenancesReq) Reset() {
+	*x = GetMaintenancesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMaintenancesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaintenancesReq) ProtoMessage() {}
+
+func (x *GetMaintenancesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaintenancesReq.ProtoReflect.Descriptor instead.
+func (*GetMaintenancesReq) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetMaintenancesReq) GetCarUUID() string {
+	if x != nil {
+		return x.CarUUID
+	}
+	return ""
+}
+
+type GetMaintenancesRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Maintenances []*Maintenance `protobuf:"bytes,1,rep,name=Maintenances,proto3" json:"Maintenances,omitempty"`
+	// NextServiceMileage is the mileage of the last closed maintenance with an interval plus the interval, zero if unknown
+	NextServiceMileage int64 `protobuf:"varint,2,opt,name=NextServiceMileage,proto3" json:"NextServiceMileage,omitempty"`
+}
+
+func (x *GetMaintenancesRes) Reset() {
+	*x = GetMaintenancesRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMaintenancesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaintenancesRes) ProtoMessage() {}
+
+func (x *GetMaintenancesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaintenancesRes.ProtoReflect.Descriptor instead.
+func (*GetMaintenancesRes) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetMaintenancesRes) GetMaintenances() []*Maintenance {
+	if x != nil {
+		return x.Maintenances
+	}
+	return nil
+}
+
+func (x *GetMaintenancesRes) GetNextServiceMileage() int64 {
+	if x != nil {
+		return x.NextServiceMileage
+	}
+	return 0
+}
+
+type Maintenance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UUID    string                 `protobuf:"bytes,1,opt,name=UUID,proto3" json:"UUID,omitempty"`
+	CarUUID string                 `protobuf:"bytes,2,opt,name=CarUUID,proto3" json:"CarUUID,omitempty"`
+	Kind    string                 `protobuf:"bytes,3,opt,name=Kind,proto3" json:"Kind,omitempty"`
+	Reason  string                 `protobuf:"bytes,4,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	Start   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=Start,proto3" json:"Start,omitempty"`
+	// not set, if the maintenance lasts till it is closed
+	End        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=End,proto3" json:"End,omitempty"`
+	IntervalKm int64                  `protobuf:"varint,7,opt,name=IntervalKm,proto3" json:"IntervalKm,omitempty"`
+	Mileage    int64                  `protobuf:"varint,8,opt,name=Mileage,proto3" json:"Mileage,omitempty"`
+	// not set, if the maintenance is not closed yet
+	ClosedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=ClosedAt,proto3" json:"ClosedAt,omitempty"`
+	// Actor is the uuid of the admin, who scheduled the maintenance
+	Actor string `protobuf:"bytes,10,opt,name=Actor,proto3" json:"Actor,omitempty"`
+}
+
+func (x *Maintenance) Reset() {
+	*x = Maintenance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Maintenance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Maintenance) ProtoMessage() {}
+
+func (x *Maintenance) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Maintenance.ProtoReflect.Descriptor instead.
+func (*Maintenance) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Maintenance) GetUUID() string {
+	if x != nil {
+		return x.UUID
+	}
+	return ""
+}
+
+func (x *Maintenance) GetCarUUID() string {
+	if x != nil {
+		return x.CarUUID
+	}
+	return ""
+}
+
+func (x *Maintenance) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Maintenance) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Maintenance) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *Maintenance) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *Maintenance) GetIntervalKm() int64 {
+	if x != nil {
+		return x.IntervalKm
+	}
+	return 0
+}
+
+func (x *Maintenance) GetMileage() int64 {
+	if x != nil {
+		return x.Mileage
+	}
+	return 0
+}
+
+func (x *Maintenance) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return nil
+}
+
+func (x *Maintenance) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+// Page requests the cars after the page token in the sort order
+type Page struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// zero means default size
+	Size int32 `protobuf:"varint,1,opt,name=Size,proto3" json:"Size,omitempty"`
+	// NextPageToken of the previous page, empty for the first page
+	Token string `protobuf:"bytes,2,opt,name=Token,proto3" json:"Token,omitempty"`
+	// price, maxSpeed, seats, brand or relevance for the search, empty means the order of car uuids
+	SortBy     string `protobuf:"bytes,3,opt,name=SortBy,proto3" json:"SortBy,omitempty"`
+	Descending bool   `protobuf:"varint,4,opt,name=Descending,proto3" json:"Descending,omitempty"`
+}
+
+func (x *Page) Reset() {
+	*x = Page{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Page) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Page) ProtoMessage() {}
+
+func (x *Page) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Page.ProtoReflect.Descriptor instead.
+func (*Page) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *Page) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Page) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *Page) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *Page) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+type GetCarsRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cars []*CarMainInfo `protobuf:"bytes,1,rep,name=Cars,proto3" json:"Cars,omitempty"`
+	// empty if there are no more cars
+	NextPageToken string `protobuf:"bytes,2,opt,name=NextPageToken,proto3" json:"NextPageToken,omitempty"`
+}
+
+func (x *GetCarsRes) Reset() {
+	*x = GetCarsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCarsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCarsRes) ProtoMessage() {}
+
+func (x *GetCarsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCarsRes.ProtoReflect.Descriptor instead.
+func (*GetCarsRes) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetCarsRes) GetCars() []*CarMainInfo {
+	if x != nil {
+		return x.Cars
+	}
+	return nil
+}
+
+func (x *GetCarsRes) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetCarsByParamsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Brand    string `protobuf:"bytes,1,opt,name=Brand,proto3" json:"Brand,omitempty"`
+	Type     string `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	MaxSpeed int32  `protobuf:"varint,3,opt,name=MaxSpeed,proto3" json:"MaxSpeed,omitempty"`
+	Seats    int32  `protobuf:"varint,4,opt,name=Seats,proto3" json:"Seats,omitempty"`
+	Category string `protobuf:"bytes,5,opt,name=Category,proto3" json:"Category,omitempty"`
+	// Deprecated: Do not use.
+	PricePerDay float32 `protobuf:"fixed32,6,opt,name=PricePerDay,proto3" json:"PricePerDay,omitempty"`
+	// MinPrice and MaxPrice filter cars by price per day in the same currency, both are inclusive
+	MaxPrice *Money `protobuf:"bytes,7,opt,name=MaxPrice,proto3" json:"MaxPrice,omitempty"`
+	MinPrice *Money `protobuf:"bytes,8,opt,name=MinPrice,proto3" json:"MinPrice,omitempty"`
+	Page     *Page  `protobuf:"bytes,9,opt,name=Page,proto3" json:"Page,omitempty"`
+}
+
+func (x *GetCarsByParamsReq) Reset() {
+	*x = GetCarsByParamsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCarsByParamsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCarsByParamsReq) ProtoMessage() {}
+
+func (x *GetCarsByParamsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCarsByParamsReq.ProtoReflect.Descriptor instead.
+func (*GetCarsByParamsReq) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetCarsByParamsReq) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *GetCarsByParamsReq) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
 }
 
 func (x *GetCarsByParamsReq) GetMaxSpeed() int32 {
@@ -1108,21 +3845,261 @@ func (x *GetCarsByParamsReq) GetSeats() int32 {
 	if x != nil {
 		return x.Seats
 	}
-	return 0
+	return 0
+}
+
+func (x *GetCarsByParamsReq) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (x *GetCarsByParamsReq) GetPricePerDay() float32 {
+	if x != nil {
+		return x.PricePerDay
+	}
+	return 0
+}
+
+func (x *GetCarsByParamsReq) GetMaxPrice() *Money {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return nil
+}
+
+func (x *GetCarsByParamsReq) GetMinPrice() *Money {
+	if x != nil {
+		return x.MinPrice
+	}
+	return nil
+}
+
+func (x *GetCarsByParamsReq) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+// SearchCarsReq matches the words of the query as prefixes of the car brand, type and category
+type SearchCarsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query string `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
+	// facet filters, empty ones are ignored
+	Brand    string `protobuf:"bytes,2,opt,name=Brand,proto3" json:"Brand,omitempty"`
+	Category string `protobuf:"bytes,3,opt,name=Category,proto3" json:"Category,omitempty"`
+	Seats    int32  `protobuf:"varint,4,opt,name=Seats,proto3" json:"Seats,omitempty"`
+	// cars are sorted by relevance, if the sort is not set
+	Page *Page `protobuf:"bytes,5,opt,name=Page,proto3" json:"Page,omitempty"`
+}
+
+func (x *SearchCarsReq) Reset() {
+	*x = SearchCarsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchCarsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchCarsReq) ProtoMessage() {}
+
+func (x *SearchCarsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchCarsReq.ProtoReflect.Descriptor instead.
+func (*SearchCarsReq) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SearchCarsReq) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchCarsReq) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *SearchCarsReq) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SearchCarsReq) GetSeats() int32 {
+	if x != nil {
+		return x.Seats
+	}
+	return 0
+}
+
+func (x *SearchCarsReq) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type Facet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value string `protobuf:"bytes,1,opt,name=Value,proto3" json:"Value,omitempty"`
+	Count int64  `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+}
+
+func (x *Facet) Reset() {
+	*x = Facet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Facet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Facet) ProtoMessage() {}
+
+func (x *Facet) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Facet.ProtoReflect.Descriptor instead.
+func (*Facet) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *Facet) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Facet) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SearchCarsRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cars []*CarMainInfo `protobuf:"bytes,1,rep,name=Cars,proto3" json:"Cars,omitempty"`
+	// empty if there are no more cars
+	NextPageToken string `protobuf:"bytes,2,opt,name=NextPageToken,proto3" json:"NextPageToken,omitempty"`
+	// amounts of all the found cars, not only the ones of the page
+	Brands     []*Facet `protobuf:"bytes,3,rep,name=Brands,proto3" json:"Brands,omitempty"`
+	Categories []*Facet `protobuf:"bytes,4,rep,name=Categories,proto3" json:"Categories,omitempty"`
+	Seats      []*Facet `protobuf:"bytes,5,rep,name=Seats,proto3" json:"Seats,omitempty"`
+}
+
+func (x *SearchCarsRes) Reset() {
+	*x = SearchCarsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_carsharing_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchCarsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchCarsRes) ProtoMessage() {}
+
+func (x *SearchCarsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_carsharing_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchCarsRes.ProtoReflect.Descriptor instead.
+func (*SearchCarsRes) Descriptor() ([]byte, []int) {
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SearchCarsRes) GetCars() []*CarMainInfo {
+	if x != nil {
+		return x.Cars
+	}
+	return nil
+}
+
+func (x *SearchCarsRes) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *SearchCarsRes) GetBrands() []*Facet {
+	if x != nil {
+		return x.Brands
+	}
+	return nil
 }
 
-func (x *GetCarsByParamsReq) GetCategory() string {
+func (x *SearchCarsRes) GetCategories() []*Facet {
 	if x != nil {
-		return x.Category
+		return x.Categories
 	}
-	return ""
+	return nil
 }
 
-func (x *GetCarsByParamsReq) GetPricePerDay() float32 {
+func (x *SearchCarsRes) GetSeats() []*Facet {
 	if x != nil {
-		return x.PricePerDay
+		return x.Seats
 	}
-	return 0
+	return nil
 }
 
 type GetCarByUUIDReq struct {
@@ -1136,7 +4113,7 @@ type GetCarByUUIDReq struct {
 func (x *GetCarByUUIDReq) Reset() {
 	*x = GetCarByUUIDReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_carsharing_proto_msgTypes[17]
+		mi := &file_protos_carsharing_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1149,7 +4126,7 @@ func (x *GetCarByUUIDReq) String() string {
 func (*GetCarByUUIDReq) ProtoMessage() {}
 
 func (x *GetCarByUUIDReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_carsharing_proto_msgTypes[17]
+	mi := &file_protos_carsharing_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1162,7 +4139,7 @@ func (x *GetCarByUUIDReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCarByUUIDReq.ProtoReflect.Descriptor instead.
 func (*GetCarByUUIDReq) Descriptor() ([]byte, []int) {
-	return file_protos_carsharing_proto_rawDescGZIP(), []int{17}
+	return file_protos_carsharing_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *GetCarByUUIDReq) GetUUID() string {
@@ -1181,85 +4158,268 @@ var file_protos_carsharing_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0x56, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6e, 0x74, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12,
-	0x3a, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x6e, 0x18, 0x01, 0x20,
+	0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3b, 0x0a, 0x05, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x22, 0x56, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x3a,
+	0x0a, 0x0a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x6e, 0x22, 0x52, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x6e, 0x44,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x52, 0x65, 0x6e, 0x74, 0x73, 0x49,
+	0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x61, 0x72, 0x73,
+	0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x52, 0x09, 0x52, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xee,
+	0x01, 0x0a, 0x0b, 0x43, 0x61, 0x72, 0x4d, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12,
+	0x0a, 0x04, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x55,
+	0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x24, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x42, 0x02, 0x18,
+	0x01, 0x52, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67,
+	0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x55, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x55, 0x49, 0x44, 0x22,
+	0x4f, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x16,
+	0x0a, 0x06, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74,
+	0x22, 0x85, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x6e, 0x0a, 0x0a, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0x69, 0x0a, 0x11, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x43, 0x61, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x34, 0x0a,
+	0x04, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x61,
+	0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43,
+	0x61, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x04, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x05, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x48, 0x00, 0x52, 0x05, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x42, 0x06, 0x0a, 0x04, 0x44,
+	0x61, 0x74, 0x61, 0x22, 0x42, 0x0a, 0x12, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x61, 0x72,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x61, 0x72,
+	0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x61, 0x72, 0x55,
+	0x55, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4d, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x04, 0x4d, 0x61, 0x69, 0x6e, 0x22, 0x23, 0x0a, 0x11, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x43, 0x61, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x12, 0x0e, 0x0a, 0x02,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x22, 0x2b, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x18, 0x0a, 0x07, 0x43, 0x61, 0x72, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x43, 0x61, 0x72, 0x55, 0x55, 0x49, 0x44, 0x22, 0x66, 0x0a, 0x08, 0x43, 0x61, 0x72,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x4d, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x4d, 0x61, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74,
+	0x73, 0x22, 0x3f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x06, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e,
+	0x67, 0x2e, 0x43, 0x61, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x06, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x73, 0x22, 0x3d, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x72, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x61, 0x72, 0x55, 0x55,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x61, 0x72, 0x55, 0x55, 0x49,
+	0x44, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49,
+	0x64, 0x22, 0x41, 0x0a, 0x13, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x61, 0x72, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x61, 0x72, 0x55,
+	0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x61, 0x72, 0x55, 0x55,
+	0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x03, 0x49, 0x64, 0x73, 0x22, 0x3e, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x43,
+	0x61, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x61,
+	0x72, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x61, 0x72,
+	0x55, 0x55, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x49, 0x64, 0x22, 0x21, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x22, 0x7c, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x61, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07,
+	0x43, 0x61, 0x72, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43,
+	0x61, 0x72, 0x55, 0x55, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x50,
+	0x65, 0x72, 0x44, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x42, 0x02, 0x18, 0x01, 0x52,
+	0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x12, 0x27, 0x0a, 0x05,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x61,
+	0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x61, 0x72, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x61, 0x72, 0x55, 0x55, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x61, 0x72, 0x55, 0x55, 0x49, 0x44,
+	0x12, 0x21, 0x0a, 0x03, 0x43, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x61, 0x72, 0x52, 0x03,
+	0x43, 0x61, 0x72, 0x12, 0x3a, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73,
+	0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d,
+	0x61, 0x73, 0x6b, 0x52, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22,
+	0x28, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x72, 0x52, 0x65, 0x71, 0x12,
+	0x18, 0x0a, 0x07, 0x43, 0x61, 0x72, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x43, 0x61, 0x72, 0x55, 0x55, 0x49, 0x44, 0x22, 0x2c, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x43, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a,
+	0x07, 0x43, 0x61, 0x72, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x43, 0x61, 0x72, 0x55, 0x55, 0x49, 0x44, 0x22, 0x43, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x61,
+	0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63,
+	0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x61, 0x72, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0xb6, 0x01, 0x0a,
+	0x09, 0x43, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x63,
+	0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x41, 0x63, 0x74, 0x6f, 0x72,
+	0x12, 0x16, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x61, 0x72, 0x73,
+	0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x41, 0x74, 0x22, 0x47, 0x0a, 0x0b, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x4f, 0x6c,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4f, 0x6c, 0x64, 0x12, 0x10, 0x0a, 0x03,
+	0x4e, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4e, 0x65, 0x77, 0x22, 0xad,
+	0x02, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x52, 0x65, 0x71, 0x12,
+	0x14, 0x0a, 0x05, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x42, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4d, 0x61, 0x78,
+	0x53, 0x70, 0x65, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x4d, 0x61, 0x78,
+	0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x65, 0x61, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x24, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x42, 0x02, 0x18, 0x01,
+	0x52, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x4d, 0x61, 0x69, 0x6e, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x4d, 0x61, 0x69, 0x6e, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x55, 0x49, 0x44, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x55, 0x49, 0x44, 0x22, 0xa1,
+	0x03, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x12, 0x18, 0x0a, 0x07, 0x43, 0x61, 0x72, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x43, 0x61, 0x72, 0x55, 0x55, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x68,
+	0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e,
+	0x50, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x38, 0x0a, 0x09, 0x52, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x06, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x0a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x6e, 0x22, 0x52, 0x0a, 0x18, 0x47,
-	0x65, 0x74, 0x52, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x6e,
-	0x44, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x52, 0x65, 0x6e, 0x74, 0x73,
-	0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x61, 0x72,
-	0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x52, 0x09, 0x52, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x22,
-	0x9f, 0x01, 0x0a, 0x0b, 0x43, 0x61, 0x72, 0x4d, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x12, 0x0a, 0x04, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55,
-	0x55, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x69,
-	0x63, 0x65, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b,
-	0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x49,
-	0x6d, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x22, 0x35, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
-	0x12, 0x16, 0x0a, 0x06, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x22, 0x21, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x49,
-	0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x22, 0x4f, 0x0a, 0x11, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x12, 0x18, 0x0a, 0x07, 0x43, 0x61, 0x72, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x43, 0x61, 0x72, 0x55, 0x55, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52,
-	0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x22, 0x28, 0x0a, 0x0c,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x72, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07,
-	0x43, 0x61, 0x72, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43,
-	0x61, 0x72, 0x55, 0x55, 0x49, 0x44, 0x22, 0xde, 0x01, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x43, 0x61, 0x72, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x42, 0x72, 0x61, 0x6e, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4d, 0x61, 0x78, 0x53, 0x70, 0x65, 0x65, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x08, 0x4d, 0x61, 0x78, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x53, 0x65, 0x61, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x53, 0x65,
-	0x61, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12,
-	0x20, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x44, 0x61,
-	0x79, 0x12, 0x16, 0x0a, 0x06, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
-	0x0c, 0x52, 0x06, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x4d, 0x61, 0x69,
-	0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x4d, 0x61,
-	0x69, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x22, 0x9f, 0x02, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x61, 0x72,
-	0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x61, 0x72, 0x55,
-	0x55, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x4e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72,
-	0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50,
-	0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x24, 0x0a,
-	0x0d, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x52, 0x65, 0x6e,
-	0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x52, 0x65, 0x6e, 0x74, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x52, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x64, 0x18, 0x07,
+	0x09, 0x52, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x52, 0x65,
+	0x6e, 0x74, 0x45, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x52, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43,
+	0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x50, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x50, 0x69, 0x63,
+	0x6b, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x44, 0x72,
+	0x6f, 0x70, 0x4f, 0x66, 0x66, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x44, 0x72, 0x6f, 0x70, 0x4f, 0x66, 0x66, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x2b, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x52, 0x65, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x22,
+	0xc5, 0x02, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e,
+	0x67, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x4d, 0x61, 0x78, 0x55, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x4d, 0x61, 0x78, 0x55, 0x73, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x4d, 0x61, 0x78,
+	0x55, 0x73, 0x65, 0x73, 0x50, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0e, 0x4d, 0x61, 0x78, 0x55, 0x73, 0x65, 0x73, 0x50, 0x65, 0x72, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x38, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x07,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x07, 0x52, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x64, 0x22, 0x2b, 0x0a, 0x0d, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65,
+	0x52, 0x09, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x3a, 0x0a, 0x0a, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x28, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a,
+	0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64,
+	0x65, 0x22, 0x2b, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x52, 0x65, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x22, 0x2a,
+	0x0a, 0x0c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1a,
+	0x0a, 0x08, 0x52, 0x65, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x52, 0x65, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x22, 0x2d, 0x0a, 0x0f, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a,
+	0x08, 0x52, 0x65, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x52, 0x65, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x22, 0x2b, 0x0a, 0x0d, 0x4d, 0x61, 0x72,
+	0x6b, 0x4e, 0x6f, 0x53, 0x68, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65,
 	0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x52, 0x65,
-	0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x22, 0x2b, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
-	0x52, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x6e, 0x74, 0x55,
-	0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x52, 0x65, 0x6e, 0x74, 0x55,
-	0x55, 0x49, 0x44, 0x22, 0x2a, 0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x52, 0x65, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x22,
-	0xd2, 0x01, 0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x43, 0x61, 0x72, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x43, 0x61, 0x72, 0x55, 0x55, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x65,
-	0x6e, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x52,
+	0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x22, 0xe7, 0x01, 0x0a, 0x0d, 0x51, 0x75, 0x6f, 0x74, 0x65,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x61, 0x72, 0x55,
+	0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x61, 0x72, 0x55, 0x55,
+	0x49, 0x44, 0x12, 0x38, 0x0a, 0x09, 0x52, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x52, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x34, 0x0a, 0x07,
+	0x52, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x52, 0x65, 0x6e, 0x74, 0x45,
+	0x6e, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x50, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x50, 0x69, 0x63, 0x6b, 0x75,
+	0x70, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x44, 0x72, 0x6f, 0x70,
+	0x4f, 0x66, 0x66, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x44, 0x72, 0x6f, 0x70, 0x4f, 0x66, 0x66, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x6a, 0x0a, 0x0f, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xf0, 0x01, 0x0a,
+	0x0d, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x12, 0x18,
+	0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x42, 0x02, 0x18,
+	0x01, 0x52, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x09, 0x42, 0x61, 0x73, 0x65,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x42, 0x02, 0x18, 0x01, 0x52,
+	0x09, 0x42, 0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x48, 0x6f,
+	0x75, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x48, 0x6f, 0x75, 0x72, 0x73,
+	0x12, 0x3d, 0x0a, 0x0b, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69,
+	0x6e, 0x67, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0b, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x27, 0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x52, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x25, 0x0a, 0x04, 0x42, 0x61, 0x73, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72,
+	0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x04, 0x42, 0x61, 0x73, 0x65, 0x22,
+	0x2a, 0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12,
+	0x1a, 0x0a, 0x08, 0x52, 0x65, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x52, 0x65, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x22, 0xe5, 0x02, 0x0a, 0x0c,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x43, 0x61, 0x72, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43,
+	0x61, 0x72, 0x55, 0x55, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x09, 0x52, 0x65, 0x6e, 0x74, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x42, 0x02, 0x18, 0x01, 0x52, 0x09, 0x52,
 	0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72,
 	0x55, 0x55, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72,
 	0x55, 0x55, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x09, 0x52, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x72,
@@ -1269,99 +4429,374 @@ var file_protos_carsharing_proto_rawDesc = []byte{
 	0x0a, 0x07, 0x52, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
 	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x52, 0x65, 0x6e,
-	0x74, 0x45, 0x6e, 0x64, 0x22, 0xe9, 0x01, 0x0a, 0x03, 0x43, 0x61, 0x72, 0x12, 0x14, 0x0a, 0x05,
-	0x42, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x42, 0x72, 0x61,
-	0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x74, 0x45, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x05,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x61,
+	0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x50, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x50, 0x69,
+	0x63, 0x6b, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x44,
+	0x72, 0x6f, 0x70, 0x4f, 0x66, 0x66, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x44, 0x72, 0x6f, 0x70, 0x4f, 0x66, 0x66, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0xb8, 0x02, 0x0a, 0x03, 0x43, 0x61, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x42,
+	0x72, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x42, 0x72, 0x61, 0x6e,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4d, 0x61, 0x78, 0x53, 0x70, 0x65, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x4d, 0x61, 0x78, 0x53, 0x70, 0x65, 0x65,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x65, 0x61, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x12, 0x24, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x44,
+	0x61, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0b, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x55, 0x49,
+	0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x55, 0x49, 0x44, 0x12, 0x16, 0x0a,
+	0x06, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x4d, 0x61, 0x69, 0x6e, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4d, 0x61, 0x69, 0x6e, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x55, 0x49, 0x44, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x55, 0x49, 0x44, 0x22, 0xb9,
+	0x03, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x43,
+	0x61, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x30, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x45, 0x6e, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x03, 0x45, 0x6e, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x50, 0x61, 0x67, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e,
+	0x67, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x50, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x42, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x42, 0x72, 0x61,
+	0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4d, 0x61, 0x78, 0x53, 0x70, 0x65,
-	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x4d, 0x61, 0x78, 0x53, 0x70, 0x65,
-	0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x65, 0x61, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x4d, 0x61, 0x78, 0x53, 0x70, 0x65,
+	0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x65, 0x61, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
 	0x05, 0x52, 0x05, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x61, 0x74, 0x65,
-	0x67, 0x6f, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x61, 0x74, 0x65,
-	0x67, 0x6f, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72,
-	0x44, 0x61, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x55, 0x49, 0x44, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x55, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x49, 0x6d,
-	0x61, 0x67, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x4d, 0x61, 0x69, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4d, 0x61, 0x69, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x22, 0x75, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
-	0x43, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x30, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x45, 0x6e, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x03, 0x45, 0x6e, 0x64, 0x22, 0x39, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x61,
-	0x72, 0x73, 0x52, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x04, 0x43, 0x61, 0x72, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67,
-	0x2e, 0x43, 0x61, 0x72, 0x4d, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x43, 0x61,
-	0x72, 0x73, 0x22, 0xae, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x73, 0x42, 0x79,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x42, 0x72, 0x61,
-	0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4d, 0x61, 0x78, 0x53, 0x70, 0x65, 0x65, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x4d, 0x61, 0x78, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x53, 0x65, 0x61, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-	0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
-	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
-	0x79, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72,
-	0x44, 0x61, 0x79, 0x22, 0x25, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x42, 0x79, 0x55,
-	0x55, 0x49, 0x44, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x55, 0x49, 0x44, 0x32, 0x8c, 0x06, 0x0a, 0x04, 0x43,
-	0x61, 0x72, 0x73, 0x12, 0x42, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6e,
-	0x74, 0x12, 0x19, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x63,
-	0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x43, 0x61, 0x6e, 0x63, 0x65,
-	0x6c, 0x52, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69,
-	0x6e, 0x67, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x09, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x52, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69,
-	0x6e, 0x67, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a,
-	0x18, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x52, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x12, 0x63, 0x0a, 0x15, 0x47, 0x65, 0x74,
-	0x52, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x6e, 0x44, 0x61,
-	0x74, 0x65, 0x12, 0x24, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e,
-	0x47, 0x65, 0x74, 0x52, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x4f,
-	0x6e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68,
-	0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x12, 0x4b,
-	0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x61,
-	0x72, 0x73, 0x12, 0x1f, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e,
-	0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x61, 0x72, 0x73,
-	0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67,
-	0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x12, 0x49, 0x0a, 0x0f, 0x47,
-	0x65, 0x74, 0x43, 0x61, 0x72, 0x73, 0x42, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1e,
-	0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x43,
-	0x61, 0x72, 0x73, 0x42, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x16,
-	0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x43,
-	0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72,
-	0x42, 0x79, 0x55, 0x55, 0x49, 0x44, 0x12, 0x1b, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72,
-	0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x42, 0x79, 0x55, 0x55, 0x49, 0x44,
-	0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67,
-	0x2e, 0x43, 0x61, 0x72, 0x12, 0x3c, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x12, 0x17, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65,
-	0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x63, 0x61, 0x72, 0x73,
-	0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x73, 0x12, 0x3d, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x12,
-	0x18, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x67, 0x6f, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x12, 0x2d, 0x0a, 0x08, 0x4d, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72,
+	0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x08, 0x4d, 0x69, 0x6e, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x4d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69,
+	0x6e, 0x67, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x08, 0x4d, 0x61, 0x78, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x55, 0x49,
+	0x44, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x55, 0x55, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x04, 0x4e, 0x65, 0x61, 0x72, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x41, 0x72, 0x65, 0x61, 0x52, 0x04, 0x4e, 0x65, 0x61, 0x72, 0x22, 0x5c, 0x0a, 0x04, 0x41, 0x72,
+	0x65, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x4c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x4c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x09, 0x4c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x4b, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
+	0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x4b, 0x6d, 0x22, 0xf3, 0x01, 0x0a, 0x07, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x55, 0x55, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x61, 0x74, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x4c, 0x61, 0x74, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x4c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x4c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x4f, 0x70, 0x65, 0x6e, 0x73, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x4f, 0x70, 0x65, 0x6e, 0x73, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x73, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x73, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x5a, 0x6f,
+	0x6e, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x5a, 0x6f,
+	0x6e, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x22, 0x41,
+	0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x12, 0x2d, 0x0a, 0x07, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x26, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x55, 0x49, 0x44, 0x22, 0x36, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x24, 0x0a, 0x04, 0x4e,
+	0x65, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x61, 0x72, 0x73,
+	0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x65, 0x61, 0x52, 0x04, 0x4e, 0x65, 0x61,
+	0x72, 0x22, 0x41, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x08, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69,
+	0x6e, 0x67, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0xde, 0x01, 0x0a, 0x16, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12,
+	0x18, 0x0a, 0x07, 0x43, 0x61, 0x72, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x43, 0x61, 0x72, 0x55, 0x55, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4b, 0x69, 0x6e,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x45, 0x6e, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x03, 0x45, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x4b, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x4b, 0x6d, 0x22, 0x64, 0x0a, 0x16, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55,
+	0x55, 0x49, 0x44, 0x12, 0x36, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72,
+	0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x52, 0x09, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x22, 0xb2, 0x01, 0x0a, 0x0c,
+	0x52, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x52, 0x65, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x52, 0x65, 0x6e, 0x74, 0x55, 0x55, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x38, 0x0a, 0x09, 0x52, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x52, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x52, 0x65,
+	0x6e, 0x74, 0x45, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x52, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x64,
+	0x22, 0x43, 0x0a, 0x13, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x55, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x55, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x4d,
+	0x69, 0x6c, 0x65, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x4d, 0x69,
+	0x6c, 0x65, 0x61, 0x67, 0x65, 0x22, 0x2e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6e,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x43,
+	0x61, 0x72, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x61,
+	0x72, 0x55, 0x55, 0x49, 0x44, 0x22, 0x81, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0c,
+	0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0c, 0x4d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x4e, 0x65, 0x78,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x69, 0x6c, 0x65, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x4e, 0x65, 0x78, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x4d, 0x69, 0x6c, 0x65, 0x61, 0x67, 0x65, 0x22, 0xcf, 0x02, 0x0a, 0x0b, 0x4d, 0x61,
+	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x55, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x55, 0x49, 0x44, 0x12, 0x18, 0x0a,
+	0x07, 0x43, 0x61, 0x72, 0x55, 0x55, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x43, 0x61, 0x72, 0x55, 0x55, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x45, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03,
+	0x45, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4b,
+	0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x4b, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x69, 0x6c, 0x65, 0x61, 0x67, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x4d, 0x69, 0x6c, 0x65, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a,
+	0x08, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x64, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x68, 0x0a, 0x04, 0x50,
+	0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53,
+	0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x44, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x44, 0x65, 0x73, 0x63, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x5f, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x04, 0x43, 0x61, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x43,
+	0x61, 0x72, 0x4d, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x43, 0x61, 0x72, 0x73,
+	0x12, 0x24, 0x0a, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xb6, 0x02, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x61,
+	0x72, 0x73, 0x42, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a,
+	0x05, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x42, 0x72,
+	0x61, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4d, 0x61, 0x78, 0x53, 0x70,
+	0x65, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x4d, 0x61, 0x78, 0x53, 0x70,
+	0x65, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x65, 0x61, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x24, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65,
+	0x72, 0x44, 0x61, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0b,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x12, 0x2d, 0x0a, 0x08, 0x4d,
+	0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x52, 0x08, 0x4d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x4d, 0x69,
+	0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63,
+	0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52,
+	0x08, 0x4d, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x50, 0x61, 0x67,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61,
+	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x50, 0x61, 0x67, 0x65, 0x22,
+	0x93, 0x01, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x61, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x14, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x42, 0x72, 0x61, 0x6e, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x65, 0x61,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12,
+	0x24, 0x0a, 0x04, 0x50, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52,
+	0x04, 0x50, 0x61, 0x67, 0x65, 0x22, 0x33, 0x0a, 0x05, 0x46, 0x61, 0x63, 0x65, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xe9, 0x01, 0x0a, 0x0d, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x04,
+	0x43, 0x61, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x61, 0x72,
+	0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x61, 0x72, 0x4d, 0x61, 0x69, 0x6e, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x04, 0x43, 0x61, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x4e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x29, 0x0a, 0x06, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x46, 0x61, 0x63,
+	0x65, 0x74, 0x52, 0x06, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x31, 0x0a, 0x0a, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x46, 0x61, 0x63, 0x65,
+	0x74, 0x52, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x27, 0x0a,
+	0x05, 0x53, 0x65, 0x61, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63,
+	0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x74, 0x52,
+	0x05, 0x53, 0x65, 0x61, 0x74, 0x73, 0x22, 0x25, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72,
+	0x42, 0x79, 0x55, 0x55, 0x49, 0x44, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x55, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x55, 0x49, 0x44, 0x32, 0xd6, 0x11,
+	0x0a, 0x04, 0x43, 0x61, 0x72, 0x73, 0x12, 0x42, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e,
+	0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a,
+	0x19, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68,
+	0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x09, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68,
+	0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x1a, 0x18, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x09,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x63, 0x61, 0x72, 0x73,
+	0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x43, 0x0a, 0x0c, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x63, 0x61,
+	0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x3f, 0x0a, 0x0a, 0x4d, 0x61, 0x72, 0x6b, 0x4e, 0x6f, 0x53, 0x68, 0x6f, 0x77, 0x12, 0x19,
+	0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
+	0x4e, 0x6f, 0x53, 0x68, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x12, 0x3d, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x72, 0x12, 0x18,
-	0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x79, 0x12, 0x42, 0x0a, 0x0a, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x19, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x51, 0x75, 0x6f,
+	0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x63, 0x61, 0x72,
+	0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e,
+	0x67, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x1a, 0x1a, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x12, 0x63, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x4f,
+	0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69,
+	0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x69,
+	0x6e, 0x67, 0x4f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x63, 0x61,
+	0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x12, 0x4b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x43, 0x61, 0x72, 0x73, 0x12, 0x1f, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69,
+	0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x43,
+	0x61, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72,
+	0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x12, 0x49,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x73, 0x42, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x1e, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x61, 0x72, 0x73, 0x42, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x16, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x0a, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x43, 0x61, 0x72, 0x73, 0x12, 0x19, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61,
+	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x61, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x19, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x12, 0x3c, 0x0a,
+	0x0c, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x42, 0x79, 0x55, 0x55, 0x49, 0x44, 0x12, 0x1b, 0x2e,
+	0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61,
+	0x72, 0x42, 0x79, 0x55, 0x55, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x63, 0x61, 0x72,
+	0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x61, 0x72, 0x12, 0x3c, 0x0a, 0x08, 0x47,
+	0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x17, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61,
+	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x1a, 0x17, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65,
+	0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x12, 0x49, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1d, 0x2e, 0x63, 0x61,
+	0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x63, 0x61, 0x72,
+	0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x43, 0x68, 0x75,
+	0x6e, 0x6b, 0x30, 0x01, 0x12, 0x4b, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69,
+	0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x12, 0x3d, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x12, 0x18,
+	0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61,
 	0x74, 0x65, 0x43, 0x61, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x12, 0x47, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x50, 0x72, 0x69,
-	0x63, 0x65, 0x12, 0x1d, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65,
-	0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x73, 0x65, 0x72, 0x6f, 0x76, 0x2f,
-	0x72, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e,
-	0x2f, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x12, 0x50, 0x0a, 0x0e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x61, 0x72, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x12, 0x1d, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x61, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x1a, 0x1d, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x61, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x28, 0x01, 0x12, 0x48, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x73, 0x12, 0x1b, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x1b, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x61, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1d,
+	0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x61, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4b, 0x0a, 0x10, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x43, 0x61, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x63, 0x61, 0x72, 0x73,
+	0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x61,
+	0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x49, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x43, 0x61, 0x72,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69,
+	0x6e, 0x67, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x43, 0x61, 0x72, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3d, 0x0a,
+	0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x72, 0x12, 0x18, 0x2e, 0x63, 0x61, 0x72,
+	0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61,
+	0x72, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x09,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x12, 0x18, 0x2e, 0x63, 0x61, 0x72, 0x73,
+	0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72,
+	0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x47, 0x0a, 0x0e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1d, 0x2e,
+	0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x61, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x4b, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69,
+	0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x12, 0x5d, 0x0a, 0x13, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x22, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68,
+	0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x61,
+	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x63,
+	0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x12, 0x4b, 0x0a, 0x10, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e,
+	0x67, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x51, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x12, 0x1e, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x1a, 0x1e, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x12, 0x49, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x1e, 0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x49, 0x0a, 0x0f, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1e,
+	0x2e, 0x63, 0x61, 0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x73, 0x65, 0x72, 0x6f, 0x76, 0x2f, 0x72, 0x65, 0x6e,
+	0x74, 0x6c, 0x79, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x63, 0x61,
+	0x72, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1376,66 +4811,194 @@ func file_protos_carsharing_proto_rawDescGZIP() []byte {
 	return file_protos_carsharing_proto_rawDescData
 }
 
-var file_protos_carsharing_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_protos_carsharing_proto_msgTypes = make([]protoimpl.MessageInfo, 60)
 var file_protos_carsharing_proto_goTypes = []interface{}{
-	(*GetRentStartingOnDateReq)(nil), // 0: carsharing.GetRentStartingOnDateReq
-	(*GetRentStartingOnDateRes)(nil), // 1: carsharing.GetRentStartingOnDateRes
-	(*CarMainInfo)(nil),              // 2: carsharing.CarMainInfo
-	(*GetImageReq)(nil),              // 3: carsharing.GetImageReq
-	(*GetImageRes)(nil),              // 4: carsharing.GetImageRes
-	(*UpdateCarPriceReq)(nil),        // 5: carsharing.UpdateCarPriceReq
-	(*DeleteCarReq)(nil),             // 6: carsharing.DeleteCarReq
-	(*CreateCarReq)(nil),             // 7: carsharing.CreateCarReq
-	(*CreateRentReq)(nil),            // 8: carsharing.CreateRentReq
-	(*CreateRentRes)(nil),            // 9: carsharing.CreateRentRes
-	(*CancelRentReq)(nil),            // 10: carsharing.CancelRentReq
-	(*CheckRentReq)(nil),             // 11: carsharing.CheckRentReq
-	(*CheckRentRes)(nil),             // 12: carsharing.CheckRentRes
-	(*Car)(nil),                      // 13: carsharing.Car
-	(*GetAvailableCarsReq)(nil),      // 14: carsharing.GetAvailableCarsReq
-	(*GetCarsRes)(nil),               // 15: carsharing.GetCarsRes
-	(*GetCarsByParamsReq)(nil),       // 16: carsharing.GetCarsByParamsReq
-	(*GetCarByUUIDReq)(nil),          // 17: carsharing.GetCarByUUIDReq
-	(*timestamppb.Timestamp)(nil),    // 18: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),            // 19: google.protobuf.Empty
+	(*Money)(nil),                    // 0: carsharing.Money
+	(*GetRentStartingOnDateReq)(nil), // 1: carsharing.GetRentStartingOnDateReq
+	(*GetRentStartingOnDateRes)(nil), // 2: carsharing.GetRentStartingOnDateRes
+	(*CarMainInfo)(nil),              // 3: carsharing.CarMainInfo
+	(*GetImageReq)(nil),              // 4: carsharing.GetImageReq
+	(*GetImageStreamReq)(nil),        // 5: carsharing.GetImageStreamReq
+	(*ImageChunk)(nil),               // 6: carsharing.ImageChunk
+	(*UploadCarImageReq)(nil),        // 7: carsharing.UploadCarImageReq
+	(*UploadCarImageInfo)(nil),       // 8: carsharing.UploadCarImageInfo
+	(*UploadCarImageRes)(nil),        // 9: carsharing.UploadCarImageRes
+	(*GetCarImagesReq)(nil),          // 10: carsharing.GetCarImagesReq
+	(*CarImage)(nil),                 // 11: carsharing.CarImage
+	(*GetCarImagesRes)(nil),          // 12: carsharing.GetCarImagesRes
+	(*DeleteCarImageReq)(nil),        // 13: carsharing.DeleteCarImageReq
+	(*ReorderCarImagesReq)(nil),      // 14: carsharing.ReorderCarImagesReq
+	(*SetMainCarImageReq)(nil),       // 15: carsharing.SetMainCarImageReq
+	(*GetImageRes)(nil),              // 16: carsharing.GetImageRes
+	(*UpdateCarPriceReq)(nil),        // 17: carsharing.UpdateCarPriceReq
+	(*UpdateCarReq)(nil),             // 18: carsharing.UpdateCarReq
+	(*DeleteCarReq)(nil),             // 19: carsharing.DeleteCarReq
+	(*GetCarChangesReq)(nil),         // 20: carsharing.GetCarChangesReq
+	(*GetCarChangesRes)(nil),         // 21: carsharing.GetCarChangesRes
+	(*CarChange)(nil),                // 22: carsharing.CarChange
+	(*FieldChange)(nil),              // 23: carsharing.FieldChange
+	(*CreateCarReq)(nil),             // 24: carsharing.CreateCarReq
+	(*CreateRentReq)(nil),            // 25: carsharing.CreateRentReq
+	(*CreateRentRes)(nil),            // 26: carsharing.CreateRentRes
+	(*CreatePromoCodeReq)(nil),       // 27: carsharing.CreatePromoCodeReq
+	(*ExpirePromoCodeReq)(nil),       // 28: carsharing.ExpirePromoCodeReq
+	(*CancelRentReq)(nil),            // 29: carsharing.CancelRentReq
+	(*StartRentReq)(nil),             // 30: carsharing.StartRentReq
+	(*CompleteRentReq)(nil),          // 31: carsharing.CompleteRentReq
+	(*MarkNoShowReq)(nil),            // 32: carsharing.MarkNoShowReq
+	(*QuotePriceReq)(nil),            // 33: carsharing.QuotePriceReq
+	(*PriceAdjustment)(nil),          // 34: carsharing.PriceAdjustment
+	(*QuotePriceRes)(nil),            // 35: carsharing.QuotePriceRes
+	(*CheckRentReq)(nil),             // 36: carsharing.CheckRentReq
+	(*CheckRentRes)(nil),             // 37: carsharing.CheckRentRes
+	(*Car)(nil),                      // 38: carsharing.Car
+	(*GetAvailableCarsReq)(nil),      // 39: carsharing.GetAvailableCarsReq
+	(*Area)(nil),                     // 40: carsharing.Area
+	(*Station)(nil),                  // 41: carsharing.Station
+	(*CreateStationReq)(nil),         // 42: carsharing.CreateStationReq
+	(*CreateStationRes)(nil),         // 43: carsharing.CreateStationRes
+	(*GetStationsReq)(nil),           // 44: carsharing.GetStationsReq
+	(*GetStationsRes)(nil),           // 45: carsharing.GetStationsRes
+	(*ScheduleMaintenanceReq)(nil),   // 46: carsharing.ScheduleMaintenanceReq
+	(*ScheduleMaintenanceRes)(nil),   // 47: carsharing.ScheduleMaintenanceRes
+	(*RentConflict)(nil),             // 48: carsharing.RentConflict
+	(*CloseMaintenanceReq)(nil),      // 49: carsharing.CloseMaintenanceReq
+	(*GetMaintenancesReq)(nil),       // 50: carsharing.GetMaintenancesReq
+	(*GetMaintenancesRes)(nil),       // 51: carsharing.GetMaintenancesRes
+	(*Maintenance)(nil),              // 52: carsharing.Maintenance
+	(*Page)(nil),                     // 53: carsharing.Page
+	(*GetCarsRes)(nil),               // 54: carsharing.GetCarsRes
+	(*GetCarsByParamsReq)(nil),       // 55: carsharing.GetCarsByParamsReq
+	(*SearchCarsReq)(nil),            // 56: carsharing.SearchCarsReq
+	(*Facet)(nil),                    // 57: carsharing.Facet
+	(*SearchCarsRes)(nil),            // 58: carsharing.SearchCarsRes
+	(*GetCarByUUIDReq)(nil),          // 59: carsharing.GetCarByUUIDReq
+	(*timestamppb.Timestamp)(nil),    // 60: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),    // 61: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),            // 62: google.protobuf.Empty
 }
 var file_protos_carsharing_proto_depIdxs = []int32{
-	18, // 0: carsharing.GetRentStartingOnDateReq.StartingOn:type_name -> google.protobuf.Timestamp
-	12, // 1: carsharing.GetRentStartingOnDateRes.RentsInfo:type_name -> carsharing.CheckRentRes
-	18, // 2: carsharing.CreateRentReq.RentStart:type_name -> google.protobuf.Timestamp
-	18, // 3: carsharing.CreateRentReq.RentEnd:type_name -> google.protobuf.Timestamp
-	18, // 4: carsharing.CheckRentRes.RentStart:type_name -> google.protobuf.Timestamp
-	18, // 5: carsharing.CheckRentRes.RentEnd:type_name -> google.protobuf.Timestamp
-	18, // 6: carsharing.GetAvailableCarsReq.Start:type_name -> google.protobuf.Timestamp
-	18, // 7: carsharing.GetAvailableCarsReq.End:type_name -> google.protobuf.Timestamp
-	2,  // 8: carsharing.GetCarsRes.Cars:type_name -> carsharing.CarMainInfo
-	8,  // 9: carsharing.Cars.CreateRent:input_type -> carsharing.CreateRentReq
-	10, // 10: carsharing.Cars.CancelRent:input_type -> carsharing.CancelRentReq
-	11, // 11: carsharing.Cars.CheckRent:input_type -> carsharing.CheckRentReq
-	0,  // 12: carsharing.Cars.GetRentStartingOnDate:input_type -> carsharing.GetRentStartingOnDateReq
-	14, // 13: carsharing.Cars.GetAvailableCars:input_type -> carsharing.GetAvailableCarsReq
-	16, // 14: carsharing.Cars.GetCarsByParams:input_type -> carsharing.GetCarsByParamsReq
-	17, // 15: carsharing.Cars.GetCarByUUID:input_type -> carsharing.GetCarByUUIDReq
-	3,  // 16: carsharing.Cars.GetImage:input_type -> carsharing.GetImageReq
-	7,  // 17: carsharing.Cars.CreateCar:input_type -> carsharing.CreateCarReq
-	6,  // 18: carsharing.Cars.DeleteCar:input_type -> carsharing.DeleteCarReq
-	5,  // 19: carsharing.Cars.UpdateCarPrice:input_type -> carsharing.UpdateCarPriceReq
-	9,  // 20: carsharing.Cars.CreateRent:output_type -> carsharing.CreateRentRes
-	19, // 21: carsharing.Cars.CancelRent:output_type -> google.protobuf.Empty
-	12, // 22: carsharing.Cars.CheckRent:output_type -> carsharing.CheckRentRes
-	1,  // 23: carsharing.Cars.GetRentStartingOnDate:output_type -> carsharing.GetRentStartingOnDateRes
-	15, // 24: carsharing.Cars.GetAvailableCars:output_type -> carsharing.GetCarsRes
-	15, // 25: carsharing.Cars.GetCarsByParams:output_type -> carsharing.GetCarsRes
-	13, // 26: carsharing.Cars.GetCarByUUID:output_type -> carsharing.Car
-	4,  // 27: carsharing.Cars.GetImage:output_type -> carsharing.GetImageRes
-	19, // 28: carsharing.Cars.CreateCar:output_type -> google.protobuf.Empty
-	19, // 29: carsharing.Cars.DeleteCar:output_type -> google.protobuf.Empty
-	19, // 30: carsharing.Cars.UpdateCarPrice:output_type -> google.protobuf.Empty
-	20, // [20:31] is the sub-list for method output_type
-	9,  // [9:20] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	60, // 0: carsharing.GetRentStartingOnDateReq.StartingOn:type_name -> google.protobuf.Timestamp
+	37, // 1: carsharing.GetRentStartingOnDateRes.RentsInfo:type_name -> carsharing.CheckRentRes
+	0,  // 2: carsharing.CarMainInfo.Price:type_name -> carsharing.Money
+	8,  // 3: carsharing.UploadCarImageReq.Info:type_name -> carsharing.UploadCarImageInfo
+	11, // 4: carsharing.GetCarImagesRes.Images:type_name -> carsharing.CarImage
+	0,  // 5: carsharing.UpdateCarPriceReq.Price:type_name -> carsharing.Money
+	38, // 6: carsharing.UpdateCarReq.Car:type_name -> carsharing.Car
+	61, // 7: carsharing.UpdateCarReq.UpdateMask:type_name -> google.protobuf.FieldMask
+	22, // 8: carsharing.GetCarChangesRes.Changes:type_name -> carsharing.CarChange
+	23, // 9: carsharing.CarChange.Changes:type_name -> carsharing.FieldChange
+	60, // 10: carsharing.CarChange.ChangedAt:type_name -> google.protobuf.Timestamp
+	0,  // 11: carsharing.CreateCarReq.Price:type_name -> carsharing.Money
+	60, // 12: carsharing.CreateRentReq.RentStart:type_name -> google.protobuf.Timestamp
+	60, // 13: carsharing.CreateRentReq.RentEnd:type_name -> google.protobuf.Timestamp
+	0,  // 14: carsharing.CreatePromoCodeReq.Amount:type_name -> carsharing.Money
+	60, // 15: carsharing.CreatePromoCodeReq.ValidFrom:type_name -> google.protobuf.Timestamp
+	60, // 16: carsharing.CreatePromoCodeReq.ValidUntil:type_name -> google.protobuf.Timestamp
+	60, // 17: carsharing.QuotePriceReq.RentStart:type_name -> google.protobuf.Timestamp
+	60, // 18: carsharing.QuotePriceReq.RentEnd:type_name -> google.protobuf.Timestamp
+	0,  // 19: carsharing.PriceAdjustment.Value:type_name -> carsharing.Money
+	34, // 20: carsharing.QuotePriceRes.Adjustments:type_name -> carsharing.PriceAdjustment
+	0,  // 21: carsharing.QuotePriceRes.Total:type_name -> carsharing.Money
+	0,  // 22: carsharing.QuotePriceRes.Base:type_name -> carsharing.Money
+	60, // 23: carsharing.CheckRentRes.RentStart:type_name -> google.protobuf.Timestamp
+	60, // 24: carsharing.CheckRentRes.RentEnd:type_name -> google.protobuf.Timestamp
+	0,  // 25: carsharing.CheckRentRes.Price:type_name -> carsharing.Money
+	0,  // 26: carsharing.Car.Price:type_name -> carsharing.Money
+	60, // 27: carsharing.GetAvailableCarsReq.Start:type_name -> google.protobuf.Timestamp
+	60, // 28: carsharing.GetAvailableCarsReq.End:type_name -> google.protobuf.Timestamp
+	53, // 29: carsharing.GetAvailableCarsReq.Page:type_name -> carsharing.Page
+	0,  // 30: carsharing.GetAvailableCarsReq.MinPrice:type_name -> carsharing.Money
+	0,  // 31: carsharing.GetAvailableCarsReq.MaxPrice:type_name -> carsharing.Money
+	40, // 32: carsharing.GetAvailableCarsReq.Near:type_name -> carsharing.Area
+	41, // 33: carsharing.CreateStationReq.Station:type_name -> carsharing.Station
+	40, // 34: carsharing.GetStationsReq.Near:type_name -> carsharing.Area
+	41, // 35: carsharing.GetStationsRes.Stations:type_name -> carsharing.Station
+	60, // 36: carsharing.ScheduleMaintenanceReq.Start:type_name -> google.protobuf.Timestamp
+	60, // 37: carsharing.ScheduleMaintenanceReq.End:type_name -> google.protobuf.Timestamp
+	48, // 38: carsharing.ScheduleMaintenanceRes.Conflicts:type_name -> carsharing.RentConflict
+	60, // 39: carsharing.RentConflict.RentStart:type_name -> google.protobuf.Timestamp
+	60, // 40: carsharing.RentConflict.RentEnd:type_name -> google.protobuf.Timestamp
+	52, // 41: carsharing.GetMaintenancesRes.Maintenances:type_name -> carsharing.Maintenance
+	60, // 42: carsharing.Maintenance.Start:type_name -> google.protobuf.Timestamp
+	60, // 43: carsharing.Maintenance.End:type_name -> google.protobuf.Timestamp
+	60, // 44: carsharing.Maintenance.ClosedAt:type_name -> google.protobuf.Timestamp
+	3,  // 45: carsharing.GetCarsRes.Cars:type_name -> carsharing.CarMainInfo
+	0,  // 46: carsharing.GetCarsByParamsReq.MaxPrice:type_name -> carsharing.Money
+	0,  // 47: carsharing.GetCarsByParamsReq.MinPrice:type_name -> carsharing.Money
+	53, // 48: carsharing.GetCarsByParamsReq.Page:type_name -> carsharing.Page
+	53, // 49: carsharing.SearchCarsReq.Page:type_name -> carsharing.Page
+	3,  // 50: carsharing.SearchCarsRes.Cars:type_name -> carsharing.CarMainInfo
+	57, // 51: carsharing.SearchCarsRes.Brands:type_name -> carsharing.Facet
+	57, // 52: carsharing.SearchCarsRes.Categories:type_name -> carsharing.Facet
+	57, // 53: carsharing.SearchCarsRes.Seats:type_name -> carsharing.Facet
+	25, // 54: carsharing.Cars.CreateRent:input_type -> carsharing.CreateRentReq
+	29, // 55: carsharing.Cars.CancelRent:input_type -> carsharing.CancelRentReq
+	36, // 56: carsharing.Cars.CheckRent:input_type -> carsharing.CheckRentReq
+	30, // 57: carsharing.Cars.StartRent:input_type -> carsharing.StartRentReq
+	31, // 58: carsharing.Cars.CompleteRent:input_type -> carsharing.CompleteRentReq
+	32, // 59: carsharing.Cars.MarkNoShow:input_type -> carsharing.MarkNoShowReq
+	33, // 60: carsharing.Cars.QuotePrice:input_type -> carsharing.QuotePriceReq
+	44, // 61: carsharing.Cars.GetStations:input_type -> carsharing.GetStationsReq
+	1,  // 62: carsharing.Cars.GetRentStartingOnDate:input_type -> carsharing.GetRentStartingOnDateReq
+	39, // 63: carsharing.Cars.GetAvailableCars:input_type -> carsharing.GetAvailableCarsReq
+	55, // 64: carsharing.Cars.GetCarsByParams:input_type -> carsharing.GetCarsByParamsReq
+	56, // 65: carsharing.Cars.SearchCars:input_type -> carsharing.SearchCarsReq
+	59, // 66: carsharing.Cars.GetCarByUUID:input_type -> carsharing.GetCarByUUIDReq
+	4,  // 67: carsharing.Cars.GetImage:input_type -> carsharing.GetImageReq
+	5,  // 68: carsharing.Cars.GetImageStream:input_type -> carsharing.GetImageStreamReq
+	42, // 69: carsharing.Cars.CreateStation:input_type -> carsharing.CreateStationReq
+	24, // 70: carsharing.Cars.CreateCar:input_type -> carsharing.CreateCarReq
+	7,  // 71: carsharing.Cars.UploadCarImage:input_type -> carsharing.UploadCarImageReq
+	10, // 72: carsharing.Cars.GetCarImages:input_type -> carsharing.GetCarImagesReq
+	13, // 73: carsharing.Cars.DeleteCarImage:input_type -> carsharing.DeleteCarImageReq
+	14, // 74: carsharing.Cars.ReorderCarImages:input_type -> carsharing.ReorderCarImagesReq
+	15, // 75: carsharing.Cars.SetMainCarImage:input_type -> carsharing.SetMainCarImageReq
+	19, // 76: carsharing.Cars.DeleteCar:input_type -> carsharing.DeleteCarReq
+	18, // 77: carsharing.Cars.UpdateCar:input_type -> carsharing.UpdateCarReq
+	17, // 78: carsharing.Cars.UpdateCarPrice:input_type -> carsharing.UpdateCarPriceReq
+	20, // 79: carsharing.Cars.GetCarChanges:input_type -> carsharing.GetCarChangesReq
+	46, // 80: carsharing.Cars.ScheduleMaintenance:input_type -> carsharing.ScheduleMaintenanceReq
+	49, // 81: carsharing.Cars.CloseMaintenance:input_type -> carsharing.CloseMaintenanceReq
+	50, // 82: carsharing.Cars.GetMaintenances:input_type -> carsharing.GetMaintenancesReq
+	27, // 83: carsharing.Cars.CreatePromoCode:input_type -> carsharing.CreatePromoCodeReq
+	28, // 84: carsharing.Cars.ExpirePromoCode:input_type -> carsharing.ExpirePromoCodeReq
+	26, // 85: carsharing.Cars.CreateRent:output_type -> carsharing.CreateRentRes
+	62, // 86: carsharing.Cars.CancelRent:output_type -> google.protobuf.Empty
+	37, // 87: carsharing.Cars.CheckRent:output_type -> carsharing.CheckRentRes
+	62, // 88: carsharing.Cars.StartRent:output_type -> google.protobuf.Empty
+	62, // 89: carsharing.Cars.CompleteRent:output_type -> google.protobuf.Empty
+	62, // 90: carsharing.Cars.MarkNoShow:output_type -> google.protobuf.Empty
+	35, // 91: carsharing.Cars.QuotePrice:output_type -> carsharing.QuotePriceRes
+	45, // 92: carsharing.Cars.GetStations:output_type -> carsharing.GetStationsRes
+	2,  // 93: carsharing.Cars.GetRentStartingOnDate:output_type -> carsharing.GetRentStartingOnDateRes
+	54, // 94: carsharing.Cars.GetAvailableCars:output_type -> carsharing.GetCarsRes
+	54, // 95: carsharing.Cars.GetCarsByParams:output_type -> carsharing.GetCarsRes
+	58, // 96: carsharing.Cars.SearchCars:output_type -> carsharing.SearchCarsRes
+	38, // 97: carsharing.Cars.GetCarByUUID:output_type -> carsharing.Car
+	16, // 98: carsharing.Cars.GetImage:output_type -> carsharing.GetImageRes
+	6,  // 99: carsharing.Cars.GetImageStream:output_type -> carsharing.ImageChunk
+	43, // 100: carsharing.Cars.CreateStation:output_type -> carsharing.CreateStationRes
+	62, // 101: carsharing.Cars.CreateCar:output_type -> google.protobuf.Empty
+	9,  // 102: carsharing.Cars.UploadCarImage:output_type -> carsharing.UploadCarImageRes
+	12, // 103: carsharing.Cars.GetCarImages:output_type -> carsharing.GetCarImagesRes
+	62, // 104: carsharing.Cars.DeleteCarImage:output_type -> google.protobuf.Empty
+	62, // 105: carsharing.Cars.ReorderCarImages:output_type -> google.protobuf.Empty
+	62, // 106: carsharing.Cars.SetMainCarImage:output_type -> google.protobuf.Empty
+	62, // 107: carsharing.Cars.DeleteCar:output_type -> google.protobuf.Empty
+	62, // 108: carsharing.Cars.UpdateCar:output_type -> google.protobuf.Empty
+	62, // 109: carsharing.Cars.UpdateCarPrice:output_type -> google.protobuf.Empty
+	21, // 110: carsharing.Cars.GetCarChanges:output_type -> carsharing.GetCarChangesRes
+	47, // 111: carsharing.Cars.ScheduleMaintenance:output_type -> carsharing.ScheduleMaintenanceRes
+	62, // 112: carsharing.Cars.CloseMaintenance:output_type -> google.protobuf.Empty
+	51, // 113: carsharing.Cars.GetMaintenances:output_type -> carsharing.GetMaintenancesRes
+	62, // 114: carsharing.Cars.CreatePromoCode:output_type -> google.protobuf.Empty
+	62, // 115: carsharing.Cars.ExpirePromoCode:output_type -> google.protobuf.Empty
+	85, // [85:116] is the sub-list for method output_type
+	54, // [54:85] is the sub-list for method input_type
+	54, // [54:54] is the sub-list for extension type_name
+	54, // [54:54] is the sub-list for extension extendee
+	0,  // [0:54] is the sub-list for field type_name
 }
 
 func init() { file_protos_carsharing_proto_init() }
@@ -1445,6 +5008,18 @@ func file_protos_carsharing_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_protos_carsharing_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Money); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetRentStartingOnDateReq); i {
 			case 0:
 				return &v.state
@@ -1456,8 +5031,236 @@ func file_protos_carsharing_proto_init() {
 				return nil
 			}
 		}
-		file_protos_carsharing_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRentStartingOnDateRes); i {
+		file_protos_carsharing_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRentStartingOnDateRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CarMainInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetImageReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetImageStreamReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadCarImageReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadCarImageInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadCarImageRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCarImagesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CarImage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCarImagesRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCarImageReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReorderCarImagesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMainCarImageReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetImageRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCarPriceReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCarReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCarReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCarChangesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCarChangesRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1468,8 +5271,8 @@ func file_protos_carsharing_proto_init() {
 				return nil
 			}
 		}
-		file_protos_carsharing_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CarMainInfo); i {
+		file_protos_carsharing_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CarChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1480,8 +5283,8 @@ func file_protos_carsharing_proto_init() {
 				return nil
 			}
 		}
-		file_protos_carsharing_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetImageReq); i {
+		file_protos_carsharing_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1492,8 +5295,8 @@ func file_protos_carsharing_proto_init() {
 				return nil
 			}
 		}
-		file_protos_carsharing_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetImageRes); i {
+		file_protos_carsharing_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCarReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1504,8 +5307,8 @@ func file_protos_carsharing_proto_init() {
 				return nil
 			}
 		}
-		file_protos_carsharing_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateCarPriceReq); i {
+		file_protos_carsharing_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRentReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1516,8 +5319,8 @@ func file_protos_carsharing_proto_init() {
 				return nil
 			}
 		}
-		file_protos_carsharing_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteCarReq); i {
+		file_protos_carsharing_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRentRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1528,8 +5331,8 @@ func file_protos_carsharing_proto_init() {
 				return nil
 			}
 		}
-		file_protos_carsharing_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCarReq); i {
+		file_protos_carsharing_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePromoCodeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1540,8 +5343,8 @@ func file_protos_carsharing_proto_init() {
 				return nil
 			}
 		}
-		file_protos_carsharing_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRentReq); i {
+		file_protos_carsharing_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpirePromoCodeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1552,8 +5355,8 @@ func file_protos_carsharing_proto_init() {
 				return nil
 			}
 		}
-		file_protos_carsharing_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRentRes); i {
+		file_protos_carsharing_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelRentReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1564,8 +5367,8 @@ func file_protos_carsharing_proto_init() {
 				return nil
 			}
 		}
-		file_protos_carsharing_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelRentReq); i {
+		file_protos_carsharing_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartRentReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1576,7 +5379,67 @@ func file_protos_carsharing_proto_init() {
 				return nil
 			}
 		}
-		file_protos_carsharing_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_carsharing_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompleteRentReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarkNoShowReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuotePriceReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceAdjustment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuotePriceRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CheckRentReq); i {
 			case 0:
 				return &v.state
@@ -1588,7 +5451,7 @@ func file_protos_carsharing_proto_init() {
 				return nil
 			}
 		}
-		file_protos_carsharing_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_carsharing_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CheckRentRes); i {
 			case 0:
 				return &v.state
@@ -1600,7 +5463,7 @@ func file_protos_carsharing_proto_init() {
 				return nil
 			}
 		}
-		file_protos_carsharing_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_carsharing_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Car); i {
 			case 0:
 				return &v.state
@@ -1612,7 +5475,7 @@ func file_protos_carsharing_proto_init() {
 				return nil
 			}
 		}
-		file_protos_carsharing_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_carsharing_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetAvailableCarsReq); i {
 			case 0:
 				return &v.state
@@ -1624,7 +5487,175 @@ func file_protos_carsharing_proto_init() {
 				return nil
 			}
 		}
-		file_protos_carsharing_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_carsharing_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Area); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Station); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateStationReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateStationRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStationsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStationsRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleMaintenanceReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleMaintenanceRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RentConflict); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseMaintenanceReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMaintenancesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMaintenancesRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Maintenance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Page); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetCarsRes); i {
 			case 0:
 				return &v.state
@@ -1636,7 +5667,7 @@ func file_protos_carsharing_proto_init() {
 				return nil
 			}
 		}
-		file_protos_carsharing_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_carsharing_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetCarsByParamsReq); i {
 			case 0:
 				return &v.state
@@ -1648,7 +5679,43 @@ func file_protos_carsharing_proto_init() {
 				return nil
 			}
 		}
-		file_protos_carsharing_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_carsharing_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchCarsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Facet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchCarsRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_carsharing_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetCarByUUIDReq); i {
 			case 0:
 				return &v.state
@@ -1661,13 +5728,17 @@ func file_protos_carsharing_proto_init() {
 			}
 		}
 	}
+	file_protos_carsharing_proto_msgTypes[7].OneofWrappers = []interface{}{
+		(*UploadCarImageReq_Info)(nil),
+		(*UploadCarImageReq_Chunk)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protos_carsharing_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   18,
+			NumMessages:   60,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
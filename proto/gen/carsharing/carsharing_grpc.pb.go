@@ -26,14 +26,34 @@ type CarsClient interface {
 	CreateRent(ctx context.Context, in *CreateRentReq, opts ...grpc.CallOption) (*CreateRentRes, error)
 	CancelRent(ctx context.Context, in *CancelRentReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	CheckRent(ctx context.Context, in *CheckRentReq, opts ...grpc.CallOption) (*CheckRentRes, error)
+	StartRent(ctx context.Context, in *StartRentReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CompleteRent(ctx context.Context, in *CompleteRentReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MarkNoShow(ctx context.Context, in *MarkNoShowReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	QuotePrice(ctx context.Context, in *QuotePriceReq, opts ...grpc.CallOption) (*QuotePriceRes, error)
+	GetStations(ctx context.Context, in *GetStationsReq, opts ...grpc.CallOption) (*GetStationsRes, error)
 	GetRentStartingOnDate(ctx context.Context, in *GetRentStartingOnDateReq, opts ...grpc.CallOption) (*GetRentStartingOnDateRes, error)
 	GetAvailableCars(ctx context.Context, in *GetAvailableCarsReq, opts ...grpc.CallOption) (*GetCarsRes, error)
 	GetCarsByParams(ctx context.Context, in *GetCarsByParamsReq, opts ...grpc.CallOption) (*GetCarsRes, error)
+	SearchCars(ctx context.Context, in *SearchCarsReq, opts ...grpc.CallOption) (*SearchCarsRes, error)
 	GetCarByUUID(ctx context.Context, in *GetCarByUUIDReq, opts ...grpc.CallOption) (*Car, error)
 	GetImage(ctx context.Context, in *GetImageReq, opts ...grpc.CallOption) (*GetImageRes, error)
+	GetImageStream(ctx context.Context, in *GetImageStreamReq, opts ...grpc.CallOption) (Cars_GetImageStreamClient, error)
+	CreateStation(ctx context.Context, in *CreateStationReq, opts ...grpc.CallOption) (*CreateStationRes, error)
 	CreateCar(ctx context.Context, in *CreateCarReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UploadCarImage(ctx context.Context, opts ...grpc.CallOption) (Cars_UploadCarImageClient, error)
+	GetCarImages(ctx context.Context, in *GetCarImagesReq, opts ...grpc.CallOption) (*GetCarImagesRes, error)
+	DeleteCarImage(ctx context.Context, in *DeleteCarImageReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ReorderCarImages(ctx context.Context, in *ReorderCarImagesReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetMainCarImage(ctx context.Context, in *SetMainCarImageReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteCar(ctx context.Context, in *DeleteCarReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UpdateCar(ctx context.Context, in *UpdateCarReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UpdateCarPrice(ctx context.Context, in *UpdateCarPriceReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetCarChanges(ctx context.Context, in *GetCarChangesReq, opts ...grpc.CallOption) (*GetCarChangesRes, error)
+	ScheduleMaintenance(ctx context.Context, in *ScheduleMaintenanceReq, opts ...grpc.CallOption) (*ScheduleMaintenanceRes, error)
+	CloseMaintenance(ctx context.Context, in *CloseMaintenanceReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetMaintenances(ctx context.Context, in *GetMaintenancesReq, opts ...grpc.CallOption) (*GetMaintenancesRes, error)
+	CreatePromoCode(ctx context.Context, in *CreatePromoCodeReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ExpirePromoCode(ctx context.Context, in *ExpirePromoCodeReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type carsClient struct {
@@ -71,6 +91,51 @@ func (c *carsClient) CheckRent(ctx context.Context, in *CheckRentReq, opts ...gr
 	return out, nil
 }
 
+func (c *carsClient) StartRent(ctx context.Context, in *StartRentReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/carsharing.Cars/StartRent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *carsClient) CompleteRent(ctx context.Context, in *CompleteRentReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/carsharing.Cars/CompleteRent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *carsClient) MarkNoShow(ctx context.Context, in *MarkNoShowReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/carsharing.Cars/MarkNoShow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *carsClient) QuotePrice(ctx context.Context, in *QuotePriceReq, opts ...grpc.CallOption) (*QuotePriceRes, error) {
+	out := new(QuotePriceRes)
+	err := c.cc.Invoke(ctx, "/carsharing.Cars/QuotePrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *carsClient) GetStations(ctx context.Context, in *GetStationsReq, opts ...grpc.CallOption) (*GetStationsRes, error) {
+	out := new(GetStationsRes)
+	err := c.cc.Invoke(ctx, "/carsharing.Cars/GetStations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *carsClient) GetRentStartingOnDate(ctx context.Context, in *GetRentStartingOnDateReq, opts ...grpc.CallOption) (*GetRentStartingOnDateRes, error) {
 	out := new(GetRentStartingOnDateRes)
 	err := c.cc.Invoke(ctx, "/carsharing.Cars/GetRentStartingOnDate", in, out, opts...)
@@ -98,6 +163,15 @@ func (c *carsClient) GetCarsByParams(ctx context.Context, in *GetCarsByParamsReq
 	return out, nil
 }
 
+func (c *carsClient) SearchCars(ctx context.Context, in *SearchCarsReq, opts ...grpc.CallOption) (*SearchCarsRes, error) {
+	out := new(SearchCarsRes)
+	err := c.cc.Invoke(ctx, "/carsharing.Cars/SearchCars", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *carsClient) GetCarByUUID(ctx context.Context, in *GetCarByUUIDReq, opts ...grpc.CallOption) (*Car, error) {
 	out := new(Car)
 	err := c.cc.Invoke(ctx, "/carsharing.Cars/GetCarByUUID", in, out, opts...)
@@ -116,6 +190,47 @@ func (c *carsClient) GetImage(ctx context.Context, in *GetImageReq, opts ...grpc
 	return out, nil
 }
 
+func (c *carsClient) GetImageStream(ctx context.Context, in *GetImageStreamReq, opts ...grpc.CallOption) (Cars_GetImageStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Cars_ServiceDesc.Streams[0], "/carsharing.Cars/GetImageStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &carsGetImageStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Cars_GetImageStreamClient interface {
+	Recv() (*ImageChunk, error)
+	grpc.ClientStream
+}
+
+type carsGetImageStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *carsGetImageStreamClient) Recv() (*ImageChunk, error) {
+	m := new(ImageChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *carsClient) CreateStation(ctx context.Context, in *CreateStationReq, opts ...grpc.CallOption) (*CreateStationRes, error) {
+	out := new(CreateStationRes)
+	err := c.cc.Invoke(ctx, "/carsharing.Cars/CreateStation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *carsClient) CreateCar(ctx context.Context, in *CreateCarReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/carsharing.Cars/CreateCar", in, out, opts...)
@@ -125,6 +240,76 @@ func (c *carsClient) CreateCar(ctx context.Context, in *CreateCarReq, opts ...gr
 	return out, nil
 }
 
+func (c *carsClient) UploadCarImage(ctx context.Context, opts ...grpc.CallOption) (Cars_UploadCarImageClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Cars_ServiceDesc.Streams[1], "/carsharing.Cars/UploadCarImage", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &carsUploadCarImageClient{stream}
+	return x, nil
+}
+
+type Cars_UploadCarImageClient interface {
+	Send(*UploadCarImageReq) error
+	CloseAndRecv() (*UploadCarImageRes, error)
+	grpc.ClientStream
+}
+
+type carsUploadCarImageClient struct {
+	grpc.ClientStream
+}
+
+func (x *carsUploadCarImageClient) Send(m *UploadCarImageReq) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *carsUploadCarImageClient) CloseAndRecv() (*UploadCarImageRes, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadCarImageRes)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *carsClient) GetCarImages(ctx context.Context, in *GetCarImagesReq, opts ...grpc.CallOption) (*GetCarImagesRes, error) {
+	out := new(GetCarImagesRes)
+	err := c.cc.Invoke(ctx, "/carsharing.Cars/GetCarImages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *carsClient) DeleteCarImage(ctx context.Context, in *DeleteCarImageReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/carsharing.Cars/DeleteCarImage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *carsClient) ReorderCarImages(ctx context.Context, in *ReorderCarImagesReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/carsharing.Cars/ReorderCarImages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *carsClient) SetMainCarImage(ctx context.Context, in *SetMainCarImageReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/carsharing.Cars/SetMainCarImage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *carsClient) DeleteCar(ctx context.Context, in *DeleteCarReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/carsharing.Cars/DeleteCar", in, out, opts...)
@@ -134,6 +319,15 @@ func (c *carsClient) DeleteCar(ctx context.Context, in *DeleteCarReq, opts ...gr
 	return out, nil
 }
 
+func (c *carsClient) UpdateCar(ctx context.Context, in *UpdateCarReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/carsharing.Cars/UpdateCar", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *carsClient) UpdateCarPrice(ctx context.Context, in *UpdateCarPriceReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/carsharing.Cars/UpdateCarPrice", in, out, opts...)
@@ -143,6 +337,60 @@ func (c *carsClient) UpdateCarPrice(ctx context.Context, in *UpdateCarPriceReq,
 	return out, nil
 }
 
+func (c *carsClient) GetCarChanges(ctx context.Context, in *GetCarChangesReq, opts ...grpc.CallOption) (*GetCarChangesRes, error) {
+	out := new(GetCarChangesRes)
+	err := c.cc.Invoke(ctx, "/carsharing.Cars/GetCarChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *carsClient) ScheduleMaintenance(ctx context.Context, in *ScheduleMaintenanceReq, opts ...grpc.CallOption) (*ScheduleMaintenanceRes, error) {
+	out := new(ScheduleMaintenanceRes)
+	err := c.cc.Invoke(ctx, "/carsharing.Cars/ScheduleMaintenance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *carsClient) CloseMaintenance(ctx context.Context, in *CloseMaintenanceReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/carsharing.Cars/CloseMaintenance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *carsClient) GetMaintenances(ctx context.Context, in *GetMaintenancesReq, opts ...grpc.CallOption) (*GetMaintenancesRes, error) {
+	out := new(GetMaintenancesRes)
+	err := c.cc.Invoke(ctx, "/carsharing.Cars/GetMaintenances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *carsClient) CreatePromoCode(ctx context.Context, in *CreatePromoCodeReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/carsharing.Cars/CreatePromoCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *carsClient) ExpirePromoCode(ctx context.Context, in *ExpirePromoCodeReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/carsharing.Cars/ExpirePromoCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CarsServer is the server API for Cars service.
 // All implementations must embed UnimplementedCarsServer
 // for forward compatibility
@@ -150,14 +398,34 @@ type CarsServer interface {
 	CreateRent(context.Context, *CreateRentReq) (*CreateRentRes, error)
 	CancelRent(context.Context, *CancelRentReq) (*emptypb.Empty, error)
 	CheckRent(context.Context, *CheckRentReq) (*CheckRentRes, error)
+	StartRent(context.Context, *StartRentReq) (*emptypb.Empty, error)
+	CompleteRent(context.Context, *CompleteRentReq) (*emptypb.Empty, error)
+	MarkNoShow(context.Context, *MarkNoShowReq) (*emptypb.Empty, error)
+	QuotePrice(context.Context, *QuotePriceReq) (*QuotePriceRes, error)
+	GetStations(context.Context, *GetStationsReq) (*GetStationsRes, error)
 	GetRentStartingOnDate(context.Context, *GetRentStartingOnDateReq) (*GetRentStartingOnDateRes, error)
 	GetAvailableCars(context.Context, *GetAvailableCarsReq) (*GetCarsRes, error)
 	GetCarsByParams(context.Context, *GetCarsByParamsReq) (*GetCarsRes, error)
+	SearchCars(context.Context, *SearchCarsReq) (*SearchCarsRes, error)
 	GetCarByUUID(context.Context, *GetCarByUUIDReq) (*Car, error)
 	GetImage(context.Context, *GetImageReq) (*GetImageRes, error)
+	GetImageStream(*GetImageStreamReq, Cars_GetImageStreamServer) error
+	CreateStation(context.Context, *CreateStationReq) (*CreateStationRes, error)
 	CreateCar(context.Context, *CreateCarReq) (*emptypb.Empty, error)
+	UploadCarImage(Cars_UploadCarImageServer) error
+	GetCarImages(context.Context, *GetCarImagesReq) (*GetCarImagesRes, error)
+	DeleteCarImage(context.Context, *DeleteCarImageReq) (*emptypb.Empty, error)
+	ReorderCarImages(context.Context, *ReorderCarImagesReq) (*emptypb.Empty, error)
+	SetMainCarImage(context.Context, *SetMainCarImageReq) (*emptypb.Empty, error)
 	DeleteCar(context.Context, *DeleteCarReq) (*emptypb.Empty, error)
+	UpdateCar(context.Context, *UpdateCarReq) (*emptypb.Empty, error)
 	UpdateCarPrice(context.Context, *UpdateCarPriceReq) (*emptypb.Empty, error)
+	GetCarChanges(context.Context, *GetCarChangesReq) (*GetCarChangesRes, error)
+	ScheduleMaintenance(context.Context, *ScheduleMaintenanceReq) (*ScheduleMaintenanceRes, error)
+	CloseMaintenance(context.Context, *CloseMaintenanceReq) (*emptypb.Empty, error)
+	GetMaintenances(context.Context, *GetMaintenancesReq) (*GetMaintenancesRes, error)
+	CreatePromoCode(context.Context, *CreatePromoCodeReq) (*emptypb.Empty, error)
+	ExpirePromoCode(context.Context, *ExpirePromoCodeReq) (*emptypb.Empty, error)
 	mustEmbedUnimplementedCarsServer()
 }
 
@@ -174,6 +442,21 @@ func (UnimplementedCarsServer) CancelRent(context.Context, *CancelRentReq) (*emp
 func (UnimplementedCarsServer) CheckRent(context.Context, *CheckRentReq) (*CheckRentRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckRent not implemented")
 }
+func (UnimplementedCarsServer) StartRent(context.Context, *StartRentReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartRent not implemented")
+}
+func (UnimplementedCarsServer) CompleteRent(context.Context, *CompleteRentReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteRent not implemented")
+}
+func (UnimplementedCarsServer) MarkNoShow(context.Context, *MarkNoShowReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkNoShow not implemented")
+}
+func (UnimplementedCarsServer) QuotePrice(context.Context, *QuotePriceReq) (*QuotePriceRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuotePrice not implemented")
+}
+func (UnimplementedCarsServer) GetStations(context.Context, *GetStationsReq) (*GetStationsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStations not implemented")
+}
 func (UnimplementedCarsServer) GetRentStartingOnDate(context.Context, *GetRentStartingOnDateReq) (*GetRentStartingOnDateRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRentStartingOnDate not implemented")
 }
@@ -183,21 +466,66 @@ func (UnimplementedCarsServer) GetAvailableCars(context.Context, *GetAvailableCa
 func (UnimplementedCarsServer) GetCarsByParams(context.Context, *GetCarsByParamsReq) (*GetCarsRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCarsByParams not implemented")
 }
+func (UnimplementedCarsServer) SearchCars(context.Context, *SearchCarsReq) (*SearchCarsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchCars not implemented")
+}
 func (UnimplementedCarsServer) GetCarByUUID(context.Context, *GetCarByUUIDReq) (*Car, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCarByUUID not implemented")
 }
 func (UnimplementedCarsServer) GetImage(context.Context, *GetImageReq) (*GetImageRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetImage not implemented")
 }
+func (UnimplementedCarsServer) GetImageStream(*GetImageStreamReq, Cars_GetImageStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetImageStream not implemented")
+}
+func (UnimplementedCarsServer) CreateStation(context.Context, *CreateStationReq) (*CreateStationRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStation not implemented")
+}
 func (UnimplementedCarsServer) CreateCar(context.Context, *CreateCarReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateCar not implemented")
 }
+func (UnimplementedCarsServer) UploadCarImage(Cars_UploadCarImageServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadCarImage not implemented")
+}
+func (UnimplementedCarsServer) GetCarImages(context.Context, *GetCarImagesReq) (*GetCarImagesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCarImages not implemented")
+}
+func (UnimplementedCarsServer) DeleteCarImage(context.Context, *DeleteCarImageReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCarImage not implemented")
+}
+func (UnimplementedCarsServer) ReorderCarImages(context.Context, *ReorderCarImagesReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderCarImages not implemented")
+}
+func (UnimplementedCarsServer) SetMainCarImage(context.Context, *SetMainCarImageReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMainCarImage not implemented")
+}
 func (UnimplementedCarsServer) DeleteCar(context.Context, *DeleteCarReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCar not implemented")
 }
+func (UnimplementedCarsServer) UpdateCar(context.Context, *UpdateCarReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCar not implemented")
+}
 func (UnimplementedCarsServer) UpdateCarPrice(context.Context, *UpdateCarPriceReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCarPrice not implemented")
 }
+func (UnimplementedCarsServer) GetCarChanges(context.Context, *GetCarChangesReq) (*GetCarChangesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCarChanges not implemented")
+}
+func (UnimplementedCarsServer) ScheduleMaintenance(context.Context, *ScheduleMaintenanceReq) (*ScheduleMaintenanceRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMaintenance not implemented")
+}
+func (UnimplementedCarsServer) CloseMaintenance(context.Context, *CloseMaintenanceReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseMaintenance not implemented")
+}
+func (UnimplementedCarsServer) GetMaintenances(context.Context, *GetMaintenancesReq) (*GetMaintenancesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMaintenances not implemented")
+}
+func (UnimplementedCarsServer) CreatePromoCode(context.Context, *CreatePromoCodeReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePromoCode not implemented")
+}
+func (UnimplementedCarsServer) ExpirePromoCode(context.Context, *ExpirePromoCodeReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpirePromoCode not implemented")
+}
 func (UnimplementedCarsServer) mustEmbedUnimplementedCarsServer() {}
 
 // UnsafeCarsServer may be embedded to opt out of forward compatibility for this service.
@@ -265,6 +593,96 @@ func _Cars_CheckRent_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cars_StartRent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartRentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarsServer).StartRent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/carsharing.Cars/StartRent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarsServer).StartRent(ctx, req.(*StartRentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cars_CompleteRent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteRentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarsServer).CompleteRent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/carsharing.Cars/CompleteRent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarsServer).CompleteRent(ctx, req.(*CompleteRentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cars_MarkNoShow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkNoShowReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarsServer).MarkNoShow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/carsharing.Cars/MarkNoShow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarsServer).MarkNoShow(ctx, req.(*MarkNoShowReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cars_QuotePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuotePriceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarsServer).QuotePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/carsharing.Cars/QuotePrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarsServer).QuotePrice(ctx, req.(*QuotePriceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cars_GetStations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarsServer).GetStations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/carsharing.Cars/GetStations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarsServer).GetStations(ctx, req.(*GetStationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Cars_GetRentStartingOnDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetRentStartingOnDateReq)
 	if err := dec(in); err != nil {
@@ -319,6 +737,24 @@ func _Cars_GetCarsByParams_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cars_SearchCars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchCarsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarsServer).SearchCars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/carsharing.Cars/SearchCars",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarsServer).SearchCars(ctx, req.(*SearchCarsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Cars_GetCarByUUID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCarByUUIDReq)
 	if err := dec(in); err != nil {
@@ -355,6 +791,45 @@ func _Cars_GetImage_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cars_GetImageStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetImageStreamReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CarsServer).GetImageStream(m, &carsGetImageStreamServer{stream})
+}
+
+type Cars_GetImageStreamServer interface {
+	Send(*ImageChunk) error
+	grpc.ServerStream
+}
+
+type carsGetImageStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *carsGetImageStreamServer) Send(m *ImageChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Cars_CreateStation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarsServer).CreateStation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/carsharing.Cars/CreateStation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarsServer).CreateStation(ctx, req.(*CreateStationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Cars_CreateCar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateCarReq)
 	if err := dec(in); err != nil {
@@ -373,6 +848,104 @@ func _Cars_CreateCar_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cars_UploadCarImage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CarsServer).UploadCarImage(&carsUploadCarImageServer{stream})
+}
+
+type Cars_UploadCarImageServer interface {
+	SendAndClose(*UploadCarImageRes) error
+	Recv() (*UploadCarImageReq, error)
+	grpc.ServerStream
+}
+
+type carsUploadCarImageServer struct {
+	grpc.ServerStream
+}
+
+func (x *carsUploadCarImageServer) SendAndClose(m *UploadCarImageRes) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *carsUploadCarImageServer) Recv() (*UploadCarImageReq, error) {
+	m := new(UploadCarImageReq)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Cars_GetCarImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCarImagesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarsServer).GetCarImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/carsharing.Cars/GetCarImages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarsServer).GetCarImages(ctx, req.(*GetCarImagesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cars_DeleteCarImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCarImageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarsServer).DeleteCarImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/carsharing.Cars/DeleteCarImage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarsServer).DeleteCarImage(ctx, req.(*DeleteCarImageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cars_ReorderCarImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderCarImagesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarsServer).ReorderCarImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/carsharing.Cars/ReorderCarImages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarsServer).ReorderCarImages(ctx, req.(*ReorderCarImagesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cars_SetMainCarImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMainCarImageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarsServer).SetMainCarImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/carsharing.Cars/SetMainCarImage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarsServer).SetMainCarImage(ctx, req.(*SetMainCarImageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Cars_DeleteCar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteCarReq)
 	if err := dec(in); err != nil {
@@ -391,6 +964,24 @@ func _Cars_DeleteCar_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cars_UpdateCar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCarReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarsServer).UpdateCar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/carsharing.Cars/UpdateCar",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarsServer).UpdateCar(ctx, req.(*UpdateCarReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Cars_UpdateCarPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateCarPriceReq)
 	if err := dec(in); err != nil {
@@ -409,6 +1000,114 @@ func _Cars_UpdateCarPrice_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cars_GetCarChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCarChangesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarsServer).GetCarChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/carsharing.Cars/GetCarChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarsServer).GetCarChanges(ctx, req.(*GetCarChangesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cars_ScheduleMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleMaintenanceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarsServer).ScheduleMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/carsharing.Cars/ScheduleMaintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarsServer).ScheduleMaintenance(ctx, req.(*ScheduleMaintenanceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cars_CloseMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseMaintenanceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarsServer).CloseMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/carsharing.Cars/CloseMaintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarsServer).CloseMaintenance(ctx, req.(*CloseMaintenanceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cars_GetMaintenances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMaintenancesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarsServer).GetMaintenances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/carsharing.Cars/GetMaintenances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarsServer).GetMaintenances(ctx, req.(*GetMaintenancesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cars_CreatePromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePromoCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarsServer).CreatePromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/carsharing.Cars/CreatePromoCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarsServer).CreatePromoCode(ctx, req.(*CreatePromoCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cars_ExpirePromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpirePromoCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarsServer).ExpirePromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/carsharing.Cars/ExpirePromoCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarsServer).ExpirePromoCode(ctx, req.(*ExpirePromoCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Cars_ServiceDesc is the grpc.ServiceDesc for Cars service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -428,6 +1127,26 @@ var Cars_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckRent",
 			Handler:    _Cars_CheckRent_Handler,
 		},
+		{
+			MethodName: "StartRent",
+			Handler:    _Cars_StartRent_Handler,
+		},
+		{
+			MethodName: "CompleteRent",
+			Handler:    _Cars_CompleteRent_Handler,
+		},
+		{
+			MethodName: "MarkNoShow",
+			Handler:    _Cars_MarkNoShow_Handler,
+		},
+		{
+			MethodName: "QuotePrice",
+			Handler:    _Cars_QuotePrice_Handler,
+		},
+		{
+			MethodName: "GetStations",
+			Handler:    _Cars_GetStations_Handler,
+		},
 		{
 			MethodName: "GetRentStartingOnDate",
 			Handler:    _Cars_GetRentStartingOnDate_Handler,
@@ -440,6 +1159,10 @@ var Cars_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCarsByParams",
 			Handler:    _Cars_GetCarsByParams_Handler,
 		},
+		{
+			MethodName: "SearchCars",
+			Handler:    _Cars_SearchCars_Handler,
+		},
 		{
 			MethodName: "GetCarByUUID",
 			Handler:    _Cars_GetCarByUUID_Handler,
@@ -448,19 +1171,78 @@ var Cars_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetImage",
 			Handler:    _Cars_GetImage_Handler,
 		},
+		{
+			MethodName: "CreateStation",
+			Handler:    _Cars_CreateStation_Handler,
+		},
 		{
 			MethodName: "CreateCar",
 			Handler:    _Cars_CreateCar_Handler,
 		},
+		{
+			MethodName: "GetCarImages",
+			Handler:    _Cars_GetCarImages_Handler,
+		},
+		{
+			MethodName: "DeleteCarImage",
+			Handler:    _Cars_DeleteCarImage_Handler,
+		},
+		{
+			MethodName: "ReorderCarImages",
+			Handler:    _Cars_ReorderCarImages_Handler,
+		},
+		{
+			MethodName: "SetMainCarImage",
+			Handler:    _Cars_SetMainCarImage_Handler,
+		},
 		{
 			MethodName: "DeleteCar",
 			Handler:    _Cars_DeleteCar_Handler,
 		},
+		{
+			MethodName: "UpdateCar",
+			Handler:    _Cars_UpdateCar_Handler,
+		},
 		{
 			MethodName: "UpdateCarPrice",
 			Handler:    _Cars_UpdateCarPrice_Handler,
 		},
+		{
+			MethodName: "GetCarChanges",
+			Handler:    _Cars_GetCarChanges_Handler,
+		},
+		{
+			MethodName: "ScheduleMaintenance",
+			Handler:    _Cars_ScheduleMaintenance_Handler,
+		},
+		{
+			MethodName: "CloseMaintenance",
+			Handler:    _Cars_CloseMaintenance_Handler,
+		},
+		{
+			MethodName: "GetMaintenances",
+			Handler:    _Cars_GetMaintenances_Handler,
+		},
+		{
+			MethodName: "CreatePromoCode",
+			Handler:    _Cars_CreatePromoCode_Handler,
+		},
+		{
+			MethodName: "ExpirePromoCode",
+			Handler:    _Cars_ExpirePromoCode_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetImageStream",
+			Handler:       _Cars_GetImageStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadCarImage",
+			Handler:       _Cars_UploadCarImage_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "carsharing/carsharing.proto",
 }
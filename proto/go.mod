@@ -3,6 +3,7 @@ module github.com/alserov/rently/proto
 go 1.21.0
 
 require (
+	github.com/golang-jwt/jwt/v4 v4.5.0
 	google.golang.org/grpc v1.60.1
 	google.golang.org/protobuf v1.32.0
 )
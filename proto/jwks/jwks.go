@@ -0,0 +1,115 @@
+// Package jwks verifies the tokens of the user service with its public keys, so the services do not call it on every request
+package jwks
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+const (
+	ALG_RS256 = "RS256"
+	ALG_EDDSA = "EdDSA"
+
+	KTY_RSA = "RSA"
+	KTY_OKP = "OKP"
+
+	CRV_ED25519 = "Ed25519"
+
+	USE_SIGNATURE = "sig"
+
+	// MIN_RSA_KEY_BITS the shorter keys are rejected
+	MIN_RSA_KEY_BITS = 2048
+)
+
+var ErrUnsupportedKey = errors.New("unsupported key")
+
+// Set is the JSON Web Key Set
+type Set struct {
+	Keys []Key `json:"keys"`
+}
+
+// Key is the public JSON Web Key
+type Key struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+}
+
+// NewKey the algorithm is chosen by the type of the key
+func NewKey(kid string, pub crypto.PublicKey) (Key, error) {
+	switch k := pub.(type) {
+	case ed25519.PublicKey:
+		return Key{
+			Kty: KTY_OKP,
+			Kid: kid,
+			Alg: ALG_EDDSA,
+			Use: USE_SIGNATURE,
+			Crv: CRV_ED25519,
+			X:   base64.RawURLEncoding.EncodeToString(k),
+		}, nil
+	case *rsa.PublicKey:
+		return Key{
+			Kty: KTY_RSA,
+			Kid: kid,
+			Alg: ALG_RS256,
+			Use: USE_SIGNATURE,
+			N:   base64.RawURLEncoding.EncodeToString(k.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(k.E)).Bytes()),
+		}, nil
+	default:
+		return Key{}, fmt.Errorf("%w: %T", ErrUnsupportedKey, pub)
+	}
+}
+
+// Algorithm returns the signing algorithm of the key
+func Algorithm(pub crypto.PublicKey) (string, error) {
+	switch pub.(type) {
+	case ed25519.PublicKey:
+		return ALG_EDDSA, nil
+	case *rsa.PublicKey:
+		return ALG_RS256, nil
+	default:
+		return "", fmt.Errorf("%w: %T", ErrUnsupportedKey, pub)
+	}
+}
+
+func (k Key) PublicKey() (crypto.PublicKey, error) {
+	switch {
+	case k.Kty == KTY_OKP && k.Crv == CRV_ED25519 && k.Alg == ALG_EDDSA:
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("%w: invalid ed25519 key %s", ErrUnsupportedKey, k.Kid)
+		}
+		return ed25519.PublicKey(x), nil
+	case k.Kty == KTY_RSA && k.Alg == ALG_RS256:
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid rsa modulus %s", ErrUnsupportedKey, k.Kid)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil || len(e) == 0 || len(e) > 4 {
+			return nil, fmt.Errorf("%w: invalid rsa exponent %s", ErrUnsupportedKey, k.Kid)
+		}
+
+		pub := &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+		if pub.N.BitLen() < MIN_RSA_KEY_BITS {
+			return nil, fmt.Errorf("%w: rsa key %s is shorter than %d bits", ErrUnsupportedKey, k.Kid, MIN_RSA_KEY_BITS)
+		}
+		return pub, nil
+	default:
+		return nil, fmt.Errorf("%w: %s %s", ErrUnsupportedKey, k.Kty, k.Alg)
+	}
+}
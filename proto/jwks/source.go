@@ -0,0 +1,55 @@
+package jwks
+
+import (
+	"context"
+	"github.com/alserov/rently/proto/gen/user"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// UserSource fetches the keys from the user service
+func UserSource(client user.UserClient) Source {
+	return func(ctx context.Context) (Set, error) {
+		res, err := client.GetJWKS(ctx, &emptypb.Empty{})
+		if err != nil {
+			return Set{}, err
+		}
+
+		return FromPb(res), nil
+	}
+}
+
+func FromPb(set *user.JWKS) Set {
+	keys := make([]Key, 0, len(set.GetKeys()))
+	for _, k := range set.GetKeys() {
+		keys = append(keys, Key{
+			Kty: k.Kty,
+			Kid: k.Kid,
+			Alg: k.Alg,
+			Use: k.Use,
+			Crv: k.Crv,
+			X:   k.X,
+			N:   k.N,
+			E:   k.E,
+		})
+	}
+
+	return Set{Keys: keys}
+}
+
+func ToPb(set Set) *user.JWKS {
+	keys := make([]*user.JWK, 0, len(set.Keys))
+	for _, k := range set.Keys {
+		keys = append(keys, &user.JWK{
+			Kty: k.Kty,
+			Kid: k.Kid,
+			Alg: k.Alg,
+			Use: k.Use,
+			Crv: k.Crv,
+			X:   k.X,
+			N:   k.N,
+			E:   k.E,
+		})
+	}
+
+	return &user.JWKS{Keys: keys}
+}
@@ -0,0 +1,161 @@
+package jwks
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+	"github.com/golang-jwt/jwt/v4"
+	"sync"
+	"time"
+)
+
+const (
+	DEFAULT_TTL = time.Hour
+	// DEFAULT_MIN_REFRESH_INTERVAL limits the refreshes, caused by the tokens with the unknown key ids
+	DEFAULT_MIN_REFRESH_INTERVAL = time.Minute
+)
+
+var (
+	ErrInvalidToken = errors.New("invalid token")
+	ErrUnknownKey   = errors.New("unknown key id")
+)
+
+// Source fetches the current key set
+type Source func(ctx context.Context) (Set, error)
+
+// Claims of the access tokens, issued by the user service
+type Claims struct {
+	UUID      string `json:"uuid"`
+	Role      string `json:"role"`
+	SessionID string `json:"sid"`
+	jwt.RegisteredClaims
+}
+
+// Verifier checks the signature and the expiry of the tokens only, the revoked sessions are checked by the user service,
+// so the token of the revoked session is valid offline till it expires.
+// The revocation relies on the short lifetime of the access tokens instead: the revoked session and the changed role,
+// the admin one too, take effect within the access token ttl of the user service, because the refresh rotation
+// checks the session and reads the role from the db, while the signed role claim is trusted till the token expires
+
+type Verifier interface {
+	Verify(ctx context.Context, token string) (Claims, error)
+}
+
+type Params struct {
+	Source Source
+	// TTL is the time, the keys are cached for
+	TTL time.Duration
+	// MinRefreshInterval is the minimal time between the fetches of the key set
+	MinRefreshInterval time.Duration
+}
+
+func NewVerifier(p Params) Verifier {
+	if p.TTL == 0 {
+		p.TTL = DEFAULT_TTL
+	}
+	if p.MinRefreshInterval == 0 {
+		p.MinRefreshInterval = DEFAULT_MIN_REFRESH_INTERVAL
+	}
+
+	return &verifier{
+		source:             p.Source,
+		ttl:                p.TTL,
+		minRefreshInterval: p.MinRefreshInterval,
+		now:                time.Now,
+	}
+}
+
+type publicKey struct {
+	alg string
+	key crypto.PublicKey
+}
+
+type verifier struct {
+	source Source
+
+	ttl                time.Duration
+	minRefreshInterval time.Duration
+
+	mu        sync.Mutex
+	keys      map[string]publicKey
+	fetchedAt time.Time
+
+	now func() time.Time
+}
+
+func (v *verifier) Verify(ctx context.Context, token string) (Claims, error) {
+	var c Claims
+
+	_, err := jwt.ParseWithClaims(token, &c, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+
+		k, err := v.key(ctx, kid)
+		if err != nil {
+			return nil, err
+		}
+
+		// the algorithm is bound to the key, so the token can not choose it
+		if t.Method.Alg() != k.alg {
+			return nil, fmt.Errorf("unexpected signing method: %s", t.Method.Alg())
+		}
+
+		return k.key, nil
+	}, jwt.WithValidMethods([]string{ALG_RS256, ALG_EDDSA}))
+	if err != nil {
+		return Claims{}, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	return c, nil
+}
+
+// key the cached keys are used, while they are fresh, the unknown key id refreshes them, because the key could be rotated
+func (v *verifier) key(ctx context.Context, kid string) (publicKey, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	now := v.now()
+	k, ok := v.keys[kid]
+	if ok && now.Sub(v.fetchedAt) < v.ttl {
+		return k, nil
+	}
+
+	if v.keys == nil || now.Sub(v.fetchedAt) >= v.minRefreshInterval {
+		if err := v.refresh(ctx, now); err != nil {
+			// the stale key is better than none, while the user service is unavailable
+			if ok {
+				return k, nil
+			}
+			return publicKey{}, err
+		}
+		k, ok = v.keys[kid]
+	}
+
+	if !ok {
+		return publicKey{}, fmt.Errorf("%w: %s", ErrUnknownKey, kid)
+	}
+
+	return k, nil
+}
+
+func (v *verifier) refresh(ctx context.Context, now time.Time) error {
+	set, err := v.source(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch keys: %w", err)
+	}
+
+	keys := make(map[string]publicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		// the keys of the other purposes and algorithms are skipped, they can not verify the tokens
+		pub, err := k.PublicKey()
+		if err != nil || (k.Use != "" && k.Use != USE_SIGNATURE) {
+			continue
+		}
+		keys[k.Kid] = publicKey{alg: k.Alg, key: pub}
+	}
+
+	v.keys = keys
+	v.fetchedAt = now
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package jwks
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"github.com/golang-jwt/jwt/v4"
+	"testing"
+	"time"
+)
+
+func TestVerifier_Keys(t *testing.T) {
+	pub1, key1 := mustGenerate(t)
+	pub2, key2 := mustGenerate(t)
+
+	set := Set{Keys: []Key{mustKey(t, "k1", pub1)}}
+	var fetches int
+	var fail bool
+
+	now := time.Now()
+	v := NewVerifier(Params{
+		Source: func(ctx context.Context) (Set, error) {
+			fetches++
+			if fail {
+				return Set{}, errors.New("unavailable")
+			}
+			return set, nil
+		},
+		TTL:                time.Hour,
+		MinRefreshInterval: time.Minute,
+	}).(*verifier)
+	v.now = func() time.Time { return now }
+
+	token1 := mustSign(t, "k1", key1)
+	token2 := mustSign(t, "k2", key2)
+
+	if _, err := v.Verify(context.Background(), token1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := v.Verify(context.Background(), token1); err != nil || fetches != 1 {
+		t.Fatalf("the keys should be cached: %v, fetches: %d", err, fetches)
+	}
+
+	// the key is rotated, but the refresh is limited
+	set.Keys = append(set.Keys, mustKey(t, "k2", pub2))
+	if _, err := v.Verify(context.Background(), token2); !errors.Is(err, ErrInvalidToken) || fetches != 1 {
+		t.Fatalf("the unknown key should not be refetched too often: %v, fetches: %d", err, fetches)
+	}
+
+	now = now.Add(time.Minute)
+	if _, err := v.Verify(context.Background(), token2); err != nil || fetches != 2 {
+		t.Fatalf("the unknown key should be refetched: %v, fetches: %d", err, fetches)
+	}
+
+	// the stale keys are used, while the source is unavailable
+	fail = true
+	now = now.Add(time.Hour * 2)
+	if _, err := v.Verify(context.Background(), token1); err != nil || fetches != 3 {
+		t.Fatalf("the stale key should be used: %v, fetches: %d", err, fetches)
+	}
+}
+
+func TestVerifier_Invalid(t *testing.T) {
+	pub, key := mustGenerate(t)
+	v := NewVerifier(Params{Source: func(ctx context.Context) (Set, error) {
+		return Set{Keys: []Key{mustKey(t, "k1", pub)}}, nil
+	}})
+
+	// the key of the token is bound to EdDSA, so the token can not be signed with the other algorithm
+	hmac := jwt.NewWithClaims(jwt.SigningMethodHS256, Claims{UUID: "uuid"})
+	hmac.Header["kid"] = "k1"
+	forged, err := hmac.SignedString([]byte(pub))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expired := jwt.NewWithClaims(jwt.SigningMethodEdDSA, Claims{UUID: "uuid", RegisteredClaims: jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Minute)),
+	}})
+	expired.Header["kid"] = "k1"
+	expiredToken, err := expired.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, token := range map[string]string{"forged": forged, "expired": expiredToken, "malformed": "token"} {
+		if _, err = v.Verify(context.Background(), token); !errors.Is(err, ErrInvalidToken) {
+			t.Fatalf("%s token should be invalid: %v", name, err)
+		}
+	}
+}
+
+func TestKey_PublicKey(t *testing.T) {
+	pub, _ := mustGenerate(t)
+
+	parsed, err := mustKey(t, "k1", pub).PublicKey()
+	if err != nil || !pub.Equal(parsed) {
+		t.Fatalf("unexpected key: %v", err)
+	}
+
+	if _, err = (Key{Kty: KTY_OKP, Alg: ALG_RS256, Crv: CRV_ED25519}).PublicKey(); !errors.Is(err, ErrUnsupportedKey) {
+		t.Fatalf("the key with the wrong algorithm should not be supported: %v", err)
+	}
+}
+
+func mustGenerate(t *testing.T) (ed25519.PublicKey, ed25519.PrivateKey) {
+	pub, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pub, key
+}
+
+func mustKey(t *testing.T, kid string, pub ed25519.PublicKey) Key {
+	k, err := NewKey(kid, pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return k
+}
+
+func mustSign(t *testing.T, kid string, key ed25519.PrivateKey) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, Claims{UUID: "uuid", RegisteredClaims: jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+	}})
+	token.Header["kid"] = kid
+
+	s, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/alserov/rently/user/internal/config"
+	"github.com/alserov/rently/user/internal/db/mysql"
+	"github.com/alserov/rently/user/internal/encryption"
+	"github.com/alserov/rently/user/internal/log"
+	"github.com/alserov/rently/user/internal/service"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// re-encrypts the personal data of the users with the active key and refreshes the blind indexes, e.g.
+// go run ./cmd/reencrypt -c ./config/local.yaml
+// the old keys have to stay in the env, till the command is finished,
+// the command is the only one, which reads the values, stored before the encryption
+func main() {
+	cfg := config.MustLoad()
+	log.MustSetup(cfg.Env)
+
+	repo := mysql.NewRepository(mysql.MustConnect(cfg.DB.GetDSN()))
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	stats, err := service.ReencryptPII(ctx, service.ReencryptParams{
+		Repo:   repo,
+		Cipher: encryption.MustNewLegacyCipher(cfg.Encryption.ActiveKeyID),
+		Index:  encryption.MustNewBlindIndex(),
+	})
+	fmt.Printf("re-encrypted %d users, failed %d\n", stats.Updated, stats.Failed)
+	if err != nil {
+		fmt.Println("re-encryption failed: " + err.Error())
+		os.Exit(1)
+	}
+	if stats.Failed > 0 {
+		os.Exit(1)
+	}
+}
@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"github.com/alserov/rently/user/internal/config"
 	"github.com/alserov/rently/user/internal/db/mysql"
+	"github.com/alserov/rently/user/internal/encryption"
 	"github.com/alserov/rently/user/internal/log"
 	"github.com/alserov/rently/user/internal/notifications"
 	"github.com/alserov/rently/user/internal/server"
 	"github.com/alserov/rently/user/internal/service"
+	"github.com/alserov/rently/user/internal/signing"
 	"github.com/alserov/rently/user/internal/utils/broker/rabbit"
 	"github.com/alserov/rently/user/internal/workers"
 	"google.golang.org/grpc"
@@ -45,9 +47,25 @@ func MustStart(cfg *config.Config) {
 
 	repo := mysql.NewRepository(mysql.MustConnect(cfg.DB.GetDSN()))
 
+	// the keys are read after the connection, which loads the .env
 	srvc := service.NewService(service.Params{
 		Repo:     repo,
 		Notifier: notifications.NewNotifier(rabbit.NewProducer(rbtCh), cfg.Broker.Rabbit.Topics),
+		Cipher:   encryption.MustNewCipher(cfg.Encryption.ActiveKeyID),
+		Index:    encryption.MustNewBlindIndex(),
+		Signer:   signing.MustNewSigner(cfg.Signing.ActiveKeyID),
+		PasswordReset: service.PasswordResetParams{
+			LinkURL:     cfg.PasswordReset.LinkURL,
+			TTL:         cfg.PasswordReset.TTL,
+			MaxRequests: cfg.PasswordReset.MaxRequests,
+			Window:      cfg.PasswordReset.Window,
+		},
+		EmailVerification: service.EmailVerificationParams{
+			LinkURL:     cfg.EmailVerification.LinkURL,
+			TTL:         cfg.EmailVerification.TTL,
+			MaxRequests: cfg.EmailVerification.MaxRequests,
+			Window:      cfg.EmailVerification.Window,
+		},
 	})
 
 	gRPCServer := grpc.NewServer()
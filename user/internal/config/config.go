@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"gopkg.in/yaml.v3"
 	"os"
+	"time"
 )
 
 type Config struct {
@@ -15,6 +16,42 @@ type Config struct {
 	DB Mysql `yaml:"db"`
 
 	Broker Broker `yaml:"broker"`
+
+	Encryption Encryption `yaml:"encryption"`
+	Signing    Signing    `yaml:"signing"`
+
+	PasswordReset     PasswordReset     `yaml:"passwordReset"`
+	EmailVerification EmailVerification `yaml:"emailVerification"`
+}
+
+type EmailVerification struct {
+	// LinkURL is the page, the verification code is added to as the code query param
+	LinkURL string        `yaml:"linkUrl"`
+	TTL     time.Duration `yaml:"ttl"`
+	// MaxRequests is the limit of the verification emails to one address in the Window
+	MaxRequests int           `yaml:"maxRequests"`
+	Window      time.Duration `yaml:"window"`
+}
+
+type PasswordReset struct {
+	// LinkURL is the page, the reset code is added to as the code query param
+	LinkURL string        `yaml:"linkUrl"`
+	TTL     time.Duration `yaml:"ttl"`
+	// MaxRequests is the limit of the reset emails to one address in the Window
+	MaxRequests int           `yaml:"maxRequests"`
+	Window      time.Duration `yaml:"window"`
+}
+
+// Encryption the keys are read from the env, only their ids are configured
+type Encryption struct {
+	// ActiveKeyID is the id of the key-encryption key, the new values are encrypted with
+	ActiveKeyID string `yaml:"activeKeyId"`
+}
+
+// Signing the keys of the tokens are read from the env, the previous keys are kept there till their tokens expire
+type Signing struct {
+	// ActiveKeyID is the id of the key, the new tokens are signed with
+	ActiveKeyID string `yaml:"activeKeyId"`
 }
 
 type Mysql struct {
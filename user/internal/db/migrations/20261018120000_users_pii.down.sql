@@ -0,0 +1,6 @@
+-- the columns stay TEXT, the encrypted values do not fit the old sizes
+ALTER TABLE users
+    DROP INDEX idx_users_phone_number_idx,
+    DROP INDEX idx_users_passport_number_idx,
+    DROP COLUMN phone_number_idx,
+    DROP COLUMN passport_number_idx;
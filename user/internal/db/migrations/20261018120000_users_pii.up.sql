@@ -0,0 +1,9 @@
+-- the encrypted values are longer than the plain ones
+ALTER TABLE users
+    MODIFY passport_number TEXT NOT NULL,
+    MODIFY payment_source TEXT NOT NULL,
+    MODIFY phone_number TEXT NOT NULL,
+    ADD COLUMN passport_number_idx VARCHAR(64) NOT NULL DEFAULT '',
+    ADD COLUMN phone_number_idx VARCHAR(64) NOT NULL DEFAULT '',
+    ADD INDEX idx_users_passport_number_idx (passport_number_idx),
+    ADD INDEX idx_users_phone_number_idx (phone_number_idx);
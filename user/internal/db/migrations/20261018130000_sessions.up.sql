@@ -0,0 +1,17 @@
+-- the refresh tokens are stored hashed, the access tokens of the revoked sessions are rejected
+CREATE TABLE IF NOT EXISTS sessions
+(
+    id                  VARCHAR(40)  NOT NULL PRIMARY KEY,
+    user_uuid           VARCHAR(40)  NOT NULL,
+    refresh_token_hash  CHAR(64)     NOT NULL,
+    previous_token_hash CHAR(64)     NOT NULL DEFAULT '',
+    user_agent          VARCHAR(512) NOT NULL DEFAULT '',
+    ip                  VARCHAR(45)  NOT NULL DEFAULT '',
+    created_at          DATETIME     NOT NULL,
+    last_used_at        DATETIME     NOT NULL,
+    expires_at          DATETIME     NOT NULL,
+    revoked_at          DATETIME     NULL,
+    UNIQUE INDEX idx_sessions_refresh_token_hash (refresh_token_hash),
+    INDEX idx_sessions_previous_token_hash (previous_token_hash),
+    INDEX idx_sessions_user_uuid (user_uuid)
+);
@@ -0,0 +1,10 @@
+-- the reset codes are single-use, so the used ones are kept to count the requests of the user
+CREATE TABLE IF NOT EXISTS password_resets
+(
+    code_hash  CHAR(64)    NOT NULL PRIMARY KEY,
+    user_uuid  VARCHAR(40) NOT NULL,
+    created_at DATETIME    NOT NULL,
+    expires_at DATETIME    NOT NULL,
+    used_at    DATETIME    NULL,
+    INDEX idx_password_resets_user_uuid_created_at (user_uuid, created_at)
+);
@@ -0,0 +1,14 @@
+-- the users, registered before the verification, are considered verified, so they are not locked out of the rents
+ALTER TABLE users
+    ADD COLUMN email_verified_at DATETIME NULL;
+UPDATE users SET email_verified_at = UTC_TIMESTAMP();
+
+CREATE TABLE IF NOT EXISTS email_verifications
+(
+    code_hash  CHAR(64)    NOT NULL PRIMARY KEY,
+    user_uuid  VARCHAR(40) NOT NULL,
+    created_at DATETIME    NOT NULL,
+    expires_at DATETIME    NOT NULL,
+    used_at    DATETIME    NULL,
+    INDEX idx_email_verifications_user_uuid (user_uuid)
+);
@@ -0,0 +1,4 @@
+-- the passport can not be registered twice even by the concurrent registrations,
+-- the users, registered before the encryption, have the empty index till cmd/reencrypt is run, so they are not included
+ALTER TABLE users
+    ADD UNIQUE INDEX idx_users_passport_number_idx_unique ((NULLIF(passport_number_idx, '')));
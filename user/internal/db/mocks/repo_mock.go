@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: internal/db/repository.go
+// Source: repository.go
 
 // Package repomock is a generated GoMock package.
 package repomock
@@ -7,6 +7,7 @@ package repomock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	db "github.com/alserov/rently/user/internal/db"
 	models "github.com/alserov/rently/user/internal/models"
@@ -50,6 +51,92 @@ func (mr *MockRepositoryMockRecorder) CheckIfAuthorized(ctx, uuid, role interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIfAuthorized", reflect.TypeOf((*MockRepository)(nil).CheckIfAuthorized), ctx, uuid, role)
 }
 
+// ConfirmPasswordReset mocks base method.
+func (m *MockRepository) ConfirmPasswordReset(ctx context.Context, codeHash, password string, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmPasswordReset", ctx, codeHash, password, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfirmPasswordReset indicates an expected call of ConfirmPasswordReset.
+func (mr *MockRepositoryMockRecorder) ConfirmPasswordReset(ctx, codeHash, password, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmPasswordReset", reflect.TypeOf((*MockRepository)(nil).ConfirmPasswordReset), ctx, codeHash, password, now)
+}
+
+// CountEmailVerifications mocks base method.
+func (m *MockRepository) CountEmailVerifications(ctx context.Context, userUUID string, since time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountEmailVerifications", ctx, userUUID, since)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountEmailVerifications indicates an expected call of CountEmailVerifications.
+func (mr *MockRepositoryMockRecorder) CountEmailVerifications(ctx, userUUID, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountEmailVerifications", reflect.TypeOf((*MockRepository)(nil).CountEmailVerifications), ctx, userUUID, since)
+}
+
+// CountPasswordResets mocks base method.
+func (m *MockRepository) CountPasswordResets(ctx context.Context, userUUID string, since time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPasswordResets", ctx, userUUID, since)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountPasswordResets indicates an expected call of CountPasswordResets.
+func (mr *MockRepositoryMockRecorder) CountPasswordResets(ctx, userUUID, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPasswordResets", reflect.TypeOf((*MockRepository)(nil).CountPasswordResets), ctx, userUUID, since)
+}
+
+// CreateEmailVerification mocks base method.
+func (m *MockRepository) CreateEmailVerification(ctx context.Context, v models.EmailVerification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEmailVerification", ctx, v)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateEmailVerification indicates an expected call of CreateEmailVerification.
+func (mr *MockRepositoryMockRecorder) CreateEmailVerification(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEmailVerification", reflect.TypeOf((*MockRepository)(nil).CreateEmailVerification), ctx, v)
+}
+
+// CreatePasswordReset mocks base method.
+func (m *MockRepository) CreatePasswordReset(ctx context.Context, reset models.PasswordReset) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePasswordReset", ctx, reset)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePasswordReset indicates an expected call of CreatePasswordReset.
+func (mr *MockRepositoryMockRecorder) CreatePasswordReset(ctx, reset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePasswordReset", reflect.TypeOf((*MockRepository)(nil).CreatePasswordReset), ctx, reset)
+}
+
+// CreateSession mocks base method.
+func (m *MockRepository) CreateSession(ctx context.Context, s models.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", ctx, s)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockRepositoryMockRecorder) CreateSession(ctx, s interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockRepository)(nil).CreateSession), ctx, s)
+}
+
 // GetInfoForRent mocks base method.
 func (m *MockRepository) GetInfoForRent(ctx context.Context, uuid string) (models.InfoForRentRes, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +167,36 @@ func (mr *MockRepositoryMockRecorder) GetPassword(ctx, uuid interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPassword", reflect.TypeOf((*MockRepository)(nil).GetPassword), ctx, uuid)
 }
 
+// GetSessionByRefreshToken mocks base method.
+func (m *MockRepository) GetSessionByRefreshToken(ctx context.Context, hash string) (models.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionByRefreshToken", ctx, hash)
+	ret0, _ := ret[0].(models.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionByRefreshToken indicates an expected call of GetSessionByRefreshToken.
+func (mr *MockRepositoryMockRecorder) GetSessionByRefreshToken(ctx, hash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionByRefreshToken", reflect.TypeOf((*MockRepository)(nil).GetSessionByRefreshToken), ctx, hash)
+}
+
+// GetSessions mocks base method.
+func (m *MockRepository) GetSessions(ctx context.Context, userUUID string, now time.Time) ([]models.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessions", ctx, userUUID, now)
+	ret0, _ := ret[0].([]models.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessions indicates an expected call of GetSessions.
+func (mr *MockRepositoryMockRecorder) GetSessions(ctx, userUUID, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessions", reflect.TypeOf((*MockRepository)(nil).GetSessions), ctx, userUUID, now)
+}
+
 // GetUserByUUID mocks base method.
 func (m *MockRepository) GetUserByUUID(ctx context.Context, uuids string) (db.EmailNotificationsInfo, error) {
 	m.ctrl.T.Helper()
@@ -110,6 +227,51 @@ func (mr *MockRepositoryMockRecorder) GetUserInfo(ctx, uuid interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserInfo", reflect.TypeOf((*MockRepository)(nil).GetUserInfo), ctx, uuid)
 }
 
+// GetUsersPII mocks base method.
+func (m *MockRepository) GetUsersPII(ctx context.Context, afterUUID string, limit int) ([]models.UserPII, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersPII", ctx, afterUUID, limit)
+	ret0, _ := ret[0].([]models.UserPII)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsersPII indicates an expected call of GetUsersPII.
+func (mr *MockRepositoryMockRecorder) GetUsersPII(ctx, afterUUID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersPII", reflect.TypeOf((*MockRepository)(nil).GetUsersPII), ctx, afterUUID, limit)
+}
+
+// IsPassportRegistered mocks base method.
+func (m *MockRepository) IsPassportRegistered(ctx context.Context, passportNumberIndex string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsPassportRegistered", ctx, passportNumberIndex)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsPassportRegistered indicates an expected call of IsPassportRegistered.
+func (mr *MockRepositoryMockRecorder) IsPassportRegistered(ctx, passportNumberIndex interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPassportRegistered", reflect.TypeOf((*MockRepository)(nil).IsPassportRegistered), ctx, passportNumberIndex)
+}
+
+// IsSessionRevoked mocks base method.
+func (m *MockRepository) IsSessionRevoked(ctx context.Context, id string, now time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSessionRevoked", ctx, id, now)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsSessionRevoked indicates an expected call of IsSessionRevoked.
+func (mr *MockRepositoryMockRecorder) IsSessionRevoked(ctx, id, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSessionRevoked", reflect.TypeOf((*MockRepository)(nil).IsSessionRevoked), ctx, id, now)
+}
+
 // Login mocks base method.
 func (m *MockRepository) Login(ctx context.Context, email string) (db.LoginInfo, error) {
 	m.ctrl.T.Helper()
@@ -153,6 +315,34 @@ func (mr *MockRepositoryMockRecorder) ResetPassword(ctx, uuid, password interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockRepository)(nil).ResetPassword), ctx, uuid, password)
 }
 
+// RevokeSession mocks base method.
+func (m *MockRepository) RevokeSession(ctx context.Context, userUUID, id string, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", ctx, userUUID, id, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockRepositoryMockRecorder) RevokeSession(ctx, userUUID, id, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockRepository)(nil).RevokeSession), ctx, userUUID, id, at)
+}
+
+// RotateSession mocks base method.
+func (m *MockRepository) RotateSession(ctx context.Context, id, oldHash, newHash string, usedAt, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateSession", ctx, id, oldHash, newHash, usedAt, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RotateSession indicates an expected call of RotateSession.
+func (mr *MockRepositoryMockRecorder) RotateSession(ctx, id, oldHash, newHash, usedAt, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateSession", reflect.TypeOf((*MockRepository)(nil).RotateSession), ctx, id, oldHash, newHash, usedAt, expiresAt)
+}
+
 // SwitchNotificationsStatus mocks base method.
 func (m *MockRepository) SwitchNotificationsStatus(ctx context.Context, uuid string) error {
 	m.ctrl.T.Helper()
@@ -166,3 +356,273 @@ func (mr *MockRepositoryMockRecorder) SwitchNotificationsStatus(ctx, uuid interf
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SwitchNotificationsStatus", reflect.TypeOf((*MockRepository)(nil).SwitchNotificationsStatus), ctx, uuid)
 }
+
+// UpdateUserPII mocks base method.
+func (m *MockRepository) UpdateUserPII(ctx context.Context, pii models.UserPII) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserPII", ctx, pii)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserPII indicates an expected call of UpdateUserPII.
+func (mr *MockRepositoryMockRecorder) UpdateUserPII(ctx, pii interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserPII", reflect.TypeOf((*MockRepository)(nil).UpdateUserPII), ctx, pii)
+}
+
+// VerifyEmail mocks base method.
+func (m *MockRepository) VerifyEmail(ctx context.Context, codeHash string, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmail", ctx, codeHash, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockRepositoryMockRecorder) VerifyEmail(ctx, codeHash, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockRepository)(nil).VerifyEmail), ctx, codeHash, now)
+}
+
+// MockEmailVerificationRepository is a mock of EmailVerificationRepository interface.
+type MockEmailVerificationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmailVerificationRepositoryMockRecorder
+}
+
+// MockEmailVerificationRepositoryMockRecorder is the mock recorder for MockEmailVerificationRepository.
+type MockEmailVerificationRepositoryMockRecorder struct {
+	mock *MockEmailVerificationRepository
+}
+
+// NewMockEmailVerificationRepository creates a new mock instance.
+func NewMockEmailVerificationRepository(ctrl *gomock.Controller) *MockEmailVerificationRepository {
+	mock := &MockEmailVerificationRepository{ctrl: ctrl}
+	mock.recorder = &MockEmailVerificationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmailVerificationRepository) EXPECT() *MockEmailVerificationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountEmailVerifications mocks base method.
+func (m *MockEmailVerificationRepository) CountEmailVerifications(ctx context.Context, userUUID string, since time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountEmailVerifications", ctx, userUUID, since)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountEmailVerifications indicates an expected call of CountEmailVerifications.
+func (mr *MockEmailVerificationRepositoryMockRecorder) CountEmailVerifications(ctx, userUUID, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountEmailVerifications", reflect.TypeOf((*MockEmailVerificationRepository)(nil).CountEmailVerifications), ctx, userUUID, since)
+}
+
+// CreateEmailVerification mocks base method.
+func (m *MockEmailVerificationRepository) CreateEmailVerification(ctx context.Context, v models.EmailVerification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEmailVerification", ctx, v)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateEmailVerification indicates an expected call of CreateEmailVerification.
+func (mr *MockEmailVerificationRepositoryMockRecorder) CreateEmailVerification(ctx, v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEmailVerification", reflect.TypeOf((*MockEmailVerificationRepository)(nil).CreateEmailVerification), ctx, v)
+}
+
+// VerifyEmail mocks base method.
+func (m *MockEmailVerificationRepository) VerifyEmail(ctx context.Context, codeHash string, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmail", ctx, codeHash, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockEmailVerificationRepositoryMockRecorder) VerifyEmail(ctx, codeHash, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockEmailVerificationRepository)(nil).VerifyEmail), ctx, codeHash, now)
+}
+
+// MockPasswordResetRepository is a mock of PasswordResetRepository interface.
+type MockPasswordResetRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordResetRepositoryMockRecorder
+}
+
+// MockPasswordResetRepositoryMockRecorder is the mock recorder for MockPasswordResetRepository.
+type MockPasswordResetRepositoryMockRecorder struct {
+	mock *MockPasswordResetRepository
+}
+
+// NewMockPasswordResetRepository creates a new mock instance.
+func NewMockPasswordResetRepository(ctrl *gomock.Controller) *MockPasswordResetRepository {
+	mock := &MockPasswordResetRepository{ctrl: ctrl}
+	mock.recorder = &MockPasswordResetRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordResetRepository) EXPECT() *MockPasswordResetRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ConfirmPasswordReset mocks base method.
+func (m *MockPasswordResetRepository) ConfirmPasswordReset(ctx context.Context, codeHash, password string, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmPasswordReset", ctx, codeHash, password, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfirmPasswordReset indicates an expected call of ConfirmPasswordReset.
+func (mr *MockPasswordResetRepositoryMockRecorder) ConfirmPasswordReset(ctx, codeHash, password, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmPasswordReset", reflect.TypeOf((*MockPasswordResetRepository)(nil).ConfirmPasswordReset), ctx, codeHash, password, now)
+}
+
+// CountPasswordResets mocks base method.
+func (m *MockPasswordResetRepository) CountPasswordResets(ctx context.Context, userUUID string, since time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPasswordResets", ctx, userUUID, since)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountPasswordResets indicates an expected call of CountPasswordResets.
+func (mr *MockPasswordResetRepositoryMockRecorder) CountPasswordResets(ctx, userUUID, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPasswordResets", reflect.TypeOf((*MockPasswordResetRepository)(nil).CountPasswordResets), ctx, userUUID, since)
+}
+
+// CreatePasswordReset mocks base method.
+func (m *MockPasswordResetRepository) CreatePasswordReset(ctx context.Context, reset models.PasswordReset) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePasswordReset", ctx, reset)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePasswordReset indicates an expected call of CreatePasswordReset.
+func (mr *MockPasswordResetRepositoryMockRecorder) CreatePasswordReset(ctx, reset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePasswordReset", reflect.TypeOf((*MockPasswordResetRepository)(nil).CreatePasswordReset), ctx, reset)
+}
+
+// MockSessionRepository is a mock of SessionRepository interface.
+type MockSessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionRepositoryMockRecorder
+}
+
+// MockSessionRepositoryMockRecorder is the mock recorder for MockSessionRepository.
+type MockSessionRepositoryMockRecorder struct {
+	mock *MockSessionRepository
+}
+
+// NewMockSessionRepository creates a new mock instance.
+func NewMockSessionRepository(ctrl *gomock.Controller) *MockSessionRepository {
+	mock := &MockSessionRepository{ctrl: ctrl}
+	mock.recorder = &MockSessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSessionRepository) EXPECT() *MockSessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateSession mocks base method.
+func (m *MockSessionRepository) CreateSession(ctx context.Context, s models.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", ctx, s)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockSessionRepositoryMockRecorder) CreateSession(ctx, s interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockSessionRepository)(nil).CreateSession), ctx, s)
+}
+
+// GetSessionByRefreshToken mocks base method.
+func (m *MockSessionRepository) GetSessionByRefreshToken(ctx context.Context, hash string) (models.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionByRefreshToken", ctx, hash)
+	ret0, _ := ret[0].(models.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionByRefreshToken indicates an expected call of GetSessionByRefreshToken.
+func (mr *MockSessionRepositoryMockRecorder) GetSessionByRefreshToken(ctx, hash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionByRefreshToken", reflect.TypeOf((*MockSessionRepository)(nil).GetSessionByRefreshToken), ctx, hash)
+}
+
+// GetSessions mocks base method.
+func (m *MockSessionRepository) GetSessions(ctx context.Context, userUUID string, now time.Time) ([]models.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessions", ctx, userUUID, now)
+	ret0, _ := ret[0].([]models.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessions indicates an expected call of GetSessions.
+func (mr *MockSessionRepositoryMockRecorder) GetSessions(ctx, userUUID, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessions", reflect.TypeOf((*MockSessionRepository)(nil).GetSessions), ctx, userUUID, now)
+}
+
+// IsSessionRevoked mocks base method.
+func (m *MockSessionRepository) IsSessionRevoked(ctx context.Context, id string, now time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSessionRevoked", ctx, id, now)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsSessionRevoked indicates an expected call of IsSessionRevoked.
+func (mr *MockSessionRepositoryMockRecorder) IsSessionRevoked(ctx, id, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSessionRevoked", reflect.TypeOf((*MockSessionRepository)(nil).IsSessionRevoked), ctx, id, now)
+}
+
+// RevokeSession mocks base method.
+func (m *MockSessionRepository) RevokeSession(ctx context.Context, userUUID, id string, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", ctx, userUUID, id, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockSessionRepositoryMockRecorder) RevokeSession(ctx, userUUID, id, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockSessionRepository)(nil).RevokeSession), ctx, userUUID, id, at)
+}
+
+// RotateSession mocks base method.
+func (m *MockSessionRepository) RotateSession(ctx context.Context, id, oldHash, newHash string, usedAt, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateSession", ctx, id, oldHash, newHash, usedAt, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RotateSession indicates an expected call of RotateSession.
+func (mr *MockSessionRepositoryMockRecorder) RotateSession(ctx, id, oldHash, newHash, usedAt, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateSession", reflect.TypeOf((*MockSessionRepository)(nil).RotateSession), ctx, id, oldHash, newHash, usedAt, expiresAt)
+}
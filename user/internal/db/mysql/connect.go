@@ -2,6 +2,7 @@ package mysql
 
 import (
 	"context"
+	"errors"
 	"github.com/alserov/rently/user/internal/db/migrations"
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/google/uuid"
@@ -13,7 +14,7 @@ import (
 )
 
 func MustConnect(dsn string) *sqlx.DB {
-	conn, err := sqlx.Open("mysql", dsn+"?multiStatements=true")
+	conn, err := sqlx.Open("mysql", dsn+"?multiStatements=true&parseTime=true")
 	if err != nil {
 		panic("failed to open db: " + err.Error())
 	}
@@ -33,8 +34,9 @@ func MustConnect(dsn string) *sqlx.DB {
 }
 
 func initAdmin(conn *sqlx.DB) {
-	if err := godotenv.Load(".env"); err != nil {
-		panic("failed to find .env file: " + err.Error())
+	// the .env is optional, the deployment passes the variables and the keys with the environment
+	if err := godotenv.Load(".env"); err != nil && !errors.Is(err, os.ErrNotExist) {
+		panic("failed to load .env file: " + err.Error())
 	}
 
 	adminPassword := os.Getenv("ADMIN_PASSWORD")
@@ -50,7 +52,8 @@ func initAdmin(conn *sqlx.DB) {
 		panic("failed to hash password")
 	}
 
-	if err = conn.QueryRowx(query, uuid.New().String(), "ADMIN", string(b), adminEmail, "admin", "0", "0", "0").Err(); err != nil {
+	// the admin has no personal data, so nothing is stored unencrypted
+	if err = conn.QueryRowx(query, uuid.New().String(), "ADMIN", string(b), adminEmail, "admin", "", "", "").Err(); err != nil {
 		panic("failed to init admin: " + err.Error())
 	}
 }
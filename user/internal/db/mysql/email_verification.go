@@ -0,0 +1,98 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/alserov/rently/user/internal/models"
+	"net/http"
+	"time"
+)
+
+const ERR_INVALID_VERIFICATION_CODE = "invalid or expired verification code"
+
+func (r repository) CreateEmailVerification(_ context.Context, v models.EmailVerification) error {
+	query := `INSERT INTO email_verifications (code_hash,user_uuid,created_at,expires_at) VALUES (?,?,?,?)`
+
+	_, err := r.db.Exec(query, v.CodeHash, v.UserUUID, v.CreatedAt, v.ExpiresAt)
+	if err != nil {
+		return &models.Error{
+			Msg:    fmt.Sprintf("failed to create email verification: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return nil
+}
+
+func (r repository) CountEmailVerifications(_ context.Context, userUUID string, since time.Time) (int, error) {
+	query := `SELECT count(*) FROM email_verifications WHERE user_uuid = ? AND created_at >= ?`
+
+	var count int
+	if err := r.db.QueryRowx(query, userUUID, since).Scan(&count); err != nil {
+		return 0, &models.Error{
+			Msg:    fmt.Sprintf("failed to count email verifications: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return count, nil
+}
+
+func (r repository) VerifyEmail(ctx context.Context, codeHash string, now time.Time) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return &models.Error{
+			Msg:    fmt.Sprintf("failed to start tx: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var v models.EmailVerification
+	err = tx.QueryRowx(`SELECT code_hash, user_uuid, created_at, expires_at, used_at FROM email_verifications WHERE code_hash = ? FOR UPDATE`, codeHash).
+		StructScan(&v)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &models.Error{
+			Msg:    ERR_INVALID_VERIFICATION_CODE,
+			Status: http.StatusBadRequest,
+		}
+	}
+	if err != nil {
+		return &models.Error{
+			Msg:    fmt.Sprintf("failed to get email verification: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+	if v.UsedAt != nil || !now.Before(v.ExpiresAt) {
+		return &models.Error{
+			Msg:    ERR_INVALID_VERIFICATION_CODE,
+			Status: http.StatusBadRequest,
+		}
+	}
+
+	if _, err = tx.Exec(`UPDATE email_verifications SET used_at = ? WHERE user_uuid = ? AND used_at IS NULL`, now, v.UserUUID); err != nil {
+		return &models.Error{
+			Msg:    fmt.Sprintf("failed to use email verification: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	// the verification time of the already verified email is kept
+	if _, err = tx.Exec(`UPDATE users SET email_verified_at = COALESCE(email_verified_at, ?) WHERE uuid = ?`, now, v.UserUUID); err != nil {
+		return &models.Error{
+			Msg:    fmt.Sprintf("failed to verify email by uuid: %v: %v", v.UserUUID, err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return &models.Error{
+			Msg:    fmt.Sprintf("failed to commit tx: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/alserov/rently/user/internal/models"
+	"net/http"
+	"time"
+)
+
+const ERR_INVALID_RESET_CODE = "invalid or expired reset code"
+
+func (r repository) CreatePasswordReset(_ context.Context, reset models.PasswordReset) error {
+	query := `INSERT INTO password_resets (code_hash,user_uuid,created_at,expires_at) VALUES (?,?,?,?)`
+
+	_, err := r.db.Exec(query, reset.CodeHash, reset.UserUUID, reset.CreatedAt, reset.ExpiresAt)
+	if err != nil {
+		return &models.Error{
+			Msg:    fmt.Sprintf("failed to create password reset: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return nil
+}
+
+func (r repository) CountPasswordResets(_ context.Context, userUUID string, since time.Time) (int, error) {
+	query := `SELECT count(*) FROM password_resets WHERE user_uuid = ? AND created_at >= ?`
+
+	var count int
+	if err := r.db.QueryRowx(query, userUUID, since).Scan(&count); err != nil {
+		return 0, &models.Error{
+			Msg:    fmt.Sprintf("failed to count password resets: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return count, nil
+}
+
+func (r repository) ConfirmPasswordReset(ctx context.Context, codeHash string, password string, now time.Time) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return &models.Error{
+			Msg:    fmt.Sprintf("failed to start tx: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// the lock makes the concurrent confirmation with the same code wait and see it used
+	var reset models.PasswordReset
+	err = tx.QueryRowx(`SELECT code_hash, user_uuid, created_at, expires_at, used_at FROM password_resets WHERE code_hash = ? FOR UPDATE`, codeHash).
+		StructScan(&reset)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &models.Error{
+			Msg:    ERR_INVALID_RESET_CODE,
+			Status: http.StatusBadRequest,
+		}
+	}
+	if err != nil {
+		return &models.Error{
+			Msg:    fmt.Sprintf("failed to get password reset: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+	if reset.UsedAt != nil || !now.Before(reset.ExpiresAt) {
+		return &models.Error{
+			Msg:    ERR_INVALID_RESET_CODE,
+			Status: http.StatusBadRequest,
+		}
+	}
+
+	// the other codes of the user are used too, so the older emails can not reset the new password
+	if _, err = tx.Exec(`UPDATE password_resets SET used_at = ? WHERE user_uuid = ? AND used_at IS NULL`, now, reset.UserUUID); err != nil {
+		return &models.Error{
+			Msg:    fmt.Sprintf("failed to use password reset: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	if _, err = tx.Exec(`UPDATE users SET password = ? WHERE uuid = ?`, password, reset.UserUUID); err != nil {
+		return &models.Error{
+			Msg:    fmt.Sprintf("failed to reset password by uuid: %v: %v", reset.UserUUID, err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	if _, err = tx.Exec(`UPDATE sessions SET revoked_at = ? WHERE user_uuid = ? AND revoked_at IS NULL`, now, reset.UserUUID); err != nil {
+		return &models.Error{
+			Msg:    fmt.Sprintf("failed to revoke sessions: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return &models.Error{
+			Msg:    fmt.Sprintf("failed to commit tx: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return nil
+}
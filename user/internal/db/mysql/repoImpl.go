@@ -7,10 +7,14 @@ import (
 	"fmt"
 	"github.com/alserov/rently/user/internal/db"
 	"github.com/alserov/rently/user/internal/models"
+	driver "github.com/go-sql-driver/mysql"
 	"github.com/jmoiron/sqlx"
 	"net/http"
 )
 
+// ER_DUP_ENTRY is the mysql error number of the unique index violation
+const ER_DUP_ENTRY = 1062
+
 func NewRepository(db *sqlx.DB) db.Repository {
 	return &repository{
 		db: db,
@@ -83,7 +87,7 @@ func (r repository) CheckIfAuthorized(ctx context.Context, uuid string, role str
 }
 
 func (r repository) Login(_ context.Context, email string) (db.LoginInfo, error) {
-	query := `SELECT uuid,email,password,role FROM users WHERE email = ?  LIMIT 1`
+	query := `SELECT uuid,email,password,role,email_verified_at IS NOT NULL AS email_verified FROM users WHERE email = ?  LIMIT 1`
 
 	var info db.LoginInfo
 	err := r.db.QueryRowx(query, email).StructScan(&info)
@@ -125,7 +129,7 @@ func (r repository) GetUserInfo(_ context.Context, uuid string) (models.UserInfo
 }
 
 func (r repository) GetInfoForRent(_ context.Context, uuid string) (models.InfoForRentRes, error) {
-	query := `SELECT passport_number,phone_number FROM users WHERE uuid = ? LIMIT 1`
+	query := `SELECT passport_number,phone_number,email,email_verified_at IS NOT NULL AS email_verified FROM users WHERE uuid = ? LIMIT 1`
 
 	var info models.InfoForRentRes
 	err := r.db.QueryRowx(query, uuid).StructScan(&info)
@@ -189,10 +193,19 @@ func (r repository) GetUserByUUID(_ context.Context, uuid string) (db.EmailNotif
 const ROLE_USER = "user"
 
 func (r repository) Register(_ context.Context, req models.RegisterReq) error {
-	query := `INSERT INTO users (uuid,username,password,email,role ,passport_number,payment_source,phone_number)
-				VALUES (?,?,?,?,?,?,?, ?)`
+	query := `INSERT INTO users (uuid,username,password,email,role ,passport_number,payment_source,phone_number,passport_number_idx,phone_number_idx)
+				VALUES (?,?,?,?,?,?,?,?,?,?)`
 
-	err := r.db.QueryRowx(query, req.UUID, req.Username, req.Password, req.Email, ROLE_USER, req.PassportNumber, req.PaymentSource, req.PhoneNumber).Err()
+	err := r.db.QueryRowx(query, req.UUID, req.Username, req.Password, req.Email, ROLE_USER, req.PassportNumber, req.PaymentSource, req.PhoneNumber,
+		req.PassportNumberIndex, req.PhoneNumberIndex).Err()
+	// the passport is checked before, but the concurrent registration can take it in between
+	var mysqlErr *driver.MySQLError
+	if errors.As(err, &mysqlErr) && mysqlErr.Number == ER_DUP_ENTRY {
+		return &models.Error{
+			Msg:    db.ERR_PASSPORT_REGISTERED,
+			Status: http.StatusConflict,
+		}
+	}
 	if err != nil {
 		return &models.Error{
 			Msg:    fmt.Sprintf("failed to query raw register: %v", err),
@@ -202,3 +215,47 @@ func (r repository) Register(_ context.Context, req models.RegisterReq) error {
 
 	return nil
 }
+
+func (r repository) IsPassportRegistered(_ context.Context, passportNumberIndex string) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM users WHERE passport_number_idx = ?)`
+
+	var exists bool
+	if err := r.db.QueryRowx(query, passportNumberIndex).Scan(&exists); err != nil {
+		return false, &models.Error{
+			Msg:    fmt.Sprintf("failed to check passport number: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return exists, nil
+}
+
+func (r repository) GetUsersPII(_ context.Context, afterUUID string, limit int) ([]models.UserPII, error) {
+	query := `SELECT uuid, passport_number, payment_source, phone_number, passport_number_idx, phone_number_idx
+				FROM users WHERE uuid > ? ORDER BY uuid LIMIT ?`
+
+	var users []models.UserPII
+	if err := r.db.Select(&users, query, afterUUID, limit); err != nil {
+		return nil, &models.Error{
+			Msg:    fmt.Sprintf("failed to get users pii: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return users, nil
+}
+
+func (r repository) UpdateUserPII(_ context.Context, pii models.UserPII) error {
+	query := `UPDATE users SET passport_number = ?, payment_source = ?, phone_number = ?, passport_number_idx = ?, phone_number_idx = ?
+				WHERE uuid = ?`
+
+	_, err := r.db.Exec(query, pii.PassportNumber, pii.PaymentSource, pii.PhoneNumber, pii.PassportNumberIndex, pii.PhoneNumberIndex, pii.UUID)
+	if err != nil {
+		return &models.Error{
+			Msg:    fmt.Sprintf("failed to update user pii: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return nil
+}
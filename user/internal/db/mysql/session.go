@@ -0,0 +1,129 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/alserov/rently/user/internal/models"
+	"net/http"
+	"time"
+)
+
+const (
+	ERR_SESSION_NOT_FOUND       = "session not found"
+	ERR_REFRESH_TOKEN_NOT_FOUND = "invalid refresh token"
+	ERR_REFRESH_TOKEN_USED      = "refresh token is already used"
+)
+
+func (r repository) CreateSession(_ context.Context, s models.Session) error {
+	query := `INSERT INTO sessions (id,user_uuid,refresh_token_hash,user_agent,ip,created_at,last_used_at,expires_at)
+				VALUES (?,?,?,?,?,?,?,?)`
+
+	_, err := r.db.Exec(query, s.ID, s.UserUUID, s.RefreshTokenHash, s.UserAgent, s.IP, s.CreatedAt, s.LastUsedAt, s.ExpiresAt)
+	if err != nil {
+		return &models.Error{
+			Msg:    fmt.Sprintf("failed to create session: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return nil
+}
+
+func (r repository) GetSessionByRefreshToken(_ context.Context, hash string) (models.Session, error) {
+	query := `SELECT s.id, s.user_uuid, u.role, s.refresh_token_hash, s.previous_token_hash, s.user_agent, s.ip,
+				s.created_at, s.last_used_at, s.expires_at, s.revoked_at
+				FROM sessions s JOIN users u ON u.uuid = s.user_uuid
+				WHERE s.refresh_token_hash = ? OR s.previous_token_hash = ? LIMIT 1`
+
+	var s models.Session
+	err := r.db.QueryRowx(query, hash, hash).StructScan(&s)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.Session{}, &models.Error{
+			Msg:    ERR_REFRESH_TOKEN_NOT_FOUND,
+			Status: http.StatusUnauthorized,
+		}
+	}
+	if err != nil {
+		return models.Session{}, &models.Error{
+			Msg:    fmt.Sprintf("failed to get session: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return s, nil
+}
+
+func (r repository) RotateSession(_ context.Context, id string, oldHash string, newHash string, usedAt time.Time, expiresAt time.Time) error {
+	query := `UPDATE sessions SET previous_token_hash = refresh_token_hash, refresh_token_hash = ?, last_used_at = ?, expires_at = ?
+				WHERE id = ? AND refresh_token_hash = ? AND revoked_at IS NULL`
+
+	res, err := r.db.Exec(query, newHash, usedAt, expiresAt, id, oldHash)
+	if err != nil {
+		return &models.Error{
+			Msg:    fmt.Sprintf("failed to rotate session: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	// the concurrent refresh with the same token has rotated it first
+	if n, _ := res.RowsAffected(); n == 0 {
+		return &models.Error{
+			Msg:    ERR_REFRESH_TOKEN_USED,
+			Status: http.StatusUnauthorized,
+		}
+	}
+
+	return nil
+}
+
+func (r repository) RevokeSession(_ context.Context, userUUID string, id string, at time.Time) error {
+	query := `UPDATE sessions SET revoked_at = ? WHERE id = ? AND user_uuid = ? AND revoked_at IS NULL`
+
+	res, err := r.db.Exec(query, at, id, userUUID)
+	if err != nil {
+		return &models.Error{
+			Msg:    fmt.Sprintf("failed to revoke session: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+		return &models.Error{
+			Msg:    fmt.Sprintf("%s: %s", ERR_SESSION_NOT_FOUND, id),
+			Status: http.StatusNotFound,
+		}
+	}
+
+	return nil
+}
+
+func (r repository) GetSessions(_ context.Context, userUUID string, now time.Time) ([]models.Session, error) {
+	query := `SELECT id, user_uuid, refresh_token_hash, previous_token_hash, user_agent, ip, created_at, last_used_at, expires_at, revoked_at
+				FROM sessions WHERE user_uuid = ? AND revoked_at IS NULL AND expires_at > ? ORDER BY last_used_at DESC, id`
+
+	var sessions []models.Session
+	if err := r.db.Select(&sessions, query, userUUID, now); err != nil {
+		return nil, &models.Error{
+			Msg:    fmt.Sprintf("failed to get sessions: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return sessions, nil
+}
+
+func (r repository) IsSessionRevoked(_ context.Context, id string, now time.Time) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM sessions WHERE id = ? AND revoked_at IS NULL AND expires_at > ?)`
+
+	var active bool
+	if err := r.db.QueryRowx(query, id, now).Scan(&active); err != nil {
+		return false, &models.Error{
+			Msg:    fmt.Sprintf("failed to check session: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return !active, nil
+}
@@ -3,8 +3,12 @@ package db
 import (
 	"context"
 	"github.com/alserov/rently/user/internal/models"
+	"time"
 )
 
+// ERR_PASSPORT_REGISTERED the passport is unique, Register returns it with 409, if the passport is taken concurrently
+const ERR_PASSPORT_REGISTERED = "user with this passport number already exists"
+
 type Repository interface {
 	Register(ctx context.Context, req models.RegisterReq) error
 	Login(ctx context.Context, email string) (LoginInfo, error)
@@ -15,6 +19,44 @@ type Repository interface {
 	CheckIfAuthorized(ctx context.Context, uuid string, role string) error
 	ResetPassword(ctx context.Context, uuid string, password string) error
 	GetPassword(ctx context.Context, uuid string) (string, error)
+	IsPassportRegistered(ctx context.Context, passportNumberIndex string) (bool, error)
+
+	// GetUsersPII returns the page of the users after the uuid in the uuid order
+	GetUsersPII(ctx context.Context, afterUUID string, limit int) ([]models.UserPII, error)
+	UpdateUserPII(ctx context.Context, pii models.UserPII) error
+
+	SessionRepository
+	PasswordResetRepository
+	EmailVerificationRepository
+}
+
+type EmailVerificationRepository interface {
+	CreateEmailVerification(ctx context.Context, v models.EmailVerification) error
+	// CountEmailVerifications counts the verifications of the user, sent since the time
+	CountEmailVerifications(ctx context.Context, userUUID string, since time.Time) (int, error)
+	// VerifyEmail uses the code and marks the email of its user as verified in one tx
+	VerifyEmail(ctx context.Context, codeHash string, now time.Time) error
+}
+
+type PasswordResetRepository interface {
+	CreatePasswordReset(ctx context.Context, reset models.PasswordReset) error
+	// CountPasswordResets counts the resets of the user, requested since the time
+	CountPasswordResets(ctx context.Context, userUUID string, since time.Time) (int, error)
+	// ConfirmPasswordReset uses the code, sets the password and revokes the sessions of the user in one tx
+	ConfirmPasswordReset(ctx context.Context, codeHash string, password string, now time.Time) error
+}
+
+type SessionRepository interface {
+	CreateSession(ctx context.Context, s models.Session) error
+	// GetSessionByRefreshToken finds the session by its current or previous refresh token hash
+	GetSessionByRefreshToken(ctx context.Context, hash string) (models.Session, error)
+	// RotateSession replaces the refresh token, if the old one is still the current one and the session is not revoked
+	RotateSession(ctx context.Context, id string, oldHash string, newHash string, usedAt time.Time, expiresAt time.Time) error
+	RevokeSession(ctx context.Context, userUUID string, id string, at time.Time) error
+	// GetSessions returns the not revoked and not expired sessions of the user
+	GetSessions(ctx context.Context, userUUID string, now time.Time) ([]models.Session, error)
+	// IsSessionRevoked the missing and expired sessions are revoked too
+	IsSessionRevoked(ctx context.Context, id string, now time.Time) (bool, error)
 }
 
 type LoginInfo struct {
@@ -22,6 +64,8 @@ type LoginInfo struct {
 	Email    string
 	Password string
 	Role     string
+	// EmailVerified the verification is resent to the unverified users only
+	EmailVerified bool `db:"email_verified"`
 }
 
 type EmailNotificationsInfo struct {
@@ -0,0 +1,257 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Cipher encrypts the values with the envelope encryption: every value is sealed with its own data key,
+// which is wrapped by the key-encryption key, the id of the key-encryption key is stored in the value,
+// so the keys can be rotated without re-encrypting all the values at once
+type Cipher interface {
+	// Encrypt the aad binds the value to its owner, so the value can not be moved to the other row or column
+	Encrypt(plaintext string, aad string) (string, error)
+	Decrypt(value string, aad string) (string, error)
+	// NeedsRotation reports, that the value is not encrypted with the active key
+	NeedsRotation(value string) bool
+}
+
+const (
+	// KEYS_ENV contains the comma separated key-encryption keys as id:base64 pairs
+	KEYS_ENV = "ENCRYPTION_KEYS"
+
+	// VERSION is the prefix of the encrypted values, the values without it are the legacy base64 ones
+	VERSION = "enc1"
+
+	KEY_SIZE = 32
+)
+
+var (
+	ErrUnknownKey       = errors.New("unknown key-encryption key")
+	ErrInvalidValue     = errors.New("invalid encrypted value")
+	ErrDecryptionFailed = errors.New("failed to decrypt value")
+	ErrLegacyValue      = errors.New("value is not encrypted")
+)
+
+type Params struct {
+	// ActiveKeyID is the key, the new values are encrypted with
+	ActiveKeyID string
+	// Keys are the key-encryption keys by their ids, the old ones are kept to decrypt the not rotated values
+	Keys map[string][]byte
+	// AllowLegacy lets the values, stored before the encryption, be decrypted, it is only set for the re-encryption,
+	// otherwise the value without VERSION is rejected, so the plain value can not be planted in the database
+	AllowLegacy bool
+}
+
+func NewCipher(p Params) (Cipher, error) {
+	if _, ok := p.Keys[p.ActiveKeyID]; !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKey, p.ActiveKeyID)
+	}
+
+	keys := make(map[string]cipher.AEAD, len(p.Keys))
+	for id, key := range p.Keys {
+		if id == "" || strings.Contains(id, ":") {
+			return nil, fmt.Errorf("invalid key id: %q", id)
+		}
+
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %s: %w", id, err)
+		}
+		keys[id] = aead
+	}
+
+	return &envelopeCipher{
+		activeKeyID: p.ActiveKeyID,
+		keys:        keys,
+		allowLegacy: p.AllowLegacy,
+	}, nil
+}
+
+// MustNewCipher reads the keys from the env
+func MustNewCipher(activeKeyID string) Cipher {
+	return mustNewCipher(Params{ActiveKeyID: activeKeyID})
+}
+
+// MustNewLegacyCipher reads the keys from the env, it also decrypts the legacy values, so they can be re-encrypted
+func MustNewLegacyCipher(activeKeyID string) Cipher {
+	return mustNewCipher(Params{ActiveKeyID: activeKeyID, AllowLegacy: true})
+}
+
+func mustNewCipher(p Params) Cipher {
+	keys, err := ParseKeys(os.Getenv(KEYS_ENV))
+	if err != nil {
+		panic("failed to parse encryption keys: " + err.Error())
+	}
+	p.Keys = keys
+
+	c, err := NewCipher(p)
+	if err != nil {
+		panic("failed to init cipher: " + err.Error())
+	}
+
+	return c
+}
+
+// ParseKeys parses the id:base64 pairs separated by comma
+func ParseKeys(s string) (map[string][]byte, error) {
+	keys := make(map[string][]byte)
+	for _, pair := range strings.Split(s, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+
+		id, encoded, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		if !ok {
+			return nil, fmt.Errorf("key should be set as id:base64")
+		}
+
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode key %s: %w", id, err)
+		}
+		keys[id] = key
+	}
+
+	return keys, nil
+}
+
+type envelopeCipher struct {
+	activeKeyID string
+	keys        map[string]cipher.AEAD
+	allowLegacy bool
+}
+
+// Encrypt the value is VERSION:key id:wrapped data key:sealed plaintext
+func (c *envelopeCipher) Encrypt(plaintext string, aad string) (string, error) {
+	dataKey := make([]byte, KEY_SIZE)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", fmt.Errorf("failed to generate data key: %w", err)
+	}
+
+	// the data key is bound to the key id, so the id in the value can not be replaced
+	wrappedKey, err := seal(c.keys[c.activeKeyID], dataKey, []byte(c.activeKeyID))
+	if err != nil {
+		return "", err
+	}
+
+	data, err := newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+
+	sealed, err := seal(data, []byte(plaintext), []byte(aad))
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join([]string{
+		VERSION,
+		c.activeKeyID,
+		base64.RawURLEncoding.EncodeToString(wrappedKey),
+		base64.RawURLEncoding.EncodeToString(sealed),
+	}, ":"), nil
+}
+
+func (c *envelopeCipher) Decrypt(value string, aad string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+
+	parts := strings.Split(value, ":")
+	if parts[0] != VERSION {
+		if !c.allowLegacy {
+			return "", ErrLegacyValue
+		}
+		return decodeLegacy(value)
+	}
+	if len(parts) != 4 {
+		return "", ErrInvalidValue
+	}
+
+	kek, ok := c.keys[parts[1]]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnknownKey, parts[1])
+	}
+
+	wrappedKey, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", ErrInvalidValue
+	}
+	sealed, err := base64.RawURLEncoding.DecodeString(parts[3])
+	if err != nil {
+		return "", ErrInvalidValue
+	}
+
+	dataKey, err := open(kek, wrappedKey, []byte(parts[1]))
+	if err != nil {
+		return "", err
+	}
+
+	data, err := newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+
+	plaintext, err := open(data, sealed, []byte(aad))
+	if err != nil {
+		return "", err
+	}
+
+	return string(plaintext), nil
+}
+
+func (c *envelopeCipher) NeedsRotation(value string) bool {
+	return !strings.HasPrefix(value, VERSION+":"+c.activeKeyID+":")
+}
+
+// decodeLegacy the values, stored before the encryption, are base64 encoded, they are readable till they are re-encrypted
+func decodeLegacy(value string) (string, error) {
+	b, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return "", ErrInvalidValue
+	}
+	return string(b), nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != KEY_SIZE {
+		return nil, fmt.Errorf("key should be %d bytes", KEY_SIZE)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// seal prepends the random nonce to the ciphertext
+func seal(aead cipher.AEAD, plaintext []byte, aad []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, aad), nil
+}
+
+func open(aead cipher.AEAD, sealed []byte, aad []byte) ([]byte, error) {
+	if len(sealed) < aead.NonceSize() {
+		return nil, ErrInvalidValue
+	}
+
+	plaintext, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], aad)
+	if err != nil {
+		return nil, ErrDecryptionFailed
+	}
+
+	return plaintext, nil
+}
@@ -0,0 +1,98 @@
+package encryption
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestCipher(t *testing.T) {
+	c, err := NewCipher(Params{ActiveKeyID: "k1", Keys: map[string][]byte{"k1": bytes.Repeat([]byte{1}, KEY_SIZE)}})
+	require.NoError(t, err)
+
+	value, err := c.Encrypt("AB1234567", "user/passport_number")
+	require.NoError(t, err)
+	require.NotContains(t, value, "AB1234567")
+	require.False(t, c.NeedsRotation(value))
+
+	// every value has its own data key and nonce
+	other, err := c.Encrypt("AB1234567", "user/passport_number")
+	require.NoError(t, err)
+	require.NotEqual(t, value, other)
+
+	plaintext, err := c.Decrypt(value, "user/passport_number")
+	require.NoError(t, err)
+	require.Equal(t, "AB1234567", plaintext)
+
+	// the value can not be moved to the other user
+	_, err = c.Decrypt(value, "other/passport_number")
+	require.True(t, errors.Is(err, ErrDecryptionFailed))
+
+	_, err = c.Decrypt(value[:len(value)-2], "user/passport_number")
+	require.Error(t, err)
+
+	// the values, stored before the encryption, are only readable by the re-encryption
+	legacy := base64.StdEncoding.EncodeToString([]byte("9999999999"))
+	_, err = c.Decrypt(legacy, "user/phone_number")
+	require.True(t, errors.Is(err, ErrLegacyValue))
+	require.True(t, c.NeedsRotation(legacy))
+
+	reencryption, err := NewCipher(Params{ActiveKeyID: "k1", Keys: map[string][]byte{"k1": bytes.Repeat([]byte{1}, KEY_SIZE)}, AllowLegacy: true})
+	require.NoError(t, err)
+	plaintext, err = reencryption.Decrypt(legacy, "user/phone_number")
+	require.NoError(t, err)
+	require.Equal(t, "9999999999", plaintext)
+}
+
+func TestCipher_Rotation(t *testing.T) {
+	keys := map[string][]byte{
+		"k1": bytes.Repeat([]byte{1}, KEY_SIZE),
+		"k2": bytes.Repeat([]byte{2}, KEY_SIZE),
+	}
+
+	old, err := NewCipher(Params{ActiveKeyID: "k1", Keys: keys})
+	require.NoError(t, err)
+	value, err := old.Encrypt("value", "aad")
+	require.NoError(t, err)
+
+	rotated, err := NewCipher(Params{ActiveKeyID: "k2", Keys: keys})
+	require.NoError(t, err)
+	require.True(t, rotated.NeedsRotation(value))
+
+	plaintext, err := rotated.Decrypt(value, "aad")
+	require.NoError(t, err)
+	require.Equal(t, "value", plaintext)
+
+	// the value of the removed key can not be decrypted
+	removed, err := NewCipher(Params{ActiveKeyID: "k2", Keys: map[string][]byte{"k2": keys["k2"]}})
+	require.NoError(t, err)
+	_, err = removed.Decrypt(value, "aad")
+	require.True(t, errors.Is(err, ErrUnknownKey))
+
+	_, err = NewCipher(Params{ActiveKeyID: "k3", Keys: keys})
+	require.True(t, errors.Is(err, ErrUnknownKey))
+}
+
+func TestParseKeys(t *testing.T) {
+	key := bytes.Repeat([]byte{1}, KEY_SIZE)
+
+	keys, err := ParseKeys("k1:" + base64.StdEncoding.EncodeToString(key) + ", k2:" + base64.StdEncoding.EncodeToString(key))
+	require.NoError(t, err)
+	require.Equal(t, map[string][]byte{"k1": key, "k2": key}, keys)
+
+	_, err = ParseKeys("k1")
+	require.Error(t, err)
+}
+
+func TestBlindIndex(t *testing.T) {
+	index := NewBlindIndex(bytes.Repeat([]byte{1}, KEY_SIZE))
+
+	require.Equal(t, index.Index("passport_number", "AB1234567"), index.Index("passport_number", " ab 1234567"))
+	require.NotEqual(t, index.Index("passport_number", "AB1234567"), index.Index("passport_number", "AB1234568"))
+	require.NotEqual(t, index.Index("passport_number", "1234567"), index.Index("phone_number", "1234567"))
+
+	other := NewBlindIndex(bytes.Repeat([]byte{2}, KEY_SIZE))
+	require.NotEqual(t, index.Index("passport_number", "AB1234567"), other.Index("passport_number", "AB1234567"))
+}
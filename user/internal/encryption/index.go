@@ -0,0 +1,57 @@
+package encryption
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"os"
+	"strings"
+)
+
+// BlindIndex makes the deterministic keyed hashes of the values, so the encrypted columns can be looked up
+// by the equality without decrypting them
+type BlindIndex interface {
+	// Index the field is mixed in, so the equal values of the different fields have different indexes
+	Index(field string, value string) string
+}
+
+// BLIND_INDEX_KEY_ENV contains the base64 key of the blind indexes, it should differ from the key-encryption keys
+const BLIND_INDEX_KEY_ENV = "BLIND_INDEX_KEY"
+
+func NewBlindIndex(key []byte) BlindIndex {
+	return &hmacIndex{
+		key: key,
+	}
+}
+
+// MustNewBlindIndex reads the key from the env
+func MustNewBlindIndex() BlindIndex {
+	key, err := base64.StdEncoding.DecodeString(os.Getenv(BLIND_INDEX_KEY_ENV))
+	if err != nil {
+		panic("failed to decode blind index key: " + err.Error())
+	}
+	if len(key) < KEY_SIZE {
+		panic("blind index key should be at least 32 bytes")
+	}
+
+	return NewBlindIndex(key)
+}
+
+type hmacIndex struct {
+	key []byte
+}
+
+func (i *hmacIndex) Index(field string, value string) string {
+	mac := hmac.New(sha256.New, i.key)
+	mac.Write([]byte(field))
+	mac.Write([]byte{0})
+	mac.Write([]byte(normalize(value)))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// normalize the values, which differ in the case or the spaces only, are equal
+func normalize(value string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(value), ""))
+}
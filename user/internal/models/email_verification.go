@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// EmailVerification only the hash of the code is stored like the password reset one
+type EmailVerification struct {
+	CodeHash  string     `db:"code_hash"`
+	UserUUID  string     `db:"user_uuid"`
+	CreatedAt time.Time  `db:"created_at"`
+	ExpiresAt time.Time  `db:"expires_at"`
+	UsedAt    *time.Time `db:"used_at"`
+}
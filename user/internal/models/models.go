@@ -1,6 +1,6 @@
 package models
 
-import "github.com/golang-jwt/jwt/v4"
+import "github.com/alserov/rently/proto/jwks"
 
 type RegisterReq struct {
 	UUID           string
@@ -10,24 +10,45 @@ type RegisterReq struct {
 	PassportNumber string
 	PaymentSource  string
 	PhoneNumber    string
+	Client         Client
+
+	// the blind indexes of the encrypted fields are set by the service
+	PassportNumberIndex string
+	PhoneNumberIndex    string
+}
+
+// UserPII is the encrypted personal data of the user with the blind indexes
+type UserPII struct {
+	UUID                string `db:"uuid"`
+	PassportNumber      string `db:"passport_number"`
+	PaymentSource       string `db:"payment_source"`
+	PhoneNumber         string `db:"phone_number"`
+	PassportNumberIndex string `db:"passport_number_idx"`
+	PhoneNumberIndex    string `db:"phone_number_idx"`
 }
 
 type RegisterRes struct {
-	UUID  string
-	Token string
+	UUID         string
+	Token        string
+	RefreshToken string
 }
 
 type LoginReq struct {
 	Email    string
 	Password string
+	Client   Client
 }
 
-type Claims struct {
-	UUID string `json:"uuid"`
-	Role string `json:"role"`
-	*jwt.RegisteredClaims
+// AuthInfo is the user, who the token was issued to
+type AuthInfo struct {
+	UUID string
+	Role string
 }
 
+// Claims are shared with the services, which verify the tokens with the JWKS,
+// the token is not valid after its session is revoked
+type Claims = jwks.Claims
+
 type UserInfoRes struct {
 	Username          string
 	NotificationsOn   bool
@@ -38,7 +59,10 @@ type UserInfoRes struct {
 type InfoForRentRes struct {
 	PassportNumber string `db:"passport_number"`
 	PhoneNumber    string `db:"phone_number"`
+	Email          string `db:"email"`
 	UUID           string
+	// EmailVerified the unverified users can not rent
+	EmailVerified bool `db:"email_verified"`
 }
 
 type ResetPasswordReq struct {
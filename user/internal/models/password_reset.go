@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// PasswordReset only the hash of the code is stored, the code itself is sent to the user
+type PasswordReset struct {
+	CodeHash  string     `db:"code_hash"`
+	UserUUID  string     `db:"user_uuid"`
+	CreatedAt time.Time  `db:"created_at"`
+	ExpiresAt time.Time  `db:"expires_at"`
+	UsedAt    *time.Time `db:"used_at"`
+}
+
+type ConfirmPasswordResetReq struct {
+	Code        string
+	NewPassword string
+}
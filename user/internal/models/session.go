@@ -0,0 +1,37 @@
+package models
+
+import "time"
+
+// Tokens the access token is short-lived, the refresh token is used to get the new pair
+type Tokens struct {
+	Token        string
+	RefreshToken string
+}
+
+// Client is the device, the session is started from
+type Client struct {
+	UserAgent string `db:"user_agent"`
+	IP        string `db:"ip"`
+}
+
+// Session only the hashes of the refresh tokens are stored, the previous one is kept to detect its reuse
+type Session struct {
+	ID                string `db:"id"`
+	UserUUID          string `db:"user_uuid"`
+	Role              string `db:"role"`
+	RefreshTokenHash  string `db:"refresh_token_hash"`
+	PreviousTokenHash string `db:"previous_token_hash"`
+	Client
+	CreatedAt  time.Time  `db:"created_at"`
+	LastUsedAt time.Time  `db:"last_used_at"`
+	ExpiresAt  time.Time  `db:"expires_at"`
+	RevokedAt  *time.Time `db:"revoked_at"`
+
+	// Current is set by the service for the session of the request token
+	Current bool `db:"-"`
+}
+
+type RevokeSessionReq struct {
+	Token     string
+	SessionID string
+}
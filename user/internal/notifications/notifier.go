@@ -4,11 +4,28 @@ import (
 	"context"
 	"github.com/alserov/rently/user/internal/config"
 	"github.com/alserov/rently/user/internal/utils/broker"
+	"time"
 )
 
 type Notifier interface {
-	Registration(ctx context.Context, email string) error
+	// EmailVerification is sent on the registration with the link to verify the email
+	EmailVerification(ctx context.Context, info EmailVerificationInfo) error
 	Login(ctx context.Context, email string) error
+	PasswordReset(ctx context.Context, info PasswordResetInfo) error
+}
+
+// PasswordResetInfo is the message of the email with the reset link
+type PasswordResetInfo struct {
+	Email     string    `json:"email"`
+	Link      string    `json:"link"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// EmailVerificationInfo is the message of the email with the verification link
+type EmailVerificationInfo struct {
+	Email     string    `json:"email"`
+	Link      string    `json:"link"`
+	ExpiresAt time.Time `json:"expiresAt"`
 }
 
 func NewNotifier(producer broker.Producer, topics config.Topics) Notifier {
@@ -16,8 +33,10 @@ func NewNotifier(producer broker.Producer, topics config.Topics) Notifier {
 }
 
 const (
-	REGISTRATION_ID = "0"
-	LOGIN_ID        = "1"
+	LOGIN_ID = "1"
+	// PASSWORD_RESET_ID follows the ids of the carsharing messages
+	PASSWORD_RESET_ID     = "4"
+	EMAIL_VERIFICATION_ID = "5"
 )
 
 type notifier struct {
@@ -30,6 +49,10 @@ func (n notifier) Login(ctx context.Context, email string) error {
 	return n.producer.Produce(ctx, email, LOGIN_ID, n.topics.Email)
 }
 
-func (n notifier) Registration(ctx context.Context, email string) error {
-	return n.producer.Produce(ctx, email, REGISTRATION_ID, n.topics.Email)
+func (n notifier) PasswordReset(ctx context.Context, info PasswordResetInfo) error {
+	return n.producer.Produce(ctx, info, PASSWORD_RESET_ID, n.topics.Email)
+}
+
+func (n notifier) EmailVerification(ctx context.Context, info EmailVerificationInfo) error {
+	return n.producer.Produce(ctx, info, EMAIL_VERIFICATION_ID, n.topics.Email)
 }
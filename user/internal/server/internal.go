@@ -24,6 +24,12 @@ func (s *server) handleError(err error) error {
 			return status.Error(codes.InvalidArgument, e.Msg)
 		case http.StatusNotFound:
 			return status.Error(codes.NotFound, e.Msg)
+		case http.StatusUnauthorized:
+			return status.Error(codes.Unauthenticated, e.Msg)
+		case http.StatusForbidden:
+			return status.Error(codes.PermissionDenied, e.Msg)
+		case http.StatusConflict:
+			return status.Error(codes.FailedPrecondition, e.Msg)
 		}
 	}
 
@@ -52,17 +52,65 @@ func (s *server) ResetPassword(ctx context.Context, req *user.ResetPasswordReq)
 	return &emptypb.Empty{}, nil
 }
 
+func (s *server) RequestPasswordReset(ctx context.Context, req *user.RequestPasswordResetReq) (*emptypb.Empty, error) {
+	if err := s.valid.ValidateRequestPasswordResetReq(req); err != nil {
+		return nil, err
+	}
+
+	if err := s.service.RequestPasswordReset(ctx, req.Email); err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) ConfirmPasswordReset(ctx context.Context, req *user.ConfirmPasswordResetReq) (*emptypb.Empty, error) {
+	if err := s.valid.ValidateConfirmPasswordResetReq(req); err != nil {
+		return nil, err
+	}
+
+	if err := s.service.ConfirmPasswordReset(ctx, s.convert.ConfirmPasswordResetReqToService(req)); err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) VerifyEmail(ctx context.Context, req *user.VerifyEmailReq) (*emptypb.Empty, error) {
+	if err := s.valid.ValidateVerifyEmailReq(req); err != nil {
+		return nil, err
+	}
+
+	if err := s.service.VerifyEmail(ctx, req.Code); err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) ResendEmailVerification(ctx context.Context, req *user.ResendEmailVerificationReq) (*emptypb.Empty, error) {
+	if err := s.valid.ValidateResendEmailVerificationReq(req); err != nil {
+		return nil, err
+	}
+
+	if err := s.service.ResendEmailVerification(ctx, req.Email); err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 func (s *server) CheckIfAuthorized(ctx context.Context, req *user.CheckIfAuthorizedReq) (*user.CheckIfAuthorizedRes, error) {
 	if err := s.valid.ValidateCheckIfAuthorizedReq(req); err != nil {
 		return nil, err
 	}
 
-	role, err := s.service.CheckIfAuthorized(ctx, req.Token)
+	info, err := s.service.CheckIfAuthorized(ctx, req.Token)
 	if err != nil {
 		return nil, s.handleError(err)
 	}
 
-	return s.convert.CheckIfAuthorizedResToPb(role), nil
+	return s.convert.CheckIfAuthorizedResToPb(info), nil
 }
 
 func (s *server) Register(ctx context.Context, req *user.RegisterReq) (*user.RegisterRes, error) {
@@ -83,12 +131,66 @@ func (s *server) Login(ctx context.Context, req *user.LoginReq) (*user.LoginRes,
 		return nil, err
 	}
 
-	token, err := s.service.Login(ctx, s.convert.LoginReqToService(req))
+	tokens, err := s.service.Login(ctx, s.convert.LoginReqToService(req))
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return s.convert.LoginResToPb(tokens), nil
+}
+
+func (s *server) RefreshToken(ctx context.Context, req *user.RefreshTokenReq) (*user.RefreshTokenRes, error) {
+	if err := s.valid.ValidateRefreshTokenReq(req); err != nil {
+		return nil, err
+	}
+
+	tokens, err := s.service.RefreshToken(ctx, req.RefreshToken)
 	if err != nil {
 		return nil, s.handleError(err)
 	}
 
-	return s.convert.LoginResToPb(token), nil
+	return s.convert.RefreshTokenResToPb(tokens), nil
+}
+
+func (s *server) Logout(ctx context.Context, req *user.LogoutReq) (*emptypb.Empty, error) {
+	if err := s.valid.ValidateLogoutReq(req); err != nil {
+		return nil, err
+	}
+
+	if err := s.service.Logout(ctx, req.Token); err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) ListSessions(ctx context.Context, req *user.ListSessionsReq) (*user.ListSessionsRes, error) {
+	if err := s.valid.ValidateListSessionsReq(req); err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.service.ListSessions(ctx, req.Token)
+	if err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return s.convert.SessionsToPb(sessions), nil
+}
+
+func (s *server) RevokeSession(ctx context.Context, req *user.RevokeSessionReq) (*emptypb.Empty, error) {
+	if err := s.valid.ValidateRevokeSessionReq(req); err != nil {
+		return nil, err
+	}
+
+	if err := s.service.RevokeSession(ctx, s.convert.RevokeSessionReqToService(req)); err != nil {
+		return nil, s.handleError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) GetJWKS(ctx context.Context, _ *emptypb.Empty) (*user.JWKS, error) {
+	return s.convert.JWKSToPb(s.service.GetJWKS(ctx)), nil
 }
 
 func (s *server) GetInfo(ctx context.Context, req *user.GetInfoReq) (*user.UserInfoRes, error) {
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/alserov/rently/user/internal/models"
+	"github.com/alserov/rently/user/internal/notifications"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+const (
+	DEFAULT_EMAIL_VERIFICATION_TTL          = time.Hour * 24
+	DEFAULT_EMAIL_VERIFICATION_MAX_REQUESTS = 3
+	DEFAULT_EMAIL_VERIFICATION_WINDOW       = time.Hour
+
+	ERR_EMAIL_NOT_VERIFIED = "email is not verified"
+)
+
+type EmailVerificationParams struct {
+	// LinkURL is the page, the code is added to
+	LinkURL     string
+	TTL         time.Duration
+	MaxRequests int
+	Window      time.Duration
+}
+
+func (p EmailVerificationParams) withDefaults() EmailVerificationParams {
+	if p.TTL == 0 {
+		p.TTL = DEFAULT_EMAIL_VERIFICATION_TTL
+	}
+	if p.MaxRequests == 0 {
+		p.MaxRequests = DEFAULT_EMAIL_VERIFICATION_MAX_REQUESTS
+	}
+	if p.Window == 0 {
+		p.Window = DEFAULT_EMAIL_VERIFICATION_WINDOW
+	}
+	return p
+}
+
+func (s *service) VerifyEmail(ctx context.Context, code string) error {
+	if err := s.repo.VerifyEmail(ctx, hashRefreshToken(code), time.Now().UTC()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ResendEmailVerification the unknown, verified and rate limited emails are not reported, like with the password reset,
+// the registration email counts to the limit too
+func (s *service) ResendEmailVerification(ctx context.Context, email string) error {
+	userData, err := s.repo.Login(ctx, email)
+	var e *models.Error
+	if errors.As(err, &e) && e.Status == http.StatusNotFound {
+		s.log.Debug("email verification for unknown email")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if userData.EmailVerified {
+		s.log.Debug("email verification for verified email", slog.String("uuid", userData.UUID))
+		return nil
+	}
+
+	count, err := s.repo.CountEmailVerifications(ctx, userData.UUID, time.Now().UTC().Add(-s.emailVerification.Window))
+	if err != nil {
+		return err
+	}
+	if count >= s.emailVerification.MaxRequests {
+		s.log.Warn("email verification rate limit exceeded", slog.String("uuid", userData.UUID))
+		return nil
+	}
+
+	// the previous codes stay valid until they expire, the first used one verifies the email
+	if err = s.sendEmailVerification(ctx, userData.UUID, userData.Email); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) sendEmailVerification(ctx context.Context, userUUID string, email string) error {
+	code, err := newRefreshToken()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	v := models.EmailVerification{
+		CodeHash:  hashRefreshToken(code),
+		UserUUID:  userUUID,
+		CreatedAt: now,
+		ExpiresAt: now.Add(s.emailVerification.TTL),
+	}
+	if err = s.repo.CreateEmailVerification(ctx, v); err != nil {
+		return err
+	}
+
+	link, err := codeLink(s.emailVerification.LinkURL, code)
+	if err != nil {
+		return err
+	}
+
+	if err = s.notifier.EmailVerification(ctx, notifications.EmailVerificationInfo{
+		Email:     email,
+		Link:      link,
+		ExpiresAt: v.ExpiresAt,
+	}); err != nil {
+		return fmt.Errorf("failed to send email verification: %w", err)
+	}
+
+	return nil
+}
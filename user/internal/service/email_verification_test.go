@@ -0,0 +1,164 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"github.com/alserov/rently/user/internal/db"
+	repomock "github.com/alserov/rently/user/internal/db/mocks"
+	"github.com/alserov/rently/user/internal/encryption"
+	"github.com/alserov/rently/user/internal/log"
+	"github.com/alserov/rently/user/internal/models"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestService_VerifyEmail(t *testing.T) {
+	crtl := gomock.NewController(t)
+	defer crtl.Finish()
+
+	repo := repomock.NewMockRepository(crtl)
+	repo.EXPECT().
+		VerifyEmail(gomock.Any(), hashRefreshToken("code"), gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	s := NewService(Params{Repo: repo})
+
+	require.NoError(t, s.VerifyEmail(context.Background(), "code"))
+}
+
+func TestService_ResendEmailVerification(t *testing.T) {
+	log.MustSetup(log.ENV_LOCAL)
+
+	user := db.LoginInfo{UUID: "uuid", Email: "email@mail.com"}
+
+	t.Run("sent", func(t *testing.T) {
+		crtl := gomock.NewController(t)
+		defer crtl.Finish()
+
+		var stored models.EmailVerification
+		repo := repomock.NewMockRepository(crtl)
+		repo.EXPECT().Login(gomock.Any(), user.Email).Return(user, nil).Times(1)
+		repo.EXPECT().CountEmailVerifications(gomock.Any(), user.UUID, gomock.Any()).Return(2, nil).Times(1)
+		repo.EXPECT().
+			CreateEmailVerification(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, v models.EmailVerification) error {
+				stored = v
+				return nil
+			}).
+			Times(1)
+
+		notifier := &fakeNotifier{}
+		s := NewService(Params{Repo: repo, Notifier: notifier})
+
+		require.NoError(t, s.ResendEmailVerification(context.Background(), user.Email))
+		require.Len(t, notifier.verifications, 1)
+		require.Equal(t, user.Email, notifier.verifications[0].Email)
+		require.Equal(t, user.UUID, stored.UserUUID)
+
+		link, err := url.Parse(notifier.verifications[0].Link)
+		require.NoError(t, err)
+		require.Equal(t, hashRefreshToken(link.Query().Get(CODE_PARAM)), stored.CodeHash)
+	})
+
+	t.Run("rate limited", func(t *testing.T) {
+		crtl := gomock.NewController(t)
+		defer crtl.Finish()
+
+		repo := repomock.NewMockRepository(crtl)
+		repo.EXPECT().Login(gomock.Any(), user.Email).Return(user, nil).Times(1)
+		repo.EXPECT().
+			CountEmailVerifications(gomock.Any(), user.UUID, gomock.Any()).
+			Return(DEFAULT_EMAIL_VERIFICATION_MAX_REQUESTS, nil).
+			Times(1)
+
+		notifier := &fakeNotifier{}
+		s := NewService(Params{Repo: repo, Notifier: notifier})
+
+		require.NoError(t, s.ResendEmailVerification(context.Background(), user.Email))
+		require.Empty(t, notifier.verifications)
+	})
+
+	t.Run("verified", func(t *testing.T) {
+		crtl := gomock.NewController(t)
+		defer crtl.Finish()
+
+		verified := user
+		verified.EmailVerified = true
+
+		repo := repomock.NewMockRepository(crtl)
+		repo.EXPECT().Login(gomock.Any(), user.Email).Return(verified, nil).Times(1)
+
+		notifier := &fakeNotifier{}
+		s := NewService(Params{Repo: repo, Notifier: notifier})
+
+		require.NoError(t, s.ResendEmailVerification(context.Background(), user.Email))
+		require.Empty(t, notifier.verifications)
+	})
+
+	t.Run("unknown email", func(t *testing.T) {
+		crtl := gomock.NewController(t)
+		defer crtl.Finish()
+
+		repo := repomock.NewMockRepository(crtl)
+		repo.EXPECT().
+			Login(gomock.Any(), "unknown@mail.com").
+			Return(db.LoginInfo{}, &models.Error{Status: http.StatusNotFound}).
+			Times(1)
+
+		notifier := &fakeNotifier{}
+		s := NewService(Params{Repo: repo, Notifier: notifier})
+
+		require.NoError(t, s.ResendEmailVerification(context.Background(), "unknown@mail.com"))
+		require.Empty(t, notifier.verifications)
+	})
+}
+
+func TestService_GetRentInfo(t *testing.T) {
+	log.MustSetup(log.ENV_LOCAL)
+
+	signer := testSigner(t)
+	token, err := newToken(signer, "uuid", ROLE_USER, "session")
+	require.NoError(t, err)
+
+	t.Run("unverified", func(t *testing.T) {
+		crtl := gomock.NewController(t)
+		defer crtl.Finish()
+
+		repo := repomock.NewMockRepository(crtl)
+		repo.EXPECT().IsSessionRevoked(gomock.Any(), "session", gomock.Any()).Return(false, nil).Times(1)
+		repo.EXPECT().
+			GetInfoForRent(gomock.Any(), "uuid").
+			Return(models.InfoForRentRes{Email: "email@mail.com"}, nil).
+			Times(1)
+
+		s := NewService(Params{Repo: repo, Signer: signer})
+
+		_, err := s.GetRentInfo(context.Background(), token)
+		requireStatus(t, err, http.StatusForbidden)
+	})
+
+	t.Run("verified", func(t *testing.T) {
+		crtl := gomock.NewController(t)
+		defer crtl.Finish()
+
+		repo := repomock.NewMockRepository(crtl)
+		repo.EXPECT().IsSessionRevoked(gomock.Any(), "session", gomock.Any()).Return(false, nil).Times(1)
+		repo.EXPECT().
+			GetInfoForRent(gomock.Any(), "uuid").
+			Return(models.InfoForRentRes{Email: "email@mail.com", EmailVerified: true}, nil).
+			Times(1)
+
+		cipher, err := encryption.NewCipher(encryption.Params{ActiveKeyID: "k1", Keys: map[string][]byte{"k1": bytes.Repeat([]byte{1}, encryption.KEY_SIZE)}})
+		require.NoError(t, err)
+
+		s := NewService(Params{Repo: repo, Signer: signer, Cipher: cipher})
+
+		info, err := s.GetRentInfo(context.Background(), token)
+		require.NoError(t, err)
+		require.Equal(t, "uuid", info.UUID)
+	})
+}
@@ -1,30 +1,44 @@
 package service
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"github.com/alserov/rently/user/internal/models"
+	"github.com/alserov/rently/user/internal/signing"
 	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 	"net/http"
-	"os"
+	"net/url"
 	"time"
 )
 
 const (
-	ENV_SECRET_KEY = "SECRET_KEY"
+	// ACCESS_TOKEN_TTL is short, because the access tokens are checked against the revoked sessions only
+	ACCESS_TOKEN_TTL  = time.Minute * 15
+	REFRESH_TOKEN_TTL = time.Hour * 24 * 30
+
+	REFRESH_TOKEN_SIZE = 32
+
+	// CODE_PARAM is the query param of the emailed links, the reset and verification codes are sent with
+	CODE_PARAM = "code"
 )
 
-func newToken(uuid string, role string) (string, error) {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS512, models.Claims{
-		UUID: uuid,
-		Role: role,
-		RegisteredClaims: &jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour * 24 * 21)),
+func newToken(signer signing.Signer, userUUID string, role string, sessionID string) (string, error) {
+	now := time.Now()
+	tokenString, err := signer.Sign(models.Claims{
+		UUID:      userUUID,
+		Role:      role,
+		SessionID: sessionID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ACCESS_TOKEN_TTL)),
 		},
 	})
-
-	tokenString, err := token.SignedString([]byte(os.Getenv(ENV_SECRET_KEY)))
 	if err != nil {
 		return "", &models.Error{
 			Msg:    fmt.Sprintf("failed to sign token: %v", err),
@@ -35,20 +49,37 @@ func newToken(uuid string, role string) (string, error) {
 	return tokenString, err
 }
 
-func parseTokenClaims(token string) (string, string, error) {
+// parseTokenClaims the expired token is unauthorized, so the client can refresh it
+func parseTokenClaims(signer signing.Signer, token string) (models.Claims, error) {
 	c := models.Claims{}
 
-	_, err := jwt.ParseWithClaims(token, &c, func(token *jwt.Token) (interface{}, error) {
-		return []byte(os.Getenv(ENV_SECRET_KEY)), nil
-	})
-	if err != nil {
-		return "", "", &models.Error{
-			Msg:    "invalid token provided",
-			Status: http.StatusBadRequest,
+	if err := signer.Parse(token, &c); err != nil {
+		return models.Claims{}, &models.Error{
+			Msg:    ERR_INVALID_TOKEN,
+			Status: http.StatusUnauthorized,
 		}
 	}
 
-	return c.UUID, c.Role, nil
+	return c, nil
+}
+
+// newRefreshToken the refresh tokens are random, so they are looked up by their hashes
+func newRefreshToken() (string, error) {
+	b := make([]byte, REFRESH_TOKEN_SIZE)
+	if _, err := rand.Read(b); err != nil {
+		return "", &models.Error{
+			Msg:    fmt.Sprintf("failed to generate refresh token: %v", err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashRefreshToken the token has the full entropy, so the fast hash is enough
+func hashRefreshToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
 }
 
 func hash(value string) (string, error) {
@@ -73,17 +104,19 @@ func compareHashAndPassword(hash, password string) error {
 	return nil
 }
 
-func encrypt(value string) string {
-	return base64.StdEncoding.EncodeToString([]byte(value))
-}
-
-func decrypt(value string) (string, error) {
-	data, err := base64.StdEncoding.DecodeString(value)
+// codeLink adds the code to the query of the link url
+func codeLink(linkURL string, code string) (string, error) {
+	u, err := url.Parse(linkURL)
 	if err != nil {
 		return "", &models.Error{
-			Msg:    fmt.Sprintf("failed to decrypt value: %v", err),
+			Msg:    fmt.Sprintf("invalid link url: %v", err),
 			Status: http.StatusInternalServerError,
 		}
 	}
-	return string(data), nil
+
+	q := u.Query()
+	q.Set(CODE_PARAM, code)
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
 }
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/alserov/rently/user/internal/models"
+	"github.com/alserov/rently/user/internal/notifications"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+const (
+	DEFAULT_PASSWORD_RESET_TTL          = time.Minute * 30
+	DEFAULT_PASSWORD_RESET_MAX_REQUESTS = 3
+	DEFAULT_PASSWORD_RESET_WINDOW       = time.Hour
+)
+
+type PasswordResetParams struct {
+	// LinkURL is the page, the code is added to
+	LinkURL     string
+	TTL         time.Duration
+	MaxRequests int
+	Window      time.Duration
+}
+
+func (p PasswordResetParams) withDefaults() PasswordResetParams {
+	if p.TTL == 0 {
+		p.TTL = DEFAULT_PASSWORD_RESET_TTL
+	}
+	if p.MaxRequests == 0 {
+		p.MaxRequests = DEFAULT_PASSWORD_RESET_MAX_REQUESTS
+	}
+	if p.Window == 0 {
+		p.Window = DEFAULT_PASSWORD_RESET_WINDOW
+	}
+	return p
+}
+
+// RequestPasswordReset the unknown and rate limited emails are not reported, so the registered emails can not be found out
+func (s *service) RequestPasswordReset(ctx context.Context, email string) error {
+	userData, err := s.repo.Login(ctx, email)
+	var e *models.Error
+	if errors.As(err, &e) && e.Status == http.StatusNotFound {
+		s.log.Debug("password reset for unknown email")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	count, err := s.repo.CountPasswordResets(ctx, userData.UUID, now.Add(-s.passwordReset.Window))
+	if err != nil {
+		return err
+	}
+	if count >= s.passwordReset.MaxRequests {
+		s.log.Warn("password reset rate limit exceeded", slog.String("uuid", userData.UUID))
+		return nil
+	}
+
+	// the code is a bearer secret like the refresh token, so it is generated and stored the same way
+	code, err := newRefreshToken()
+	if err != nil {
+		return err
+	}
+
+	reset := models.PasswordReset{
+		CodeHash:  hashRefreshToken(code),
+		UserUUID:  userData.UUID,
+		CreatedAt: now,
+		ExpiresAt: now.Add(s.passwordReset.TTL),
+	}
+	if err = s.repo.CreatePasswordReset(ctx, reset); err != nil {
+		return err
+	}
+
+	link, err := codeLink(s.passwordReset.LinkURL, code)
+	if err != nil {
+		return err
+	}
+
+	if err = s.notifier.PasswordReset(ctx, notifications.PasswordResetInfo{
+		Email:     userData.Email,
+		Link:      link,
+		ExpiresAt: reset.ExpiresAt,
+	}); err != nil {
+		return fmt.Errorf("failed to send password reset notification: %w", err)
+	}
+
+	return nil
+}
+
+func (s *service) ConfirmPasswordReset(ctx context.Context, req models.ConfirmPasswordResetReq) error {
+	hashedPassword, err := hash(req.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	// the sessions are revoked, because the password could be reset due to the stolen account
+	if err = s.repo.ConfirmPasswordReset(ctx, hashRefreshToken(req.Code), hashedPassword, time.Now().UTC()); err != nil {
+		return err
+	}
+
+	return nil
+}
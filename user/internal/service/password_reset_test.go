@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"github.com/alserov/rently/user/internal/db"
+	repomock "github.com/alserov/rently/user/internal/db/mocks"
+	"github.com/alserov/rently/user/internal/log"
+	"github.com/alserov/rently/user/internal/models"
+	"github.com/alserov/rently/user/internal/notifications"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestService_RequestPasswordReset(t *testing.T) {
+	log.MustSetup(log.ENV_LOCAL)
+
+	user := db.LoginInfo{UUID: "uuid", Email: "email@mail.com"}
+
+	t.Run("sent", func(t *testing.T) {
+		crtl := gomock.NewController(t)
+		defer crtl.Finish()
+
+		var stored models.PasswordReset
+		repo := repomock.NewMockRepository(crtl)
+		repo.EXPECT().Login(gomock.Any(), user.Email).Return(user, nil).Times(1)
+		repo.EXPECT().CountPasswordResets(gomock.Any(), user.UUID, gomock.Any()).Return(2, nil).Times(1)
+		repo.EXPECT().
+			CreatePasswordReset(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, reset models.PasswordReset) error {
+				stored = reset
+				return nil
+			}).
+			Times(1)
+
+		notifier := &fakeNotifier{}
+		s := NewService(Params{
+			Repo:          repo,
+			Notifier:      notifier,
+			PasswordReset: PasswordResetParams{LinkURL: "http://localhost/reset?lang=en"},
+		})
+
+		require.NoError(t, s.RequestPasswordReset(context.Background(), user.Email))
+		require.Len(t, notifier.resets, 1)
+		require.Equal(t, user.Email, notifier.resets[0].Email)
+		require.Equal(t, stored.ExpiresAt, notifier.resets[0].ExpiresAt)
+		require.Equal(t, DEFAULT_PASSWORD_RESET_TTL, stored.ExpiresAt.Sub(stored.CreatedAt))
+
+		// only the hash of the emailed code is stored
+		link, err := url.Parse(notifier.resets[0].Link)
+		require.NoError(t, err)
+		require.Equal(t, "en", link.Query().Get("lang"))
+		require.Equal(t, hashRefreshToken(link.Query().Get(CODE_PARAM)), stored.CodeHash)
+	})
+
+	t.Run("rate limited", func(t *testing.T) {
+		crtl := gomock.NewController(t)
+		defer crtl.Finish()
+
+		repo := repomock.NewMockRepository(crtl)
+		repo.EXPECT().Login(gomock.Any(), user.Email).Return(user, nil).Times(1)
+		repo.EXPECT().CountPasswordResets(gomock.Any(), user.UUID, gomock.Any()).Return(DEFAULT_PASSWORD_RESET_MAX_REQUESTS, nil).Times(1)
+
+		notifier := &fakeNotifier{}
+		s := NewService(Params{Repo: repo, Notifier: notifier})
+
+		require.NoError(t, s.RequestPasswordReset(context.Background(), user.Email))
+		require.Empty(t, notifier.resets)
+	})
+
+	t.Run("unknown email", func(t *testing.T) {
+		crtl := gomock.NewController(t)
+		defer crtl.Finish()
+
+		repo := repomock.NewMockRepository(crtl)
+		repo.EXPECT().
+			Login(gomock.Any(), "unknown@mail.com").
+			Return(db.LoginInfo{}, &models.Error{Status: http.StatusNotFound}).
+			Times(1)
+
+		notifier := &fakeNotifier{}
+		s := NewService(Params{Repo: repo, Notifier: notifier})
+
+		require.NoError(t, s.RequestPasswordReset(context.Background(), "unknown@mail.com"))
+		require.Empty(t, notifier.resets)
+	})
+}
+
+func TestService_ConfirmPasswordReset(t *testing.T) {
+	crtl := gomock.NewController(t)
+	defer crtl.Finish()
+
+	req := models.ConfirmPasswordResetReq{Code: "code", NewPassword: "new_password"}
+
+	repo := repomock.NewMockRepository(crtl)
+	repo.EXPECT().
+		ConfirmPasswordReset(gomock.Any(), hashRefreshToken(req.Code), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, password string, _ time.Time) error {
+			require.NoError(t, compareHashAndPassword(password, req.NewPassword))
+			return nil
+		}).
+		Times(1)
+
+	s := NewService(Params{Repo: repo})
+
+	require.NoError(t, s.ConfirmPasswordReset(context.Background(), req))
+}
+
+type fakeNotifier struct {
+	resets        []notifications.PasswordResetInfo
+	verifications []notifications.EmailVerificationInfo
+}
+
+func (n *fakeNotifier) EmailVerification(_ context.Context, info notifications.EmailVerificationInfo) error {
+	n.verifications = append(n.verifications, info)
+	return nil
+}
+
+func (n *fakeNotifier) Login(_ context.Context, _ string) error {
+	return nil
+}
+
+func (n *fakeNotifier) PasswordReset(_ context.Context, info notifications.PasswordResetInfo) error {
+	n.resets = append(n.resets, info)
+	return nil
+}
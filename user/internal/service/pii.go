@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/alserov/rently/user/internal/db"
+	"github.com/alserov/rently/user/internal/encryption"
+	"github.com/alserov/rently/user/internal/log"
+	"github.com/alserov/rently/user/internal/models"
+	"log/slog"
+	"net/http"
+)
+
+// the names of the encrypted fields, they are the part of the aad and of the blind indexes
+const (
+	PII_PASSPORT_NUMBER = "passport_number"
+	PII_PAYMENT_SOURCE  = "payment_source"
+	PII_PHONE_NUMBER    = "phone_number"
+)
+
+// piiAAD binds the encrypted value to the user and the field
+func piiAAD(uuid string, field string) string {
+	return uuid + "/" + field
+}
+
+func (s *service) encrypt(uuid string, field string, value string) (string, error) {
+	encrypted, err := s.cipher.Encrypt(value, piiAAD(uuid, field))
+	if err != nil {
+		return "", &models.Error{
+			Msg:    fmt.Sprintf("failed to encrypt %s: %v", field, err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+	return encrypted, nil
+}
+
+func (s *service) decrypt(uuid string, field string, value string) (string, error) {
+	decrypted, err := s.cipher.Decrypt(value, piiAAD(uuid, field))
+	if err != nil {
+		return "", &models.Error{
+			Msg:    fmt.Sprintf("failed to decrypt %s: %v", field, err),
+			Status: http.StatusInternalServerError,
+		}
+	}
+	return decrypted, nil
+}
+
+// REENCRYPT_BATCH_SIZE is the amount of the users, which are read at once
+const REENCRYPT_BATCH_SIZE = 100
+
+type ReencryptParams struct {
+	Repo   db.Repository
+	Cipher encryption.Cipher
+	Index  encryption.BlindIndex
+}
+
+type ReencryptStats struct {
+	Updated int
+	// Failed are the users, whose values can not be decrypted, they are left as they are
+	Failed int
+}
+
+// ReencryptPII encrypts the legacy values and the values of the rotated keys with the active key
+// and refreshes the blind indexes, it can be interrupted and run again
+func ReencryptPII(ctx context.Context, p ReencryptParams) (ReencryptStats, error) {
+	l := log.GetLogger()
+
+	var (
+		stats ReencryptStats
+		after string
+	)
+	for {
+		users, err := p.Repo.GetUsersPII(ctx, after, REENCRYPT_BATCH_SIZE)
+		if err != nil {
+			return stats, err
+		}
+
+		for _, user := range users {
+			if err = ctx.Err(); err != nil {
+				return stats, err
+			}
+
+			updated, changed, err := reencryptUser(p.Cipher, p.Index, user)
+			if err != nil {
+				l.Error("failed to re-encrypt user", slog.String("uuid", user.UUID), slog.String("error", err.Error()))
+				stats.Failed++
+				continue
+			}
+			if !changed {
+				continue
+			}
+
+			if err = p.Repo.UpdateUserPII(ctx, updated); err != nil {
+				return stats, err
+			}
+			stats.Updated++
+		}
+
+		if len(users) < REENCRYPT_BATCH_SIZE {
+			return stats, nil
+		}
+		after = users[len(users)-1].UUID
+	}
+}
+
+// reencryptUser returns false, if the values and the indexes are up to date
+func reencryptUser(c encryption.Cipher, index encryption.BlindIndex, user models.UserPII) (models.UserPII, bool, error) {
+	fields := []struct {
+		name  string
+		value *string
+		index *string
+	}{
+		{name: PII_PASSPORT_NUMBER, value: &user.PassportNumber, index: &user.PassportNumberIndex},
+		{name: PII_PAYMENT_SOURCE, value: &user.PaymentSource},
+		{name: PII_PHONE_NUMBER, value: &user.PhoneNumber, index: &user.PhoneNumberIndex},
+	}
+
+	changed := false
+	for _, f := range fields {
+		if *f.value == "" {
+			continue
+		}
+
+		plaintext, err := c.Decrypt(*f.value, piiAAD(user.UUID, f.name))
+		if err != nil {
+			return models.UserPII{}, false, fmt.Errorf("failed to decrypt %s: %w", f.name, err)
+		}
+
+		if c.NeedsRotation(*f.value) {
+			if *f.value, err = c.Encrypt(plaintext, piiAAD(user.UUID, f.name)); err != nil {
+				return models.UserPII{}, false, fmt.Errorf("failed to encrypt %s: %w", f.name, err)
+			}
+			changed = true
+		}
+
+		if f.index != nil {
+			if idx := index.Index(f.name, plaintext); idx != *f.index {
+				*f.index = idx
+				changed = true
+			}
+		}
+	}
+
+	return user, changed, nil
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	repomock "github.com/alserov/rently/user/internal/db/mocks"
+	"github.com/alserov/rently/user/internal/encryption"
+	"github.com/alserov/rently/user/internal/log"
+	"github.com/alserov/rently/user/internal/models"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestReencryptPII(t *testing.T) {
+	log.MustSetup(log.ENV_LOCAL)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	keys := map[string][]byte{
+		"k1": bytes.Repeat([]byte{1}, encryption.KEY_SIZE),
+		"k2": bytes.Repeat([]byte{2}, encryption.KEY_SIZE),
+	}
+	old, err := encryption.NewCipher(encryption.Params{ActiveKeyID: "k1", Keys: keys})
+	require.NoError(t, err)
+	c, err := encryption.NewCipher(encryption.Params{ActiveKeyID: "k2", Keys: keys, AllowLegacy: true})
+	require.NoError(t, err)
+	index := encryption.NewBlindIndex(bytes.Repeat([]byte{3}, encryption.KEY_SIZE))
+
+	rotated, err := old.Encrypt("AB1234567", piiAAD("rotated", PII_PASSPORT_NUMBER))
+	require.NoError(t, err)
+	current, err := c.Encrypt("AB7654321", piiAAD("current", PII_PASSPORT_NUMBER))
+	require.NoError(t, err)
+
+	users := []models.UserPII{
+		{
+			UUID:           "legacy",
+			PassportNumber: base64.StdEncoding.EncodeToString([]byte("AB0000000")),
+			PaymentSource:  base64.StdEncoding.EncodeToString([]byte("4111111111111111")),
+			PhoneNumber:    base64.StdEncoding.EncodeToString([]byte("9999999999")),
+		},
+		{UUID: "rotated", PassportNumber: rotated},
+		{UUID: "current", PassportNumber: current, PassportNumberIndex: index.Index(PII_PASSPORT_NUMBER, "AB7654321")},
+		{UUID: "broken", PassportNumber: "0"},
+	}
+
+	repo := repomock.NewMockRepository(ctrl)
+	repo.EXPECT().GetUsersPII(gomock.Any(), "", REENCRYPT_BATCH_SIZE).Return(users, nil)
+
+	var updated []models.UserPII
+	repo.EXPECT().UpdateUserPII(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, pii models.UserPII) error {
+			updated = append(updated, pii)
+			return nil
+		}).Times(2)
+
+	stats, err := ReencryptPII(context.Background(), ReencryptParams{Repo: repo, Cipher: c, Index: index})
+	require.NoError(t, err)
+	require.Equal(t, ReencryptStats{Updated: 2, Failed: 1}, stats)
+
+	require.Equal(t, "legacy", updated[0].UUID)
+	require.False(t, c.NeedsRotation(updated[0].PaymentSource))
+	phone, err := c.Decrypt(updated[0].PhoneNumber, piiAAD("legacy", PII_PHONE_NUMBER))
+	require.NoError(t, err)
+	require.Equal(t, "9999999999", phone)
+	require.Equal(t, index.Index(PII_PHONE_NUMBER, "9999999999"), updated[0].PhoneNumberIndex)
+
+	require.Equal(t, "rotated", updated[1].UUID)
+	require.False(t, c.NeedsRotation(updated[1].PassportNumber))
+	require.Equal(t, index.Index(PII_PASSPORT_NUMBER, "AB1234567"), updated[1].PassportNumberIndex)
+}
@@ -3,11 +3,14 @@ package service
 import (
 	"context"
 	"fmt"
+	"github.com/alserov/rently/proto/jwks"
 	"github.com/alserov/rently/user/internal/db"
+	"github.com/alserov/rently/user/internal/encryption"
 	"github.com/alserov/rently/user/internal/log"
 	"github.com/alserov/rently/user/internal/metrics"
 	"github.com/alserov/rently/user/internal/models"
 	"github.com/alserov/rently/user/internal/notifications"
+	"github.com/alserov/rently/user/internal/signing"
 	"github.com/google/uuid"
 	"log/slog"
 	"net/http"
@@ -15,25 +18,50 @@ import (
 
 type Service interface {
 	Register(ctx context.Context, req models.RegisterReq) (models.RegisterRes, error)
-	Login(ctx context.Context, req models.LoginReq) (string, error)
+	Login(ctx context.Context, req models.LoginReq) (models.Tokens, error)
 	GetInfo(ctx context.Context, uuid string) (models.UserInfoRes, error)
 	GetRentInfo(ctx context.Context, token string) (models.InfoForRentRes, error)
 	SwitchNotificationsStatus(ctx context.Context, uuid string) error
-	CheckIfAuthorized(ctx context.Context, token string) (string, error)
+	CheckIfAuthorized(ctx context.Context, token string) (models.AuthInfo, error)
 	ResetPassword(ctx context.Context, req models.ResetPasswordReq) error
+	RequestPasswordReset(ctx context.Context, email string) error
+	ConfirmPasswordReset(ctx context.Context, req models.ConfirmPasswordResetReq) error
+	VerifyEmail(ctx context.Context, code string) error
+	ResendEmailVerification(ctx context.Context, email string) error
+
+	// RefreshToken rotates the refresh token, the reuse of the rotated one revokes the session
+	RefreshToken(ctx context.Context, refreshToken string) (models.Tokens, error)
+	Logout(ctx context.Context, token string) error
+	ListSessions(ctx context.Context, token string) ([]models.Session, error)
+	RevokeSession(ctx context.Context, req models.RevokeSessionReq) error
+	// GetJWKS returns the public keys of the tokens
+	GetJWKS(ctx context.Context) jwks.Set
 }
 
 type Params struct {
 	Metrics  metrics.Metrics
 	Notifier notifications.Notifier
 	Repo     db.Repository
+	// Cipher encrypts the personal data, Index makes it searchable
+	Cipher encryption.Cipher
+	Index  encryption.BlindIndex
+	// Signer signs the tokens, its public keys are published with the JWKS
+	Signer signing.Signer
+
+	PasswordReset     PasswordResetParams
+	EmailVerification EmailVerificationParams
 }
 
 func NewService(p Params) Service {
 	return &service{
-		log:      log.GetLogger(),
-		notifier: p.Notifier,
-		repo:     p.Repo,
+		log:               log.GetLogger(),
+		notifier:          p.Notifier,
+		repo:              p.Repo,
+		cipher:            p.Cipher,
+		index:             p.Index,
+		signer:            p.Signer,
+		passwordReset:     p.PasswordReset.withDefaults(),
+		emailVerification: p.EmailVerification.withDefaults(),
 	}
 }
 
@@ -48,13 +76,26 @@ type service struct {
 	notifier notifications.Notifier
 
 	repo db.Repository
+
+	cipher encryption.Cipher
+	index  encryption.BlindIndex
+
+	signer signing.Signer
+
+	passwordReset     PasswordResetParams
+	emailVerification EmailVerificationParams
+}
+
+func (s *service) GetJWKS(_ context.Context) jwks.Set {
+	return s.signer.JWKS()
 }
 
 func (s *service) ResetPassword(ctx context.Context, req models.ResetPasswordReq) error {
-	uuid, _, err := parseTokenClaims(req.Token)
+	claims, err := s.authenticate(ctx, req.Token)
 	if err != nil {
 		return fmt.Errorf("failed to parse token: %w", err)
 	}
+	uuid := claims.UUID
 
 	passwd, err := s.repo.GetPassword(ctx, uuid)
 	if err != nil {
@@ -77,17 +118,17 @@ func (s *service) ResetPassword(ctx context.Context, req models.ResetPasswordReq
 	return nil
 }
 
-func (s *service) CheckIfAuthorized(ctx context.Context, token string) (string, error) {
-	uuid, role, err := parseTokenClaims(token)
+func (s *service) CheckIfAuthorized(ctx context.Context, token string) (models.AuthInfo, error) {
+	claims, err := s.authenticate(ctx, token)
 	if err != nil {
-		return "", fmt.Errorf("failed to parse token: %w", err)
+		return models.AuthInfo{}, fmt.Errorf("failed to parse token: %w", err)
 	}
 
-	if err = s.repo.CheckIfAuthorized(ctx, uuid, role); err != nil {
-		return "", err
+	if err = s.repo.CheckIfAuthorized(ctx, claims.UUID, claims.Role); err != nil {
+		return models.AuthInfo{}, err
 	}
 
-	return role, nil
+	return models.AuthInfo{UUID: claims.UUID, Role: claims.Role}, nil
 }
 
 func (s *service) GetInfo(ctx context.Context, uuid string) (models.UserInfoRes, error) {
@@ -100,10 +141,11 @@ func (s *service) GetInfo(ctx context.Context, uuid string) (models.UserInfoRes,
 }
 
 func (s *service) GetRentInfo(ctx context.Context, token string) (models.InfoForRentRes, error) {
-	uuid, _, err := parseTokenClaims(token)
+	claims, err := s.authenticate(ctx, token)
 	if err != nil {
 		return models.InfoForRentRes{}, err
 	}
+	uuid := claims.UUID
 
 	s.log.Debug("parsed token", slog.String("uuid", uuid))
 
@@ -114,21 +156,29 @@ func (s *service) GetRentInfo(ctx context.Context, token string) (models.InfoFor
 
 	info.UUID = uuid
 
-	s.log.Debug("got info for rent", slog.Any("info", info))
+	s.log.Debug("got info for rent", slog.String("uuid", uuid))
+
+	if !info.EmailVerified {
+		return models.InfoForRentRes{}, &models.Error{
+			Msg:    ERR_EMAIL_NOT_VERIFIED,
+			Status: http.StatusForbidden,
+		}
+	}
 
-	passportNumber, err := decrypt(info.PassportNumber)
+	passportNumber, err := s.decrypt(uuid, PII_PASSPORT_NUMBER, info.PassportNumber)
 	if err != nil {
-		return models.InfoForRentRes{}, fmt.Errorf("failed to decrypt passport number: %w", err)
+		return models.InfoForRentRes{}, err
 	}
 
-	phoneNumber, err := decrypt(info.PhoneNumber)
+	phoneNumber, err := s.decrypt(uuid, PII_PHONE_NUMBER, info.PhoneNumber)
 	if err != nil {
-		return models.InfoForRentRes{}, fmt.Errorf("failed to decrypt phone number: %w", err)
+		return models.InfoForRentRes{}, err
 	}
 
 	return models.InfoForRentRes{
 		PhoneNumber:    phoneNumber,
 		PassportNumber: passportNumber,
+		Email:          info.Email,
 		UUID:           info.UUID,
 	}, nil
 }
@@ -141,26 +191,26 @@ func (s *service) SwitchNotificationsStatus(ctx context.Context, uuid string) er
 	return nil
 }
 
-func (s *service) Login(ctx context.Context, req models.LoginReq) (string, error) {
+func (s *service) Login(ctx context.Context, req models.LoginReq) (models.Tokens, error) {
 	userData, err := s.repo.Login(ctx, req.Email)
 	if err != nil {
-		return "", err
+		return models.Tokens{}, err
 	}
 
 	if err = compareHashAndPassword(userData.Password, req.Password); err != nil {
-		return "", err
+		return models.Tokens{}, err
 	}
 
-	token, err := newToken(userData.UUID, userData.Role)
+	tokens, err := s.startSession(ctx, userData.UUID, userData.Role, req.Client)
 	if err != nil {
-		return "", fmt.Errorf("failed to generate new token: %w", err)
+		return models.Tokens{}, err
 	}
 
 	if err = s.notifier.Login(ctx, userData.Email); err != nil {
-		return "", fmt.Errorf("failed to send login notification: %w", err)
+		return models.Tokens{}, fmt.Errorf("failed to send login notification: %w", err)
 	}
 
-	return token, nil
+	return tokens, nil
 }
 
 func (s *service) Register(ctx context.Context, req models.RegisterReq) (models.RegisterRes, error) {
@@ -174,31 +224,54 @@ func (s *service) Register(ctx context.Context, req models.RegisterReq) (models.
 		}
 	}
 
+	// the passport numbers are encrypted with the random keys, so they are compared by the blind index
+	req.PassportNumberIndex = s.index.Index(PII_PASSPORT_NUMBER, req.PassportNumber)
+	req.PhoneNumberIndex = s.index.Index(PII_PHONE_NUMBER, req.PhoneNumber)
+
+	registered, err := s.repo.IsPassportRegistered(ctx, req.PassportNumberIndex)
+	if err != nil {
+		return models.RegisterRes{}, err
+	}
+	if registered {
+		return models.RegisterRes{}, &models.Error{
+			Msg:    db.ERR_PASSPORT_REGISTERED,
+			Status: http.StatusConflict,
+		}
+	}
+
 	hashedPassword, err := hash(req.Password)
 	if err != nil {
 		return models.RegisterRes{}, fmt.Errorf("failed to hash password: %w", err)
 	}
-
 	req.Password = hashedPassword
-	req.PassportNumber = encrypt(req.PassportNumber)
-	req.PaymentSource = encrypt(req.PaymentSource)
-	req.PhoneNumber = encrypt(req.PhoneNumber)
+
+	if req.PassportNumber, err = s.encrypt(req.UUID, PII_PASSPORT_NUMBER, req.PassportNumber); err != nil {
+		return models.RegisterRes{}, err
+	}
+	if req.PaymentSource, err = s.encrypt(req.UUID, PII_PAYMENT_SOURCE, req.PaymentSource); err != nil {
+		return models.RegisterRes{}, err
+	}
+	if req.PhoneNumber, err = s.encrypt(req.UUID, PII_PHONE_NUMBER, req.PhoneNumber); err != nil {
+		return models.RegisterRes{}, err
+	}
 
 	if err = s.repo.Register(ctx, req); err != nil {
 		return models.RegisterRes{}, err
 	}
 
-	if err = s.notifier.Registration(ctx, req.Email); err != nil {
-		return models.RegisterRes{}, fmt.Errorf("failed to send notification: %w", err)
+	// the user can sign in before the verification, only the rents are refused
+	if err = s.sendEmailVerification(ctx, req.UUID, req.Email); err != nil {
+		return models.RegisterRes{}, err
 	}
 
-	token, err := newToken(req.UUID, ROLE_USER)
+	tokens, err := s.startSession(ctx, req.UUID, ROLE_USER, req.Client)
 	if err != nil {
-		return models.RegisterRes{}, fmt.Errorf("failed to generate new token: %w", err)
+		return models.RegisterRes{}, err
 	}
 
 	return models.RegisterRes{
-		UUID:  req.UUID,
-		Token: token,
+		UUID:         req.UUID,
+		Token:        tokens.Token,
+		RefreshToken: tokens.RefreshToken,
 	}, nil
 }
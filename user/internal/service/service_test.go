@@ -1,14 +1,17 @@
 package service
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"github.com/alserov/rently/user/internal/db"
 	repomock "github.com/alserov/rently/user/internal/db/mocks"
+	"github.com/alserov/rently/user/internal/encryption"
 	"github.com/alserov/rently/user/internal/models"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
-	"os"
+	"net/http"
+	"net/url"
 	"testing"
 )
 
@@ -25,27 +28,52 @@ func TestService_Register(t *testing.T) {
 		PhoneNumber:    "23424234234",
 	}
 
-	os.Setenv("SECRET_KEY", "secret")
+	cipher, err := encryption.NewCipher(encryption.Params{ActiveKeyID: "k1", Keys: map[string][]byte{"k1": bytes.Repeat([]byte{1}, encryption.KEY_SIZE)}})
+	require.NoError(t, err)
+	index := encryption.NewBlindIndex(bytes.Repeat([]byte{2}, encryption.KEY_SIZE))
 
 	repo := repomock.NewMockRepository(crtl)
 	repo.EXPECT().
 		Register(gomock.Any(), gomock.Any()).
-		Return(nil).
+		DoAndReturn(func(_ context.Context, registered models.RegisterReq) error {
+			// the personal data is stored encrypted only
+			require.NotContains(t, registered.PassportNumber, req.PassportNumber)
+			passport, err := cipher.Decrypt(registered.PassportNumber, piiAAD(registered.UUID, PII_PASSPORT_NUMBER))
+			require.NoError(t, err)
+			require.Equal(t, req.PassportNumber, passport)
+			require.Equal(t, index.Index(PII_PASSPORT_NUMBER, req.PassportNumber), registered.PassportNumberIndex)
+			return nil
+		}).
 		Times(1)
 	repo.EXPECT().
 		Login(gomock.Any(), gomock.Any()).
 		Return(db.LoginInfo{}, errors.New("user not found")).
 		Times(1)
+	repo.EXPECT().
+		IsPassportRegistered(gomock.Any(), index.Index(PII_PASSPORT_NUMBER, req.PassportNumber)).
+		Return(false, nil).
+		Times(1)
+	repo.EXPECT().
+		CreateSession(gomock.Any(), gomock.Any()).
+		Return(nil).
+		Times(1)
 
-	//notif := notmock.NewMockNotifier(crtl)
-	//notif.EXPECT().
-	//	Registration(gomock.Any(), req.Email).
-	//	Return(nil).
-	//	Times(1)
+	var verification models.EmailVerification
+	repo.EXPECT().
+		CreateEmailVerification(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, v models.EmailVerification) error {
+			verification = v
+			return nil
+		}).
+		Times(1)
 
+	notifier := &fakeNotifier{}
 	s := NewService(Params{
-		Repo: repo,
-		//Notifier: notif,
+		Repo:     repo,
+		Cipher:   cipher,
+		Index:    index,
+		Signer:   testSigner(t),
+		Notifier: notifier,
 	})
 
 	res, err := s.Register(context.Background(), req)
@@ -53,6 +81,46 @@ func TestService_Register(t *testing.T) {
 
 	require.NotEmpty(t, res.UUID)
 	require.NotEmpty(t, res.Token)
+
+	// the verification email is sent instead of the registration one
+	require.Len(t, notifier.verifications, 1)
+	require.Equal(t, req.Email, notifier.verifications[0].Email)
+	require.Equal(t, res.UUID, verification.UserUUID)
+	link, err := url.Parse(notifier.verifications[0].Link)
+	require.NoError(t, err)
+	require.Equal(t, hashRefreshToken(link.Query().Get(CODE_PARAM)), verification.CodeHash)
+}
+
+func TestService_RegisterPassportTaken(t *testing.T) {
+	crtl := gomock.NewController(t)
+	defer crtl.Finish()
+
+	cipher, err := encryption.NewCipher(encryption.Params{ActiveKeyID: "k1", Keys: map[string][]byte{"k1": bytes.Repeat([]byte{1}, encryption.KEY_SIZE)}})
+	require.NoError(t, err)
+
+	repo := repomock.NewMockRepository(crtl)
+	repo.EXPECT().Login(gomock.Any(), gomock.Any()).Return(db.LoginInfo{}, errors.New("user not found")).Times(2)
+	repo.EXPECT().IsPassportRegistered(gomock.Any(), gomock.Any()).Return(true, nil).Times(1)
+	// the concurrent registration takes the passport after the check
+	repo.EXPECT().IsPassportRegistered(gomock.Any(), gomock.Any()).Return(false, nil).Times(1)
+	repo.EXPECT().
+		Register(gomock.Any(), gomock.Any()).
+		Return(&models.Error{Msg: db.ERR_PASSPORT_REGISTERED, Status: http.StatusConflict}).
+		Times(1)
+
+	s := NewService(Params{
+		Repo:   repo,
+		Cipher: cipher,
+		Index:  encryption.NewBlindIndex(bytes.Repeat([]byte{2}, encryption.KEY_SIZE)),
+	})
+
+	req := models.RegisterReq{Username: "user", Password: "password", Email: "email", PassportNumber: "123414124"}
+	for i := 0; i < 2; i++ {
+		_, err = s.Register(context.Background(), req)
+		var e *models.Error
+		require.True(t, errors.As(err, &e))
+		require.Equal(t, http.StatusConflict, e.Status)
+	}
 }
 
 func TestService_Login(t *testing.T) {
@@ -76,15 +144,23 @@ func TestService_Login(t *testing.T) {
 			Password: hashedPassword,
 		}, nil).
 		Times(1)
-
-	//notif := notmock.NewMockNotifier(crtl)
+	repo.EXPECT().
+		CreateSession(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, session models.Session) error {
+			require.Equal(t, "uuid", session.UserUUID)
+			require.NotEmpty(t, session.RefreshTokenHash)
+			return nil
+		}).
+		Times(1)
 
 	s := NewService(Params{
-		Repo: repo,
-		//Notifier: notif,
+		Repo:     repo,
+		Signer:   testSigner(t),
+		Notifier: &fakeNotifier{},
 	})
 
-	token, err := s.Login(context.Background(), req)
+	tokens, err := s.Login(context.Background(), req)
 	require.NoError(t, err)
-	require.NotEmpty(t, token)
+	require.NotEmpty(t, tokens.Token)
+	require.NotEmpty(t, tokens.RefreshToken)
 }
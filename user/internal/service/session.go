@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/alserov/rently/user/internal/models"
+	"github.com/google/uuid"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+const (
+	ERR_INVALID_TOKEN         = "invalid token provided"
+	ERR_SESSION_REVOKED       = "session is revoked"
+	ERR_INVALID_REFRESH_TOKEN = "invalid refresh token"
+
+	// MAX_USER_AGENT_LENGTH is the size of the user agent column
+	MAX_USER_AGENT_LENGTH = 512
+)
+
+func (s *service) RefreshToken(ctx context.Context, refreshToken string) (models.Tokens, error) {
+	hash := hashRefreshToken(refreshToken)
+
+	session, err := s.repo.GetSessionByRefreshToken(ctx, hash)
+	if err != nil {
+		return models.Tokens{}, err
+	}
+
+	now := time.Now().UTC()
+	if session.RevokedAt != nil || !now.Before(session.ExpiresAt) {
+		return models.Tokens{}, &models.Error{
+			Msg:    ERR_INVALID_REFRESH_TOKEN,
+			Status: http.StatusUnauthorized,
+		}
+	}
+
+	// the rotated token is used again, so one of its holders is not the user, the whole session is revoked
+	if session.RefreshTokenHash != hash {
+		s.log.Warn("refresh token reuse detected", slog.String("session", session.ID), slog.String("uuid", session.UserUUID))
+
+		if err = s.repo.RevokeSession(ctx, session.UserUUID, session.ID, now); err != nil {
+			return models.Tokens{}, err
+		}
+
+		return models.Tokens{}, &models.Error{
+			Msg:    ERR_INVALID_REFRESH_TOKEN,
+			Status: http.StatusUnauthorized,
+		}
+	}
+
+	newRefreshToken, err := newRefreshToken()
+	if err != nil {
+		return models.Tokens{}, err
+	}
+
+	if err = s.repo.RotateSession(ctx, session.ID, hash, hashRefreshToken(newRefreshToken), now, now.Add(REFRESH_TOKEN_TTL)); err != nil {
+		return models.Tokens{}, err
+	}
+
+	token, err := newToken(s.signer, session.UserUUID, session.Role, session.ID)
+	if err != nil {
+		return models.Tokens{}, fmt.Errorf("failed to generate new token: %w", err)
+	}
+
+	return models.Tokens{
+		Token:        token,
+		RefreshToken: newRefreshToken,
+	}, nil
+}
+
+func (s *service) Logout(ctx context.Context, token string) error {
+	claims, err := s.authenticate(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	if err = s.repo.RevokeSession(ctx, claims.UUID, claims.SessionID, time.Now().UTC()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) ListSessions(ctx context.Context, token string) ([]models.Session, error) {
+	claims, err := s.authenticate(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.repo.GetSessions(ctx, claims.UUID, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range sessions {
+		sessions[i].Current = sessions[i].ID == claims.SessionID
+	}
+
+	return sessions, nil
+}
+
+func (s *service) RevokeSession(ctx context.Context, req models.RevokeSessionReq) error {
+	claims, err := s.authenticate(ctx, req.Token)
+	if err != nil {
+		return err
+	}
+
+	// the sessions of the other users are not found
+	if err = s.repo.RevokeSession(ctx, claims.UUID, req.SessionID, time.Now().UTC()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// startSession issues the first pair of the tokens of the new session
+func (s *service) startSession(ctx context.Context, userUUID string, role string, client models.Client) (models.Tokens, error) {
+	refreshToken, err := newRefreshToken()
+	if err != nil {
+		return models.Tokens{}, err
+	}
+
+	if len(client.UserAgent) > MAX_USER_AGENT_LENGTH {
+		client.UserAgent = client.UserAgent[:MAX_USER_AGENT_LENGTH]
+	}
+
+	now := time.Now().UTC()
+	session := models.Session{
+		ID:               uuid.New().String(),
+		UserUUID:         userUUID,
+		RefreshTokenHash: hashRefreshToken(refreshToken),
+		Client:           client,
+		CreatedAt:        now,
+		LastUsedAt:       now,
+		ExpiresAt:        now.Add(REFRESH_TOKEN_TTL),
+	}
+
+	if err = s.repo.CreateSession(ctx, session); err != nil {
+		return models.Tokens{}, err
+	}
+
+	token, err := newToken(s.signer, userUUID, role, session.ID)
+	if err != nil {
+		return models.Tokens{}, fmt.Errorf("failed to generate new token: %w", err)
+	}
+
+	return models.Tokens{
+		Token:        token,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// authenticate parses the token and checks, that its session is not revoked
+func (s *service) authenticate(ctx context.Context, token string) (models.Claims, error) {
+	claims, err := parseTokenClaims(s.signer, token)
+	if err != nil {
+		return models.Claims{}, err
+	}
+
+	// the tokens, issued before the sessions, can not be revoked, so they are not accepted
+	if claims.SessionID == "" {
+		return models.Claims{}, &models.Error{
+			Msg:    ERR_INVALID_TOKEN,
+			Status: http.StatusUnauthorized,
+		}
+	}
+
+	revoked, err := s.repo.IsSessionRevoked(ctx, claims.SessionID, time.Now().UTC())
+	if err != nil {
+		return models.Claims{}, err
+	}
+	if revoked {
+		return models.Claims{}, &models.Error{
+			Msg:    ERR_SESSION_REVOKED,
+			Status: http.StatusUnauthorized,
+		}
+	}
+
+	return claims, nil
+}
@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	repomock "github.com/alserov/rently/user/internal/db/mocks"
+	"github.com/alserov/rently/user/internal/log"
+	"github.com/alserov/rently/user/internal/models"
+	"github.com/alserov/rently/user/internal/signing"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestService_RefreshToken(t *testing.T) {
+	log.MustSetup(log.ENV_LOCAL)
+	signer := testSigner(t)
+
+	refreshToken := "refresh-token"
+	session := models.Session{
+		ID:                "session",
+		UserUUID:          "uuid",
+		Role:              ROLE_USER,
+		RefreshTokenHash:  hashRefreshToken(refreshToken),
+		PreviousTokenHash: hashRefreshToken("previous-token"),
+		ExpiresAt:         time.Now().Add(time.Hour),
+	}
+
+	t.Run("rotated", func(t *testing.T) {
+		crtl := gomock.NewController(t)
+		defer crtl.Finish()
+
+		repo := repomock.NewMockRepository(crtl)
+		repo.EXPECT().
+			GetSessionByRefreshToken(gomock.Any(), session.RefreshTokenHash).
+			Return(session, nil).
+			Times(1)
+
+		var newHash string
+		repo.EXPECT().
+			RotateSession(gomock.Any(), session.ID, session.RefreshTokenHash, gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _, hash string, _, _ time.Time) error {
+				newHash = hash
+				return nil
+			}).
+			Times(1)
+
+		s := NewService(Params{Repo: repo, Signer: signer})
+
+		tokens, err := s.RefreshToken(context.Background(), refreshToken)
+		require.NoError(t, err)
+		require.NotEqual(t, refreshToken, tokens.RefreshToken)
+		require.Equal(t, hashRefreshToken(tokens.RefreshToken), newHash)
+
+		claims, err := parseTokenClaims(signer, tokens.Token)
+		require.NoError(t, err)
+		require.Equal(t, session.ID, claims.SessionID)
+		require.Equal(t, session.UserUUID, claims.UUID)
+	})
+
+	t.Run("reused", func(t *testing.T) {
+		crtl := gomock.NewController(t)
+		defer crtl.Finish()
+
+		repo := repomock.NewMockRepository(crtl)
+		repo.EXPECT().
+			GetSessionByRefreshToken(gomock.Any(), session.PreviousTokenHash).
+			Return(session, nil).
+			Times(1)
+		repo.EXPECT().
+			RevokeSession(gomock.Any(), session.UserUUID, session.ID, gomock.Any()).
+			Return(nil).
+			Times(1)
+
+		s := NewService(Params{Repo: repo, Signer: signer})
+
+		_, err := s.RefreshToken(context.Background(), "previous-token")
+		requireStatus(t, err, http.StatusUnauthorized)
+	})
+
+	t.Run("revoked", func(t *testing.T) {
+		crtl := gomock.NewController(t)
+		defer crtl.Finish()
+
+		revoked := session
+		revokedAt := time.Now()
+		revoked.RevokedAt = &revokedAt
+
+		repo := repomock.NewMockRepository(crtl)
+		repo.EXPECT().
+			GetSessionByRefreshToken(gomock.Any(), gomock.Any()).
+			Return(revoked, nil).
+			Times(1)
+
+		s := NewService(Params{Repo: repo, Signer: signer})
+
+		_, err := s.RefreshToken(context.Background(), refreshToken)
+		requireStatus(t, err, http.StatusUnauthorized)
+	})
+}
+
+func TestService_CheckIfAuthorized(t *testing.T) {
+	signer := testSigner(t)
+
+	token, err := newToken(signer, "uuid", ROLE_USER, "session")
+	require.NoError(t, err)
+
+	legacyToken, err := newToken(signer, "uuid", ROLE_USER, "")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		token     string
+		revoked   bool
+		expStatus int
+	}{
+		{
+			name:  "active session",
+			token: token,
+		},
+		{
+			name:      "revoked session",
+			token:     token,
+			revoked:   true,
+			expStatus: http.StatusUnauthorized,
+		},
+		{
+			name:      "without session",
+			token:     legacyToken,
+			expStatus: http.StatusUnauthorized,
+		},
+		{
+			name:      "invalid token",
+			token:     token + "x",
+			expStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			crtl := gomock.NewController(t)
+			defer crtl.Finish()
+
+			repo := repomock.NewMockRepository(crtl)
+			repo.EXPECT().
+				IsSessionRevoked(gomock.Any(), "session", gomock.Any()).
+				Return(tc.revoked, nil).
+				MaxTimes(1)
+			repo.EXPECT().
+				CheckIfAuthorized(gomock.Any(), "uuid", ROLE_USER).
+				Return(nil).
+				MaxTimes(1)
+
+			s := NewService(Params{Repo: repo, Signer: signer})
+
+			info, err := s.CheckIfAuthorized(context.Background(), tc.token)
+			if tc.expStatus != 0 {
+				requireStatus(t, err, tc.expStatus)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "uuid", info.UUID)
+		})
+	}
+}
+
+func TestService_ListSessions(t *testing.T) {
+	crtl := gomock.NewController(t)
+	defer crtl.Finish()
+
+	signer := testSigner(t)
+
+	token, err := newToken(signer, "uuid", ROLE_USER, "current")
+	require.NoError(t, err)
+
+	repo := repomock.NewMockRepository(crtl)
+	repo.EXPECT().
+		IsSessionRevoked(gomock.Any(), "current", gomock.Any()).
+		Return(false, nil).
+		Times(1)
+	repo.EXPECT().
+		GetSessions(gomock.Any(), "uuid", gomock.Any()).
+		Return([]models.Session{{ID: "other"}, {ID: "current"}}, nil).
+		Times(1)
+
+	s := NewService(Params{Repo: repo, Signer: signer})
+
+	sessions, err := s.ListSessions(context.Background(), token)
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	require.False(t, sessions[0].Current)
+	require.True(t, sessions[1].Current)
+}
+
+func testSigner(t *testing.T) signing.Signer {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	signer, err := signing.NewSigner(signing.Params{ActiveKeyID: "test", Keys: map[string]crypto.Signer{"test": key}})
+	require.NoError(t, err)
+
+	return signer
+}
+
+func requireStatus(t *testing.T, err error, status int) {
+	var e *models.Error
+	require.True(t, errors.As(err, &e), "unexpected error: %v", err)
+	require.Equal(t, status, e.Status)
+}
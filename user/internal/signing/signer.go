@@ -0,0 +1,176 @@
+package signing
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/alserov/rently/proto/jwks"
+	"github.com/golang-jwt/jwt/v4"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Signer signs the tokens with the active key, the kid header tells, which key verifies the token,
+// so the previous keys verify the tokens, signed before the rotation, till they are removed
+type Signer interface {
+	Sign(claims jwt.Claims) (string, error)
+	Parse(token string, claims jwt.Claims) error
+	// JWKS returns the public keys of all the keys
+	JWKS() jwks.Set
+}
+
+// KEYS_ENV contains the comma separated signing keys as id:base64 PKCS #8 DER pairs, the Ed25519 and RSA keys are supported
+const KEYS_ENV = "SIGNING_KEYS"
+
+var ErrUnknownKey = errors.New("unknown signing key")
+
+type Params struct {
+	// ActiveKeyID is the key, the new tokens are signed with
+	ActiveKeyID string
+	Keys        map[string]crypto.Signer
+}
+
+func NewSigner(p Params) (Signer, error) {
+	if _, ok := p.Keys[p.ActiveKeyID]; !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKey, p.ActiveKeyID)
+	}
+
+	s := &signer{
+		activeKeyID: p.ActiveKeyID,
+		keys:        make(map[string]key, len(p.Keys)),
+	}
+
+	ids := make([]string, 0, len(p.Keys))
+	for id := range p.Keys {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		k, err := newKey(p.Keys[id])
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %s: %w", id, err)
+		}
+		s.keys[id] = k
+
+		jwk, err := jwks.NewKey(id, p.Keys[id].Public())
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %s: %w", id, err)
+		}
+		s.set.Keys = append(s.set.Keys, jwk)
+	}
+
+	return s, nil
+}
+
+// MustNewSigner reads the keys from the env
+func MustNewSigner(activeKeyID string) Signer {
+	keys, err := ParseKeys(os.Getenv(KEYS_ENV))
+	if err != nil {
+		panic("failed to parse signing keys: " + err.Error())
+	}
+
+	s, err := NewSigner(Params{ActiveKeyID: activeKeyID, Keys: keys})
+	if err != nil {
+		panic("failed to init signer: " + err.Error())
+	}
+
+	return s
+}
+
+// ParseKeys parses the id:base64 pairs separated by comma
+func ParseKeys(s string) (map[string]crypto.Signer, error) {
+	keys := make(map[string]crypto.Signer)
+	for _, pair := range strings.Split(s, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+
+		id, encoded, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		if !ok {
+			return nil, fmt.Errorf("key should be set as id:base64")
+		}
+
+		der, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode key %s: %w", id, err)
+		}
+
+		priv, err := x509.ParsePKCS8PrivateKey(der)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse key %s: %w", id, err)
+		}
+
+		signer, ok := priv.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("key %s can not sign", id)
+		}
+		keys[id] = signer
+	}
+
+	return keys, nil
+}
+
+type key struct {
+	method  jwt.SigningMethod
+	private crypto.Signer
+	public  crypto.PublicKey
+}
+
+func newKey(private crypto.Signer) (key, error) {
+	switch k := private.(type) {
+	case ed25519.PrivateKey:
+		return key{method: jwt.SigningMethodEdDSA, private: private, public: private.Public()}, nil
+	case *rsa.PrivateKey:
+		if k.N.BitLen() < jwks.MIN_RSA_KEY_BITS {
+			return key{}, fmt.Errorf("rsa key should be at least %d bits", jwks.MIN_RSA_KEY_BITS)
+		}
+		return key{method: jwt.SigningMethodRS256, private: private, public: private.Public()}, nil
+	default:
+		return key{}, fmt.Errorf("%w: %T", jwks.ErrUnsupportedKey, private)
+	}
+}
+
+type signer struct {
+	activeKeyID string
+	keys        map[string]key
+	set         jwks.Set
+}
+
+func (s *signer) Sign(claims jwt.Claims) (string, error) {
+	k := s.keys[s.activeKeyID]
+
+	token := jwt.NewWithClaims(k.method, claims)
+	token.Header["kid"] = s.activeKeyID
+
+	return token.SignedString(k.private)
+}
+
+func (s *signer) Parse(token string, claims jwt.Claims) error {
+	_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+
+		k, ok := s.keys[kid]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownKey, kid)
+		}
+
+		// the algorithm is bound to the key, so the token can not choose it
+		if t.Method != k.method {
+			return nil, fmt.Errorf("unexpected signing method: %s", t.Method.Alg())
+		}
+
+		return k.public, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodEdDSA.Alg(), jwt.SigningMethodRS256.Alg()}))
+
+	return err
+}
+
+func (s *signer) JWKS() jwks.Set {
+	return s.set
+}
@@ -0,0 +1,111 @@
+package signing
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"github.com/alserov/rently/proto/jwks"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestSigner(t *testing.T) {
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	keys := map[string]crypto.Signer{"ed": edKey, "rsa": rsaKey}
+
+	for _, kid := range []string{"ed", "rsa"} {
+		t.Run(kid, func(t *testing.T) {
+			s, err := NewSigner(Params{ActiveKeyID: kid, Keys: keys})
+			require.NoError(t, err)
+
+			token, err := s.Sign(newClaims("uuid", time.Minute))
+			require.NoError(t, err)
+
+			var c jwks.Claims
+			require.NoError(t, s.Parse(token, &c))
+			require.Equal(t, "uuid", c.UUID)
+
+			// the other services verify the token with the published keys only
+			v := jwks.NewVerifier(jwks.Params{Source: func(ctx context.Context) (jwks.Set, error) {
+				return s.JWKS(), nil
+			}})
+			c, err = v.Verify(context.Background(), token)
+			require.NoError(t, err)
+			require.Equal(t, "uuid", c.UUID)
+		})
+	}
+}
+
+func TestSigner_Rotation(t *testing.T) {
+	_, oldKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	_, newKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	before, err := NewSigner(Params{ActiveKeyID: "old", Keys: map[string]crypto.Signer{"old": oldKey}})
+	require.NoError(t, err)
+	token, err := before.Sign(newClaims("uuid", time.Minute))
+	require.NoError(t, err)
+
+	after, err := NewSigner(Params{ActiveKeyID: "new", Keys: map[string]crypto.Signer{"old": oldKey, "new": newKey}})
+	require.NoError(t, err)
+	require.NoError(t, after.Parse(token, &jwks.Claims{}))
+	require.Len(t, after.JWKS().Keys, 2)
+
+	removed, err := NewSigner(Params{ActiveKeyID: "new", Keys: map[string]crypto.Signer{"new": newKey}})
+	require.NoError(t, err)
+	require.ErrorIs(t, removed.Parse(token, &jwks.Claims{}), ErrUnknownKey)
+}
+
+func TestSigner_Parse_Invalid(t *testing.T) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	s, err := NewSigner(Params{ActiveKeyID: "ed", Keys: map[string]crypto.Signer{"ed": key}})
+	require.NoError(t, err)
+
+	expired, err := s.Sign(newClaims("uuid", -time.Minute))
+	require.NoError(t, err)
+	require.Error(t, s.Parse(expired, &jwks.Claims{}))
+
+	// the public key is known to everyone, so the token, signed with it as the hmac secret, is forged
+	hmacToken := jwt.NewWithClaims(jwt.SigningMethodHS256, newClaims("admin", time.Minute))
+	hmacToken.Header["kid"] = "ed"
+	forged, err := hmacToken.SignedString([]byte(key.Public().(ed25519.PublicKey)))
+	require.NoError(t, err)
+	require.Error(t, s.Parse(forged, &jwks.Claims{}))
+}
+
+func TestParseKeys(t *testing.T) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	keys, err := ParseKeys("k1:" + base64.StdEncoding.EncodeToString(der))
+	require.NoError(t, err)
+	require.Equal(t, key, keys["k1"])
+
+	_, err = ParseKeys("k1")
+	require.Error(t, err)
+}
+
+func newClaims(uuid string, ttl time.Duration) jwks.Claims {
+	return jwks.Claims{
+		UUID: uuid,
+		Role: "user",
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(ttl)),
+		},
+	}
+}
@@ -2,7 +2,9 @@ package convertation
 
 import (
 	"github.com/alserov/rently/proto/gen/user"
+	"github.com/alserov/rently/proto/jwks"
 	"github.com/alserov/rently/user/internal/models"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Converter interface {
@@ -14,14 +16,19 @@ type ToService interface {
 	RegisterReqToService(req *user.RegisterReq) models.RegisterReq
 	LoginReqToService(req *user.LoginReq) models.LoginReq
 	ResetPasswordReqToService(req *user.ResetPasswordReq) models.ResetPasswordReq
+	RevokeSessionReqToService(req *user.RevokeSessionReq) models.RevokeSessionReq
+	ConfirmPasswordResetReqToService(req *user.ConfirmPasswordResetReq) models.ConfirmPasswordResetReq
 }
 
 type ToPb interface {
 	RegisterResToPb(res models.RegisterRes) *user.RegisterRes
-	LoginResToPb(token string) *user.LoginRes
+	LoginResToPb(tokens models.Tokens) *user.LoginRes
+	RefreshTokenResToPb(tokens models.Tokens) *user.RefreshTokenRes
+	SessionsToPb(sessions []models.Session) *user.ListSessionsRes
+	JWKSToPb(set jwks.Set) *user.JWKS
 	UserInfoResToPb(res models.UserInfoRes) *user.UserInfoRes
 	InfoForRentResToPb(res models.InfoForRentRes) *user.GetInfoForRentRes
-	CheckIfAuthorizedResToPb(role string) *user.CheckIfAuthorizedRes
+	CheckIfAuthorizedResToPb(info models.AuthInfo) *user.CheckIfAuthorizedRes
 }
 
 func NewConverter() Converter {
@@ -39,8 +46,55 @@ func (c converter) ResetPasswordReqToService(req *user.ResetPasswordReq) models.
 	}
 }
 
-func (c converter) LoginResToPb(token string) *user.LoginRes {
-	return &user.LoginRes{Token: token}
+func (c converter) LoginResToPb(tokens models.Tokens) *user.LoginRes {
+	return &user.LoginRes{
+		Token:        tokens.Token,
+		RefreshToken: tokens.RefreshToken,
+	}
+}
+
+func (c converter) RefreshTokenResToPb(tokens models.Tokens) *user.RefreshTokenRes {
+	return &user.RefreshTokenRes{
+		Token:        tokens.Token,
+		RefreshToken: tokens.RefreshToken,
+	}
+}
+
+func (c converter) SessionsToPb(sessions []models.Session) *user.ListSessionsRes {
+	res := make([]*user.Session, 0, len(sessions))
+	for _, s := range sessions {
+		res = append(res, &user.Session{
+			ID: s.ID,
+			Client: &user.Client{
+				UserAgent: s.UserAgent,
+				IP:        s.IP,
+			},
+			CreatedAt:  timestamppb.New(s.CreatedAt),
+			LastUsedAt: timestamppb.New(s.LastUsedAt),
+			ExpiresAt:  timestamppb.New(s.ExpiresAt),
+			Current:    s.Current,
+		})
+	}
+
+	return &user.ListSessionsRes{Sessions: res}
+}
+
+func (c converter) JWKSToPb(set jwks.Set) *user.JWKS {
+	return jwks.ToPb(set)
+}
+
+func (c converter) ConfirmPasswordResetReqToService(req *user.ConfirmPasswordResetReq) models.ConfirmPasswordResetReq {
+	return models.ConfirmPasswordResetReq{
+		Code:        req.Code,
+		NewPassword: req.NewPassword,
+	}
+}
+
+func (c converter) RevokeSessionReqToService(req *user.RevokeSessionReq) models.RevokeSessionReq {
+	return models.RevokeSessionReq{
+		Token:     req.Token,
+		SessionID: req.SessionID,
+	}
 }
 
 func (c converter) UserInfoResToPb(res models.UserInfoRes) *user.UserInfoRes {
@@ -55,14 +109,16 @@ func (c converter) InfoForRentResToPb(res models.InfoForRentRes) *user.GetInfoFo
 	return &user.GetInfoForRentRes{
 		PhoneNumber:    res.PhoneNumber,
 		PassportNumber: res.PassportNumber,
+		Email:          res.Email,
 		UUID:           res.UUID,
 	}
 }
 
-func (c converter) CheckIfAuthorizedResToPb(role string) *user.CheckIfAuthorizedRes {
+func (c converter) CheckIfAuthorizedResToPb(info models.AuthInfo) *user.CheckIfAuthorizedRes {
 	return &user.CheckIfAuthorizedRes{
-		IsAuthorized: role != "",
-		Role:         role,
+		IsAuthorized: info.Role != "",
+		Role:         info.Role,
+		UUID:         info.UUID,
 	}
 }
 
@@ -74,6 +130,7 @@ func (c converter) RegisterReqToService(req *user.RegisterReq) models.RegisterRe
 		PassportNumber: req.PassportNumber,
 		PaymentSource:  req.PaymentSource,
 		PhoneNumber:    req.PhoneNumber,
+		Client:         clientToService(req.Client),
 	}
 }
 
@@ -81,12 +138,21 @@ func (c converter) LoginReqToService(req *user.LoginReq) models.LoginReq {
 	return models.LoginReq{
 		Email:    req.Email,
 		Password: req.Password,
+		Client:   clientToService(req.Client),
 	}
 }
 
 func (c converter) RegisterResToPb(res models.RegisterRes) *user.RegisterRes {
 	return &user.RegisterRes{
-		UUID:  res.UUID,
-		Token: res.Token,
+		UUID:         res.UUID,
+		Token:        res.Token,
+		RefreshToken: res.RefreshToken,
+	}
+}
+
+func clientToService(client *user.Client) models.Client {
+	return models.Client{
+		UserAgent: client.GetUserAgent(),
+		IP:        client.GetIP(),
 	}
 }
@@ -15,6 +15,14 @@ type Validator interface {
 	ValidateSwitchNotificationsStatusReq(req *user.SwitchNotificationsStatusReq) error
 	ValidateCheckIfAuthorizedReq(req *user.CheckIfAuthorizedReq) error
 	ValidateResetPasswordReq(req *user.ResetPasswordReq) error
+	ValidateRefreshTokenReq(req *user.RefreshTokenReq) error
+	ValidateLogoutReq(req *user.LogoutReq) error
+	ValidateListSessionsReq(req *user.ListSessionsReq) error
+	ValidateRevokeSessionReq(req *user.RevokeSessionReq) error
+	ValidateRequestPasswordResetReq(req *user.RequestPasswordResetReq) error
+	ValidateConfirmPasswordResetReq(req *user.ConfirmPasswordResetReq) error
+	ValidateVerifyEmailReq(req *user.VerifyEmailReq) error
+	ValidateResendEmailVerificationReq(req *user.ResendEmailVerificationReq) error
 }
 
 func NewValidator() Validator {
@@ -33,6 +41,10 @@ const (
 	ERR_INVALID_PASSPORT_NUMBER = "provided invalid passport number"
 	ERR_EMPTY_UUID              = "uuid can not be empty"
 	ERR_EMPTY_TOKEN             = "token can not be empty"
+	ERR_EMPTY_REFRESH_TOKEN     = "refresh token can not be empty"
+	ERR_EMPTY_SESSION_ID        = "session id can not be empty"
+	ERR_EMPTY_RESET_CODE        = "reset code can not be empty"
+	ERR_EMPTY_VERIFICATION_CODE = "verification code can not be empty"
 )
 
 type validator struct {
@@ -125,6 +137,70 @@ func (v validator) ValidateCheckIfAuthorizedReq(req *user.CheckIfAuthorizedReq)
 	return nil
 }
 
+func (v validator) ValidateRefreshTokenReq(req *user.RefreshTokenReq) error {
+	if req.GetRefreshToken() == "" {
+		return status.Error(codes.InvalidArgument, ERR_EMPTY_REFRESH_TOKEN)
+	}
+
+	return nil
+}
+
+func (v validator) ValidateLogoutReq(req *user.LogoutReq) error {
+	return validateToken(req.GetToken())
+}
+
+func (v validator) ValidateListSessionsReq(req *user.ListSessionsReq) error {
+	return validateToken(req.GetToken())
+}
+
+func (v validator) ValidateRevokeSessionReq(req *user.RevokeSessionReq) error {
+	if err := validateToken(req.GetToken()); err != nil {
+		return err
+	}
+
+	if req.GetSessionID() == "" {
+		return status.Error(codes.InvalidArgument, ERR_EMPTY_SESSION_ID)
+	}
+
+	return nil
+}
+
+func (v validator) ValidateRequestPasswordResetReq(req *user.RequestPasswordResetReq) error {
+	if ok := v.regExpEmail.MatchString(req.GetEmail()); !ok {
+		return status.Error(codes.InvalidArgument, ERR_INVALID_EMAIL)
+	}
+
+	return nil
+}
+
+func (v validator) ValidateConfirmPasswordResetReq(req *user.ConfirmPasswordResetReq) error {
+	if req.GetCode() == "" {
+		return status.Error(codes.InvalidArgument, ERR_EMPTY_RESET_CODE)
+	}
+
+	if err := validatePassword(req.GetNewPassword()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (v validator) ValidateVerifyEmailReq(req *user.VerifyEmailReq) error {
+	if req.GetCode() == "" {
+		return status.Error(codes.InvalidArgument, ERR_EMPTY_VERIFICATION_CODE)
+	}
+
+	return nil
+}
+
+func (v validator) ValidateResendEmailVerificationReq(req *user.ResendEmailVerificationReq) error {
+	if ok := v.regExpEmail.MatchString(req.GetEmail()); !ok {
+		return status.Error(codes.InvalidArgument, ERR_INVALID_EMAIL)
+	}
+
+	return nil
+}
+
 func validatePassword(password string) error {
 	if len(password) < 7 {
 		return status.Error(codes.InvalidArgument, ERR_INVALID_PASSWORD)